@@ -0,0 +1,90 @@
+package types
+
+import (
+	"math/bits"
+
+	"github.com/farazdagi/prysm-shared-types/mathutil"
+)
+
+// MinOf returns the smallest of the values (zero for empty slice). Unlike Min, it works directly on
+// typed slices (e.g. []Slot, []Epoch, []Gwei) and is unrolled for large inputs.
+func MinOf[T Uint64Like](values []T) T {
+	if len(values) == 0 {
+		return 0
+	}
+	m0, m1, m2, m3 := values[0], values[0], values[0], values[0]
+	for ; len(values) >= 4; values = values[4:] {
+		if values[0] < m0 {
+			m0 = values[0]
+		}
+		if values[1] < m1 {
+			m1 = values[1]
+		}
+		if values[2] < m2 {
+			m2 = values[2]
+		}
+		if values[3] < m3 {
+			m3 = values[3]
+		}
+	}
+	for _, v := range values {
+		if v < m0 {
+			m0 = v
+		}
+	}
+	return Min(m0, m1, m2, m3)
+}
+
+// MaxOf returns the largest of the values (zero for empty slice), see MinOf.
+func MaxOf[T Uint64Like](values []T) T {
+	if len(values) == 0 {
+		return 0
+	}
+	m0, m1, m2, m3 := values[0], values[0], values[0], values[0]
+	for ; len(values) >= 4; values = values[4:] {
+		if values[0] > m0 {
+			m0 = values[0]
+		}
+		if values[1] > m1 {
+			m1 = values[1]
+		}
+		if values[2] > m2 {
+			m2 = values[2]
+		}
+		if values[3] > m3 {
+			m3 = values[3]
+		}
+	}
+	for _, v := range values {
+		if v > m0 {
+			m0 = v
+		}
+	}
+	return Max(m0, m1, m2, m3)
+}
+
+// SumOf returns sum of the values, or an error if it overflows uint64 (e.g. when summing balances).
+func SumOf[T Uint64Like](values []T) (T, error) {
+	var s0, s1, s2, s3, carry uint64
+	for ; len(values) >= 4; values = values[4:] {
+		var c0, c1, c2, c3 uint64
+		s0, c0 = bits.Add64(s0, uint64(values[0]), 0)
+		s1, c1 = bits.Add64(s1, uint64(values[1]), 0)
+		s2, c2 = bits.Add64(s2, uint64(values[2]), 0)
+		s3, c3 = bits.Add64(s3, uint64(values[3]), 0)
+		carry |= c0 | c1 | c2 | c3
+	}
+	for _, v := range values {
+		var c uint64
+		s0, c = bits.Add64(s0, uint64(v), 0)
+		carry |= c
+	}
+	var c1, c2, c3 uint64
+	s0, c1 = bits.Add64(s0, s1, 0)
+	s0, c2 = bits.Add64(s0, s2, 0)
+	s0, c3 = bits.Add64(s0, s3, 0)
+	if carry|c1|c2|c3 != 0 {
+		return 0, mathutil.ErrOverflow
+	}
+	return T(s0), nil
+}
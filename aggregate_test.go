@@ -0,0 +1,66 @@
+package types
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/farazdagi/prysm-shared-types/mathutil"
+)
+
+func TestMinMaxSumOf(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for n := 0; n < 20; n++ {
+		values := make([]Gwei, n)
+		var min, max, sum Gwei
+		for i := range values {
+			values[i] = Gwei(r.Uint64() >> 8)
+			if i == 0 || values[i] < min {
+				min = values[i]
+			}
+			if values[i] > max {
+				max = values[i]
+			}
+			sum += values[i]
+		}
+		if got := MinOf(values); got != min {
+			t.Errorf("Unexpected min of %d values: %d, want %d", n, got, min)
+		}
+		if got := MaxOf(values); got != max {
+			t.Errorf("Unexpected max of %d values: %d, want %d", n, got, max)
+		}
+		if got, err := SumOf(values); err != nil || got != sum {
+			t.Errorf("Unexpected sum of %d values: %d, want %d (%v)", n, got, sum, err)
+		}
+	}
+
+	if got := MinOf([]Slot{9, 8, 7, 6, 5, 4, 3, 2, 1}); got != 1 {
+		t.Errorf("Unexpected min: %d", got)
+	}
+	if got := MaxOf([]Epoch{1, 2, 3, 4, 5, 6, 7, 8, 9}); got != 9 {
+		t.Errorf("Unexpected max: %d", got)
+	}
+	for _, values := range [][]Gwei{
+		{1<<64 - 1, 1},
+		{1 << 63, 0, 0, 0, 1 << 63},
+		{1 << 62, 1 << 62, 1 << 62, 1 << 62},
+		{1 << 63, 1, 1, 1, 1 << 63, 1, 1, 1},
+	} {
+		if _, err := SumOf(values); !errors.Is(err, mathutil.ErrOverflow) {
+			t.Errorf("Expected overflow summing %v, got: %v", values, err)
+		}
+	}
+}
+
+func BenchmarkSumOf(b *testing.B) {
+	values := make([]Gwei, 1<<20)
+	for i := range values {
+		values[i] = 32 * GweiPerEth
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := SumOf(values); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// AttestationData is the spec AttestationData container: the vote signed by attesters.
+type AttestationData struct {
+	Slot            Slot           `json:"slot"`
+	Index           CommitteeIndex `json:"index"`
+	BeaconBlockRoot BlockRoot      `json:"beacon_block_root"`
+	Source          Checkpoint     `json:"source"`
+	Target          Checkpoint     `json:"target"`
+}
+
+// HashTreeRoot returns calculated hash root.
+func (a *AttestationData) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	err := a.HashTreeRootInto(&root)
+	return root, err
+}
+
+// AppendFieldRoots appends hash roots of attestation data fields to dst.
+func (a *AttestationData) AppendFieldRoots(dst [][32]byte) ([][32]byte, error) {
+	slot, _ := a.Slot.HashTreeRoot()
+	index, _ := a.Index.HashTreeRoot()
+	source, err := a.Source.HashTreeRoot()
+	if err != nil {
+		return dst, err
+	}
+	target, err := a.Target.HashTreeRoot()
+	if err != nil {
+		return dst, err
+	}
+	return append(dst, slot, index, a.BeaconBlockRoot.Root, source, target), nil
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (a *AttestationData) HashTreeRootInto(dst *[32]byte) error {
+	var buf [5][32]byte
+	chunks, err := a.AppendFieldRoots(buf[:0])
+	if err != nil {
+		return err
+	}
+	return merkleizeInto(dst, chunks, 0)
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the attestation data object.
+func (a *AttestationData) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), a.SizeSSZ()); err != nil {
+		return err
+	}
+	a.Slot = Slot(binary.LittleEndian.Uint64(buf[:8]))
+	a.Index = CommitteeIndex(binary.LittleEndian.Uint64(buf[8:16]))
+	copy(a.BeaconBlockRoot.Root[:], buf[16:48])
+	if err := a.Source.UnmarshalSSZ(buf[48:88]); err != nil {
+		return err
+	}
+	return a.Target.UnmarshalSSZ(buf[88:128])
+}
+
+// MarshalSSZTo marshals attestation data with the provided byte slice.
+func (a *AttestationData) MarshalSSZTo(dst []byte) ([]byte, error) {
+	dst = a.Slot.AppendSSZ(dst)
+	dst = a.Index.AppendSSZ(dst)
+	dst = append(dst, a.BeaconBlockRoot.Root[:]...)
+	dst, _ = a.Source.MarshalSSZTo(dst)
+	return a.Target.MarshalSSZTo(dst)
+}
+
+// MarshalSSZ marshals attestation data into a serialized object.
+func (a *AttestationData) MarshalSSZ() ([]byte, error) {
+	return a.MarshalSSZTo(make([]byte, 0, a.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (a *AttestationData) SizeSSZ() int {
+	return 128
+}
+
+// WriteTo writes SSZ serialized attestation data to w.
+func (a *AttestationData) WriteTo(w io.Writer) (int64, error) {
+	return writeSSZTo(w, a)
+}
+
+// ReadFrom reads SSZ serialized attestation data from reader (exactly SizeSSZ bytes are consumed).
+func (a *AttestationData) ReadFrom(reader io.Reader) (int64, error) {
+	return readSSZFrom(reader, a)
+}
@@ -0,0 +1,19 @@
+//go:build !nofastssz
+
+package types
+
+import fssz "github.com/ferranbt/fastssz"
+
+var _ fssz.HashRoot = (*AttestationData)(nil)
+var _ fssz.Marshaler = (*AttestationData)(nil)
+var _ fssz.Unmarshaler = (*AttestationData)(nil)
+
+// HashTreeRootWith appends attestation data root to the provided hasher.
+func (a *AttestationData) HashTreeRootWith(hh *fssz.Hasher) error {
+	root, err := a.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	hh.PutBytes(root[:])
+	return nil
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestAttestationData_SSZ(t *testing.T) {
+	data := testAttestationData()
+	enc, err := data.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(enc) != data.SizeSSZ() {
+		t.Fatalf("Unexpected size: %d", len(enc))
+	}
+	decoded := &AttestationData{}
+	if err := decoded.UnmarshalSSZ(enc); err != nil {
+		t.Fatal(err)
+	}
+	if *decoded != *data {
+		t.Errorf("Unexpected attestation data: %+v", decoded)
+	}
+	if err := decoded.UnmarshalSSZ(enc[:127]); err == nil {
+		t.Error("Expected error for short buffer")
+	}
+}
+
+func TestAttestationData_JSON(t *testing.T) {
+	zeros := strings.Repeat("00", 31)
+	input := `{"slot":"100","index":"3","beacon_block_root":"0x01` + zeros + `",` +
+		`"source":{"epoch":"2","root":"0x02` + zeros + `"},"target":{"epoch":"3","root":"0x03` + zeros + `"}}`
+	var data AttestationData
+	if err := json.Unmarshal([]byte(input), &data); err != nil {
+		t.Fatal(err)
+	}
+	if data != *testAttestationData() {
+		t.Errorf("Unexpected attestation data: %+v", data)
+	}
+	enc, err := json.Marshal(&data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(enc) != input {
+		t.Errorf("Unexpected JSON: %s", enc)
+	}
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"time"
+
+	"github.com/farazdagi/prysm-shared-types/mathutil"
+)
+
+// Backoff is an exponential backoff schedule denominated in protocol time: the n-th retry (counting
+// from zero) is delayed by Initial*Factor^n slots, capped at Max. For example, epoch based schedule
+// NewEpochBackoff(1, 8, spec) retries after 1, 2, 4, 8, 8, ... epochs.
+type Backoff struct {
+	// Initial is the delay before the first retry.
+	Initial Slot
+	// Max caps the delay, zero means no cap.
+	Max Slot
+	// Factor is the multiplier applied on each retry, values below 2 are treated as 2.
+	Factor uint64
+}
+
+// NewSlotBackoff returns doubling backoff with delays expressed in slots.
+func NewSlotBackoff(initial, max Slot) Backoff {
+	return Backoff{Initial: initial, Max: max, Factor: 2}
+}
+
+// NewEpochBackoff returns doubling backoff with delays expressed in epochs.
+func NewEpochBackoff(initial, max Epoch, spec *ChainSpec) Backoff {
+	return NewSlotBackoff(initial.StartSlot(spec), max.StartSlot(spec))
+}
+
+// Delay returns number of slots to wait before the given retry attempt (0 being the first retry).
+func (b Backoff) Delay(attempt int) Slot {
+	factor := Slot(b.Factor)
+	if factor < 2 {
+		factor = 2
+	}
+	delay := b.Initial
+	for i := 0; i < attempt && (b.Max == 0 || delay < b.Max); i++ {
+		next, err := mathutil.SafeMul(delay, factor)
+		if err != nil {
+			delay = FarFutureSlot
+			break
+		}
+		delay = next
+	}
+	if b.Max != 0 && delay > b.Max {
+		return b.Max
+	}
+	return delay
+}
+
+// RetrySlot returns slot at which the given retry attempt is due, if the failure happened at slot.
+func (b Backoff) RetrySlot(slot Slot, attempt int) Slot {
+	if retry, err := slot.SafeAdd(uint64(b.Delay(attempt))); err == nil {
+		return retry
+	}
+	return FarFutureSlot
+}
+
+// Next returns retry slot for a failure at the current wall-clock slot, along with the wall-clock
+// time remaining until that slot starts.
+func (b Backoff) Next(clock *Clock, attempt int) (Slot, time.Duration) {
+	retry := b.RetrySlot(clock.CurrentSlot(), attempt)
+	return retry, clock.Until(retry)
+}
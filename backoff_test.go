@@ -0,0 +1,53 @@
+package types
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBackoff_Delay(t *testing.T) {
+	spec := &ChainSpec{SlotsPerEpoch: 32, SecondsPerSlot: 12}
+	tests := []struct {
+		name    string
+		backoff Backoff
+		want    []Slot
+	}{
+		{"slots", NewSlotBackoff(1, 10), []Slot{1, 2, 4, 8, 10, 10}},
+		{"epochs", NewEpochBackoff(1, 8, spec), []Slot{32, 64, 128, 256, 256}},
+		{"factor", Backoff{Initial: 1, Max: 100, Factor: 3}, []Slot{1, 3, 9, 27, 81, 100}},
+		{"default factor", Backoff{Initial: 2, Max: 5}, []Slot{2, 4, 5}},
+		{"uncapped", NewSlotBackoff(1, 0), []Slot{1, 2, 4, 8, 16}},
+		{"zero", NewSlotBackoff(0, 4), []Slot{0, 0, 0}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for attempt, want := range tt.want {
+				if got := tt.backoff.Delay(attempt); got != want {
+					t.Errorf("Unexpected delay at attempt %d: %v, want: %v", attempt, got, want)
+				}
+			}
+		})
+	}
+	if got := NewSlotBackoff(1, 0).Delay(100); got != FarFutureSlot {
+		t.Errorf("Unexpected overflowing delay: %v", got)
+	}
+	if got := NewSlotBackoff(4, 0).RetrySlot(FarFutureSlot-1, 0); got != FarFutureSlot {
+		t.Errorf("Unexpected overflowing retry slot: %v", got)
+	}
+}
+
+func TestBackoff_Next(t *testing.T) {
+	genesis := time.Date(2020, 12, 1, 12, 0, 23, 0, time.UTC)
+	now := genesis.Add(100*12*time.Second + 5*time.Second)
+	clock := NewClock(genesis, &ChainSpec{SlotsPerEpoch: 32, SecondsPerSlot: 12}).WithNow(func() time.Time { return now })
+	slot, wait := NewSlotBackoff(1, 8).Next(clock, 2)
+	if slot != 104 {
+		t.Errorf("Unexpected retry slot: %v", slot)
+	}
+	if wait != 4*12*time.Second-5*time.Second {
+		t.Errorf("Unexpected wait: %v", wait)
+	}
+	if got := clock.Until(100); got != -5*time.Second {
+		t.Errorf("Unexpected time until started slot: %v", got)
+	}
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/farazdagi/prysm-shared-types/mathutil"
+)
+
+// SumGwei returns total of the balances, an error wrapping mathutil.ErrOverflow is returned if
+// the total doesn't fit into uint64.
+func SumGwei(balances []Gwei) (Gwei, error) {
+	var counter BalanceCounter
+	counter.Add(balances...)
+	return counter.Total()
+}
+
+// BalanceCounter accumulates balances with overflow detection. Once overflown, the counter is
+// sticky: further additions are ignored and Total keeps returning the error.
+// The zero value is ready to use.
+type BalanceCounter struct {
+	total    Gwei
+	count    int
+	overflow bool
+}
+
+// Add adds balances to the total.
+func (c *BalanceCounter) Add(balances ...Gwei) {
+	if c.overflow {
+		return
+	}
+	for _, balance := range balances {
+		total, err := mathutil.SafeAdd(c.total, balance)
+		if err != nil {
+			c.overflow = true
+			return
+		}
+		c.total = total
+		c.count++
+	}
+}
+
+// Total returns accumulated total, an error wrapping mathutil.ErrOverflow is returned if total
+// overflew at some point.
+func (c *BalanceCounter) Total() (Gwei, error) {
+	if c.overflow {
+		return 0, fmt.Errorf("balance total: %w", mathutil.ErrOverflow)
+	}
+	return c.total, nil
+}
+
+// Count returns number of balances accumulated so far.
+func (c *BalanceCounter) Count() int {
+	return c.count
+}
+
+// Reset clears the counter.
+func (c *BalanceCounter) Reset() {
+	*c = BalanceCounter{}
+}
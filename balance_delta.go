@@ -0,0 +1,116 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// BalanceDelta represents signed change of balance in gwei (rewards are positive, penalties negative).
+type BalanceDelta int64
+
+// BalanceDeltaBetween returns delta moving balance from `from` to `to`, saturating at int64 bounds.
+func BalanceDeltaBetween(from, to Gwei) BalanceDelta {
+	if to >= from {
+		if diff := to - from; diff <= math.MaxInt64 {
+			return BalanceDelta(diff)
+		}
+		return math.MaxInt64
+	}
+	if diff := from - to; diff <= 1<<63 {
+		return BalanceDelta(-int64(diff-1)) - 1
+	}
+	return math.MinInt64
+}
+
+// ApplyTo returns balance adjusted by delta, saturating at zero and math.MaxUint64.
+func (d BalanceDelta) ApplyTo(balance Gwei) Gwei {
+	if d >= 0 {
+		if sum, err := balance.SafeAdd(uint64(d)); err == nil {
+			return sum
+		}
+		return math.MaxUint64
+	}
+	if abs := Gwei(absInt64(int64(d))); balance > abs {
+		return balance - abs
+	}
+	return 0
+}
+
+// String returns signed decimal representation of the delta.
+func (d BalanceDelta) String() string {
+	return strconv.FormatInt(int64(d), 10)
+}
+
+// MarshalJSON encodes delta as a quoted signed decimal string (as expected by the Beacon API).
+func (d BalanceDelta) MarshalJSON() ([]byte, error) {
+	data := make([]byte, 0, 22)
+	data = append(data, '"')
+	data = strconv.AppendInt(data, int64(d), 10)
+	return append(data, '"'), nil
+}
+
+// UnmarshalJSON decodes delta from either a quoted signed decimal string or a JSON number.
+func (d *BalanceDelta) UnmarshalJSON(data []byte) error {
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		data = data[1 : len(data)-1]
+	}
+	parsed, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return fmt.Errorf("could not parse balance delta: %w", err)
+	}
+	*d = BalanceDelta(parsed)
+	return nil
+}
+
+// HashTreeRoot returns calculated hash root (little-endian two's complement encoding, padded to 32 bytes).
+func (d BalanceDelta) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	binary.LittleEndian.PutUint64(root[:8], uint64(d))
+	return root, nil
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (d BalanceDelta) HashTreeRootInto(dst *[32]byte) error {
+	*dst = [32]byte{}
+	binary.LittleEndian.PutUint64(dst[:8], uint64(d))
+	return nil
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the delta object.
+func (d *BalanceDelta) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), d.SizeSSZ()); err != nil {
+		return err
+	}
+	*d = BalanceDelta(binary.LittleEndian.Uint64(buf))
+	return nil
+}
+
+// MarshalSSZTo marshals delta with the provided byte slice.
+func (d *BalanceDelta) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return Gwei(*d).AppendSSZ(dst), nil
+}
+
+// MarshalSSZ marshals delta into a serialized object.
+func (d *BalanceDelta) MarshalSSZ() ([]byte, error) {
+	return d.MarshalSSZTo(make([]byte, 0, d.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (d *BalanceDelta) SizeSSZ() int {
+	return 8
+}
+
+// WriteTo writes SSZ serialized delta to w.
+func (d *BalanceDelta) WriteTo(w io.Writer) (int64, error) {
+	return writeSSZTo(w, d)
+}
+
+// ReadFrom reads SSZ serialized delta from reader (exactly SizeSSZ bytes are consumed).
+func (d *BalanceDelta) ReadFrom(reader io.Reader) (int64, error) {
+	return readSSZFrom(reader, d)
+}
@@ -0,0 +1,15 @@
+//go:build !nofastssz
+
+package types
+
+import fssz "github.com/ferranbt/fastssz"
+
+var _ fssz.HashRoot = (BalanceDelta(0))
+var _ fssz.Marshaler = (*BalanceDelta)(nil)
+var _ fssz.Unmarshaler = (*BalanceDelta)(nil)
+
+// HashTreeRootWith appends delta to the provided hasher.
+func (d BalanceDelta) HashTreeRootWith(hh *fssz.Hasher) error {
+	hh.PutUint64(uint64(d))
+	return nil
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"math"
+	"testing"
+)
+
+func TestBalanceDelta_ApplyTo(t *testing.T) {
+	tests := []struct {
+		name    string
+		balance Gwei
+		delta   BalanceDelta
+		want    Gwei
+	}{
+		{name: "reward", balance: 100, delta: 5, want: 105},
+		{name: "penalty", balance: 100, delta: -5, want: 95},
+		{name: "penalty saturates at zero", balance: 3, delta: -5, want: 0},
+		{name: "reward saturates at max", balance: math.MaxUint64 - 1, delta: 5, want: math.MaxUint64},
+		{name: "min int64", balance: math.MaxUint64, delta: math.MinInt64, want: math.MaxUint64 - 1<<63},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.delta.ApplyTo(tt.balance); got != tt.want {
+				t.Errorf("ApplyTo() = %d, want %d", uint64(got), uint64(tt.want))
+			}
+		})
+	}
+}
+
+func TestBalanceDeltaBetween(t *testing.T) {
+	tests := []struct {
+		from, to Gwei
+		want     BalanceDelta
+	}{
+		{from: 10, to: 15, want: 5},
+		{from: 15, to: 10, want: -5},
+		{from: 0, to: math.MaxUint64, want: math.MaxInt64},
+		{from: math.MaxUint64, to: 0, want: math.MinInt64},
+		{from: 1 << 63, to: 0, want: math.MinInt64},
+	}
+	for _, tt := range tests {
+		if got := BalanceDeltaBetween(tt.from, tt.to); got != tt.want {
+			t.Errorf("BalanceDeltaBetween(%d, %d) = %d, want %d", uint64(tt.from), uint64(tt.to), got, tt.want)
+		}
+	}
+}
+
+func TestBalanceDelta_SSZ(t *testing.T) {
+	d := BalanceDelta(-42)
+	enc, err := d.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(enc) != 8 || enc[0] != 0xd6 || enc[7] != 0xff {
+		t.Errorf("Unexpected encoding: %x", enc)
+	}
+	var decoded BalanceDelta
+	if err := decoded.UnmarshalSSZ(enc); err != nil {
+		t.Fatal(err)
+	}
+	if decoded != d {
+		t.Errorf("Unexpected delta: %d", decoded)
+	}
+	root, _ := d.HashTreeRoot()
+	if root[0] != 0xd6 || root[8] != 0 {
+		t.Errorf("Unexpected root: %x", root)
+	}
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/farazdagi/prysm-shared-types/mathutil"
+)
+
+func TestSumGwei(t *testing.T) {
+	total, err := SumGwei([]Gwei{32 * GweiPerEth, 31 * GweiPerEth, 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if total != 63*GweiPerEth+1 {
+		t.Errorf("Unexpected total: %d", uint64(total))
+	}
+	if total, err := SumGwei(nil); err != nil || total != 0 {
+		t.Errorf("Unexpected total of empty slice: %d (%v)", uint64(total), err)
+	}
+	if _, err := SumGwei([]Gwei{math.MaxUint64, 1}); !errors.Is(err, mathutil.ErrOverflow) {
+		t.Errorf("Expected overflow, got: %v", err)
+	}
+}
+
+func TestBalanceCounter(t *testing.T) {
+	var c BalanceCounter
+	c.Add(1, 2)
+	c.Add(3)
+	if total, err := c.Total(); err != nil || total != 6 || c.Count() != 3 {
+		t.Errorf("Unexpected total: %d, count: %d (%v)", uint64(total), c.Count(), err)
+	}
+
+	c.Add(math.MaxUint64)
+	c.Add(1)
+	if _, err := c.Total(); !errors.Is(err, mathutil.ErrOverflow) {
+		t.Errorf("Expected sticky overflow, got: %v", err)
+	}
+
+	c.Reset()
+	if total, err := c.Total(); err != nil || total != 0 {
+		t.Errorf("Unexpected total after reset: %d (%v)", uint64(total), err)
+	}
+}
@@ -0,0 +1,99 @@
+package types
+
+import (
+	"math"
+	"math/big"
+)
+
+// BidValue is value of a builder bid (the payment to the proposer), denominated in wei.
+// It is encoded as a decimal string in JSON, as specified by the builder API.
+type BidValue struct {
+	Wei Uint256
+}
+
+// BidValueFromGwei returns bid value of the given gwei amount.
+func BidValueFromGwei(g Gwei) BidValue {
+	v, _ := Uint256FromBig(g.ToWei())
+	return BidValue{Wei: v}
+}
+
+// IsZero returns true if bid has no value.
+func (b BidValue) IsZero() bool {
+	return b.Wei.IsZero()
+}
+
+// Compare returns an integer comparing two bid values (-1, 0 or +1).
+func (b BidValue) Compare(x BidValue) int {
+	return b.Wei.Compare(x.Wei)
+}
+
+// IsHigherThan returns true if bid value is strictly higher than x.
+func (b BidValue) IsHigherThan(x BidValue) bool {
+	return b.Compare(x) > 0
+}
+
+// Gwei returns bid value in gwei, rounded down and saturating at max uint64.
+func (b BidValue) Gwei() Gwei {
+	g := b.Wei.Big()
+	g.Quo(g, big.NewInt(WeiPerGwei))
+	if !g.IsUint64() {
+		return Gwei(^uint64(0))
+	}
+	return Gwei(g.Uint64())
+}
+
+// ExceedsByPercent returns true if bid value is at least `percent` percent higher than x, i.e.
+// `b*100 >= x*(100+percent)`. Used when builder bids must beat local payloads by a margin.
+func (b BidValue) ExceedsByPercent(x BidValue, percent uint64) bool {
+	lhs := b.Wei.Big()
+	lhs.Mul(lhs, big.NewInt(100))
+	rhs := x.Wei.Big()
+	rhs.Mul(rhs, new(big.Int).Add(big.NewInt(100), new(big.Int).SetUint64(percent)))
+	return lhs.Cmp(rhs) >= 0
+}
+
+// ScaledByPercent returns bid value multiplied by `percent/100` (rounded down), e.g. to apply the
+// builder boost factor.
+func (b BidValue) ScaledByPercent(percent uint64) BidValue {
+	v := b.Wei.Big()
+	v.Mul(v, new(big.Int).SetUint64(percent))
+	v.Quo(v, big.NewInt(100))
+	scaled, err := Uint256FromBig(v)
+	if err != nil {
+		// Overflow is only possible for percent above 100, saturate.
+		return BidValue{Wei: Uint256{^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0)}}
+	}
+	return BidValue{Wei: scaled}
+}
+
+// PercentDiff returns the difference between bid value and x relative to x, in percent (e.g. 25
+// if bid is 1.25x of x, negative if bid is lower). Intended for logging and metrics only. If x is
+// zero, 0 is returned for zero bid and +Inf otherwise.
+func (b BidValue) PercentDiff(x BidValue) float64 {
+	if x.IsZero() {
+		if b.IsZero() {
+			return 0
+		}
+		return math.Inf(1)
+	}
+	diff := new(big.Float).SetInt(new(big.Int).Sub(b.Wei.Big(), x.Wei.Big()))
+	diff.Mul(diff, big.NewFloat(100))
+	diff.Quo(diff, new(big.Float).SetInt(x.Wei.Big()))
+	f, _ := diff.Float64()
+	return f
+}
+
+// String returns bid value in wei as a decimal string.
+func (b BidValue) String() string {
+	return b.Wei.String()
+}
+
+// MarshalText encodes bid value as a decimal string of wei.
+func (b BidValue) MarshalText() ([]byte, error) {
+	return b.Wei.MarshalText()
+}
+
+// UnmarshalText decodes bid value from a decimal string of wei.
+func (b *BidValue) UnmarshalText(text []byte) error {
+	return b.Wei.UnmarshalText(text)
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func TestBidValue_Compare(t *testing.T) {
+	local := BidValueFromGwei(1_000_000_000)
+	builder := BidValueFromGwei(1_100_000_000)
+	if !builder.IsHigherThan(local) || local.IsHigherThan(builder) || local.IsHigherThan(local) {
+		t.Error("Unexpected comparison")
+	}
+	if !builder.ExceedsByPercent(local, 10) || builder.ExceedsByPercent(local, 11) {
+		t.Error("Unexpected percent comparison")
+	}
+	if got := builder.PercentDiff(local); math.Abs(got-10) > 1e-9 {
+		t.Errorf("Unexpected percent diff: %v", got)
+	}
+	if got := local.PercentDiff(builder); got >= 0 {
+		t.Errorf("Unexpected percent diff: %v", got)
+	}
+	if got := builder.PercentDiff(BidValue{}); !math.IsInf(got, 1) {
+		t.Errorf("Unexpected percent diff: %v", got)
+	}
+	if got := builder.ScaledByPercent(50); got.Gwei() != 550_000_000 {
+		t.Errorf("Unexpected scaled value: %v", got)
+	}
+	if got := builder.Gwei(); got != 1_100_000_000 {
+		t.Errorf("Unexpected gwei value: %d", got)
+	}
+}
+
+func TestBidValue_JSON(t *testing.T) {
+	input := `{"value":"115792089237316195423570985008687907853269984665640564039457584007913129639935"}`
+	var bid struct {
+		Value BidValue `json:"value"`
+	}
+	if err := json.Unmarshal([]byte(input), &bid); err != nil {
+		t.Fatal(err)
+	}
+	if bid.Value.Wei != (Uint256{^uint64(0), ^uint64(0), ^uint64(0), ^uint64(0)}) {
+		t.Errorf("Unexpected value: %v", bid.Value)
+	}
+	if g := bid.Value.Gwei(); g != Gwei(math.MaxUint64) {
+		t.Errorf("Unexpected gwei value: %d", g)
+	}
+	enc, err := json.Marshal(bid)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(enc) != input {
+		t.Errorf("Unexpected JSON: %s", enc)
+	}
+	if err := json.Unmarshal([]byte(`{"value":"0x1"}`), &bid); err == nil {
+		t.Error("Expected error on hex value")
+	}
+}
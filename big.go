@@ -0,0 +1,73 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// ErrBigOutOfRange is returned when big integer is negative or doesn't fit into the target type.
+var ErrBigOutOfRange = errors.New("big integer out of range")
+
+// ToBig returns slot as a big integer.
+func (s Slot) ToBig() *big.Int {
+	return new(big.Int).SetUint64(uint64(s))
+}
+
+// FromBig sets slot to x, returns an error (leaving slot intact) if x is nil, negative or doesn't
+// fit into uint64.
+func (s *Slot) FromBig(x *big.Int) error {
+	return uint64FromBig(s, x)
+}
+
+// ToBig returns epoch as a big integer.
+func (e Epoch) ToBig() *big.Int {
+	return new(big.Int).SetUint64(uint64(e))
+}
+
+// FromBig sets epoch to x, returns an error (leaving epoch intact) if x is nil, negative or doesn't
+// fit into uint64.
+func (e *Epoch) FromBig(x *big.Int) error {
+	return uint64FromBig(e, x)
+}
+
+// ToBig returns amount in gwei as a big integer, see ToWei for amount in wei.
+func (g Gwei) ToBig() *big.Int {
+	return new(big.Int).SetUint64(uint64(g))
+}
+
+// FromBig sets amount to x gwei, returns an error (leaving amount intact) if x is nil, negative or
+// doesn't fit into uint64. See GweiFromWei for amounts in wei.
+func (g *Gwei) FromBig(x *big.Int) error {
+	return uint64FromBig(g, x)
+}
+
+// ToBig returns value as a big integer (same as Big).
+func (v Uint256) ToBig() *big.Int {
+	return v.Big()
+}
+
+// FromBig sets value to x, returns an error (leaving value intact) if x is nil, negative or doesn't
+// fit into 256 bits.
+func (v *Uint256) FromBig(x *big.Int) error {
+	if x == nil {
+		return fmt.Errorf("%w: nil", ErrBigOutOfRange)
+	}
+	parsed, err := Uint256FromBig(x)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrBigOutOfRange, err)
+	}
+	*v = parsed
+	return nil
+}
+
+func uint64FromBig[T Uint64Like](dst *T, x *big.Int) error {
+	if x == nil {
+		return fmt.Errorf("%w: nil", ErrBigOutOfRange)
+	}
+	if !x.IsUint64() {
+		return fmt.Errorf("%w: %s", ErrBigOutOfRange, x)
+	}
+	*dst = T(x.Uint64())
+	return nil
+}
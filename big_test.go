@@ -0,0 +1,60 @@
+package types
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+)
+
+func TestFromBig(t *testing.T) {
+	maxUint64 := new(big.Int).SetUint64(math.MaxUint64)
+	tooLarge := new(big.Int).Add(maxUint64, big.NewInt(1))
+	tests := []struct {
+		name    string
+		x       *big.Int
+		wantErr bool
+	}{
+		{name: "zero", x: big.NewInt(0)},
+		{name: "max", x: maxUint64},
+		{name: "too large", x: tooLarge, wantErr: true},
+		{name: "negative", x: big.NewInt(-1), wantErr: true},
+		{name: "nil", x: nil, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			slot, epoch, gwei := Slot(7), Epoch(7), Gwei(7)
+			errs := []error{slot.FromBig(tt.x), epoch.FromBig(tt.x), gwei.FromBig(tt.x)}
+			for _, err := range errs {
+				if tt.wantErr != errors.Is(err, ErrBigOutOfRange) {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+			}
+			if tt.wantErr {
+				if slot != 7 || epoch != 7 || gwei != 7 {
+					t.Error("Value must be left intact on error")
+				}
+				return
+			}
+			if slot.ToBig().Cmp(tt.x) != 0 || epoch.ToBig().Cmp(tt.x) != 0 || gwei.ToBig().Cmp(tt.x) != 0 {
+				t.Errorf("Unexpected round trip: %v, %v, %v", slot, epoch, gwei)
+			}
+		})
+	}
+}
+
+func TestUint256_FromBig(t *testing.T) {
+	x := new(big.Int).Lsh(big.NewInt(1), 200)
+	var v Uint256
+	if err := v.FromBig(x); err != nil {
+		t.Fatal(err)
+	}
+	if v.ToBig().Cmp(x) != 0 {
+		t.Errorf("Unexpected value: %v", v)
+	}
+	for _, x := range []*big.Int{nil, big.NewInt(-1), new(big.Int).Lsh(big.NewInt(1), 256)} {
+		if err := v.FromBig(x); !errors.Is(err, ErrBigOutOfRange) {
+			t.Errorf("Unexpected error for %v: %v", x, err)
+		}
+	}
+}
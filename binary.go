@@ -0,0 +1,76 @@
+package types
+
+import (
+	"encoding/binary"
+	"io"
+)
+
+// PutSlot encodes slot into dst using little-endian (SSZ) byte order, dst must be at least 8 bytes long.
+func PutSlot(dst []byte, s Slot) {
+	binary.LittleEndian.PutUint64(dst, uint64(s))
+}
+
+// PutSlotBigEndian encodes slot into dst using big-endian byte order (sortable, as used in DB keys).
+func PutSlotBigEndian(dst []byte, s Slot) {
+	binary.BigEndian.PutUint64(dst, uint64(s))
+}
+
+// ReadSlot reads little-endian (SSZ) encoded slot from r.
+func ReadSlot(r io.Reader) (Slot, error) {
+	return readUint64[Slot](r, binary.LittleEndian)
+}
+
+// ReadSlotBigEndian reads big-endian encoded slot from r.
+func ReadSlotBigEndian(r io.Reader) (Slot, error) {
+	return readUint64[Slot](r, binary.BigEndian)
+}
+
+// PutEpoch encodes epoch into dst using little-endian (SSZ) byte order, dst must be at least 8 bytes long.
+func PutEpoch(dst []byte, e Epoch) {
+	binary.LittleEndian.PutUint64(dst, uint64(e))
+}
+
+// PutEpochBigEndian encodes epoch into dst using big-endian byte order (sortable, as used in DB keys).
+func PutEpochBigEndian(dst []byte, e Epoch) {
+	binary.BigEndian.PutUint64(dst, uint64(e))
+}
+
+// ReadEpoch reads little-endian (SSZ) encoded epoch from r.
+func ReadEpoch(r io.Reader) (Epoch, error) {
+	return readUint64[Epoch](r, binary.LittleEndian)
+}
+
+// ReadEpochBigEndian reads big-endian encoded epoch from r.
+func ReadEpochBigEndian(r io.Reader) (Epoch, error) {
+	return readUint64[Epoch](r, binary.BigEndian)
+}
+
+// PutValidatorIndex encodes validator index into dst using little-endian (SSZ) byte order,
+// dst must be at least 8 bytes long.
+func PutValidatorIndex(dst []byte, v ValidatorIndex) {
+	binary.LittleEndian.PutUint64(dst, uint64(v))
+}
+
+// PutValidatorIndexBigEndian encodes validator index into dst using big-endian byte order.
+func PutValidatorIndexBigEndian(dst []byte, v ValidatorIndex) {
+	binary.BigEndian.PutUint64(dst, uint64(v))
+}
+
+// ReadValidatorIndex reads little-endian (SSZ) encoded validator index from r.
+func ReadValidatorIndex(r io.Reader) (ValidatorIndex, error) {
+	return readUint64[ValidatorIndex](r, binary.LittleEndian)
+}
+
+// ReadValidatorIndexBigEndian reads big-endian encoded validator index from r.
+func ReadValidatorIndexBigEndian(r io.Reader) (ValidatorIndex, error) {
+	return readUint64[ValidatorIndex](r, binary.BigEndian)
+}
+
+// readUint64 reads exactly 8 bytes from r and decodes them using the given byte order.
+func readUint64[T Uint64Like](r io.Reader, order binary.ByteOrder) (T, error) {
+	var buf [8]byte
+	if _, err := io.ReadFull(r, buf[:]); err != nil {
+		return 0, err
+	}
+	return T(order.Uint64(buf[:])), nil
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+)
+
+func TestPutRead(t *testing.T) {
+	buf := make([]byte, 8)
+
+	PutSlot(buf, 0x0102)
+	if !bytes.Equal(buf, []byte{0x02, 0x01, 0, 0, 0, 0, 0, 0}) {
+		t.Errorf("Unexpected little-endian encoding: %#x", buf)
+	}
+	if s, err := ReadSlot(bytes.NewReader(buf)); err != nil || s != 0x0102 {
+		t.Errorf("Unexpected slot: %v, %v", s, err)
+	}
+
+	PutEpochBigEndian(buf, 0x0102)
+	if !bytes.Equal(buf, []byte{0, 0, 0, 0, 0, 0, 0x01, 0x02}) {
+		t.Errorf("Unexpected big-endian encoding: %#x", buf)
+	}
+	if e, err := ReadEpochBigEndian(bytes.NewReader(buf)); err != nil || e != 0x0102 {
+		t.Errorf("Unexpected epoch: %v, %v", e, err)
+	}
+
+	PutValidatorIndex(buf, 42)
+	if v, err := ReadValidatorIndex(bytes.NewReader(buf)); err != nil || v != 42 {
+		t.Errorf("Unexpected validator index: %v, %v", v, err)
+	}
+
+	if _, err := ReadSlotBigEndian(bytes.NewReader(buf[:3])); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
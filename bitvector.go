@@ -0,0 +1,175 @@
+package types
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// Bitvector64 is an SSZ Bitvector[64], e.g. attestation subnets a node is subscribed to.
+// Bit i is stored in byte i/8 at position i%8.
+type Bitvector64 [8]byte
+
+// Len returns number of bits in the vector.
+func (b Bitvector64) Len() uint64 {
+	return 64
+}
+
+// BitAt returns value of the i-th bit, false if index is out of range.
+func (b Bitvector64) BitAt(i uint64) bool {
+	return i < b.Len() && b[i/8]&(1<<(i%8)) != 0
+}
+
+// SetBitAt sets value of the i-th bit, out of range indices are ignored.
+func (b *Bitvector64) SetBitAt(i uint64, v bool) {
+	if i < b.Len() {
+		setBit(b[:], i, v)
+	}
+}
+
+// Count returns number of set bits.
+func (b Bitvector64) Count() int {
+	return countBits(b[:])
+}
+
+// String returns 0x-prefixed hex representation of the vector.
+func (b Bitvector64) String() string {
+	return string(appendHex(nil, b[:]))
+}
+
+// MarshalText encodes vector as 0x-prefixed hex string.
+func (b Bitvector64) MarshalText() ([]byte, error) {
+	return appendHex(make([]byte, 0, 18), b[:]), nil
+}
+
+// UnmarshalText decodes vector from 0x-prefixed hex string.
+func (b *Bitvector64) UnmarshalText(text []byte) error {
+	return decodeHexInto(b[:], text)
+}
+
+// Bitvector512 is an SSZ Bitvector[512], e.g. sync committee participation bits (mainnet preset).
+type Bitvector512 [64]byte
+
+// Len returns number of bits in the vector.
+func (b Bitvector512) Len() uint64 {
+	return 512
+}
+
+// BitAt returns value of the i-th bit, false if index is out of range.
+func (b Bitvector512) BitAt(i uint64) bool {
+	return i < b.Len() && b[i/8]&(1<<(i%8)) != 0
+}
+
+// SetBitAt sets value of the i-th bit, out of range indices are ignored.
+func (b *Bitvector512) SetBitAt(i uint64, v bool) {
+	if i < b.Len() {
+		setBit(b[:], i, v)
+	}
+}
+
+// Count returns number of set bits.
+func (b Bitvector512) Count() int {
+	return countBits(b[:])
+}
+
+// String returns 0x-prefixed hex representation of the vector.
+func (b Bitvector512) String() string {
+	return string(appendHex(nil, b[:]))
+}
+
+// MarshalText encodes vector as 0x-prefixed hex string.
+func (b Bitvector512) MarshalText() ([]byte, error) {
+	return appendHex(make([]byte, 0, 130), b[:]), nil
+}
+
+// UnmarshalText decodes vector from 0x-prefixed hex string.
+func (b *Bitvector512) UnmarshalText(text []byte) error {
+	return decodeHexInto(b[:], text)
+}
+
+// HashTreeRoot returns calculated hash root (vector is merkleized as 2 chunks).
+func (b Bitvector512) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	err := b.HashTreeRootInto(&root)
+	return root, err
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (b Bitvector512) HashTreeRootInto(dst *[32]byte) error {
+	var chunks [2][32]byte
+	copy(chunks[0][:], b[:32])
+	copy(chunks[1][:], b[32:])
+	return merkleizeInto(dst, chunks[:], 0)
+}
+
+// Bitvector4 is an SSZ Bitvector[4], e.g. sync committee subnets a node is subscribed to.
+// Only the lower 4 bits of the byte are used, the rest must be zero.
+type Bitvector4 [1]byte
+
+// Len returns number of bits in the vector.
+func (b Bitvector4) Len() uint64 {
+	return 4
+}
+
+// BitAt returns value of the i-th bit, false if index is out of range.
+func (b Bitvector4) BitAt(i uint64) bool {
+	return i < b.Len() && b[0]&(1<<i) != 0
+}
+
+// SetBitAt sets value of the i-th bit, out of range indices are ignored.
+func (b *Bitvector4) SetBitAt(i uint64, v bool) {
+	if i < b.Len() {
+		setBit(b[:], i, v)
+	}
+}
+
+// Count returns number of set bits.
+func (b Bitvector4) Count() int {
+	return countBits(b[:])
+}
+
+// String returns 0x-prefixed hex representation of the vector.
+func (b Bitvector4) String() string {
+	return string(appendHex(nil, b[:]))
+}
+
+// MarshalText encodes vector as 0x-prefixed hex string.
+func (b Bitvector4) MarshalText() ([]byte, error) {
+	return appendHex(make([]byte, 0, 4), b[:]), nil
+}
+
+// UnmarshalText decodes vector from 0x-prefixed hex string, rejecting bits beyond vector length.
+func (b *Bitvector4) UnmarshalText(text []byte) error {
+	var v Bitvector4
+	if err := decodeHexInto(v[:], text); err != nil {
+		return err
+	}
+	if err := v.validate(); err != nil {
+		return err
+	}
+	*b = v
+	return nil
+}
+
+// validate checks that padding bits are zero.
+func (b Bitvector4) validate() error {
+	if b[0]>>4 != 0 {
+		return fmt.Errorf("bitvector %v has bits set beyond its length", b)
+	}
+	return nil
+}
+
+func setBit(b []byte, i uint64, v bool) {
+	if v {
+		b[i/8] |= 1 << (i % 8)
+	} else {
+		b[i/8] &^= 1 << (i % 8)
+	}
+}
+
+func countBits(b []byte) int {
+	n := 0
+	for _, x := range b {
+		n += bits.OnesCount8(x)
+	}
+	return n
+}
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// ErrInvalidBlobSchedule is returned when blob schedule has conflicting entries.
+var ErrInvalidBlobSchedule = errors.New("invalid blob schedule")
+
+// BlobScheduleEntry sets blob limit effective from the epoch onward.
+// Field names follow the BLOB_SCHEDULE entries of the chain config.
+type BlobScheduleEntry struct {
+	Epoch            Epoch  `json:"EPOCH" yaml:"EPOCH"`
+	MaxBlobsPerBlock uint64 `json:"MAX_BLOBS_PER_BLOCK,string" yaml:"MAX_BLOBS_PER_BLOCK"`
+}
+
+// BlobSchedule maps epochs to the maximum number of blobs per block, covering both fork-defined limits
+// (MAX_BLOBS_PER_BLOCK of Deneb and Electra) and blob-parameter-only forks (BLOB_SCHEDULE).
+// Entries are kept sorted by epoch.
+type BlobSchedule []BlobScheduleEntry
+
+// NewBlobSchedule creates schedule from entries in any order, rejecting duplicate epochs.
+func NewBlobSchedule(entries ...BlobScheduleEntry) (BlobSchedule, error) {
+	schedule := append(BlobSchedule(nil), entries...)
+	sort.Slice(schedule, func(i, j int) bool {
+		return schedule[i].Epoch < schedule[j].Epoch
+	})
+	for i := 1; i < len(schedule); i++ {
+		if schedule[i].Epoch == schedule[i-1].Epoch {
+			return nil, fmt.Errorf("%w: duplicate epoch %d", ErrInvalidBlobSchedule, schedule[i].Epoch)
+		}
+	}
+	return schedule, nil
+}
+
+// AtEpoch returns the maximum number of blobs per block at the epoch, zero before the first entry
+// (i.e. prior to Deneb).
+func (s BlobSchedule) AtEpoch(epoch Epoch) uint64 {
+	entry, _ := s.EntryAt(epoch)
+	return entry.MaxBlobsPerBlock
+}
+
+// EntryAt returns the latest entry effective at the epoch (i.e. blob parameters, see
+// get_blob_parameters), false before the first entry.
+func (s BlobSchedule) EntryAt(epoch Epoch) (BlobScheduleEntry, bool) {
+	i := sort.Search(len(s), func(i int) bool {
+		return s[i].Epoch > epoch
+	})
+	if i == 0 {
+		return BlobScheduleEntry{}, false
+	}
+	return s[i-1], true
+}
+
+// Max returns the highest blob limit across all entries.
+func (s BlobSchedule) Max() uint64 {
+	var limit uint64
+	for _, e := range s {
+		if e.MaxBlobsPerBlock > limit {
+			limit = e.MaxBlobsPerBlock
+		}
+	}
+	return limit
+}
+
+// UnmarshalJSON decodes schedule from the Beacon API config representation, sorting entries.
+func (s *BlobSchedule) UnmarshalJSON(data []byte) error {
+	var entries []BlobScheduleEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return err
+	}
+	return s.set(entries)
+}
+
+// UnmarshalYAML decodes schedule from the config.yaml representation, sorting entries.
+func (s *BlobSchedule) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var entries []BlobScheduleEntry
+	if err := unmarshal(&entries); err != nil {
+		return err
+	}
+	return s.set(entries)
+}
+
+func (s *BlobSchedule) set(entries []BlobScheduleEntry) error {
+	schedule, err := NewBlobSchedule(entries...)
+	if err != nil {
+		return err
+	}
+	*s = schedule
+	return nil
+}
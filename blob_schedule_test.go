@@ -0,0 +1,87 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"gopkg.in/yaml.v2"
+)
+
+func testBlobSchedule() BlobSchedule {
+	return BlobSchedule{
+		{Epoch: 269568, MaxBlobsPerBlock: 6},
+		{Epoch: 364032, MaxBlobsPerBlock: 9},
+		{Epoch: 412672, MaxBlobsPerBlock: 15},
+	}
+}
+
+func TestBlobSchedule_AtEpoch(t *testing.T) {
+	s, err := NewBlobSchedule(
+		BlobScheduleEntry{Epoch: 412672, MaxBlobsPerBlock: 15},
+		BlobScheduleEntry{Epoch: 269568, MaxBlobsPerBlock: 6},
+		BlobScheduleEntry{Epoch: 364032, MaxBlobsPerBlock: 9},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(s, testBlobSchedule()) {
+		t.Errorf("Unexpected schedule: %v", s)
+	}
+	for epoch, want := range map[Epoch]uint64{0: 0, 269567: 0, 269568: 6, 364031: 6, 364032: 9, FarFutureEpoch: 15} {
+		if got := s.AtEpoch(epoch); got != want {
+			t.Errorf("Unexpected blob limit at %d: %d", epoch, got)
+		}
+	}
+	if entry, ok := s.EntryAt(400000); !ok || entry != (BlobScheduleEntry{Epoch: 364032, MaxBlobsPerBlock: 9}) {
+		t.Errorf("Unexpected entry: %v, %v", entry, ok)
+	}
+	if _, ok := s.EntryAt(269567); ok {
+		t.Error("Unexpected entry before Deneb")
+	}
+	if s.Max() != 15 {
+		t.Errorf("Unexpected max blob limit: %d", s.Max())
+	}
+	_, err = NewBlobSchedule(BlobScheduleEntry{Epoch: 1, MaxBlobsPerBlock: 6}, BlobScheduleEntry{Epoch: 1, MaxBlobsPerBlock: 9})
+	if !errors.Is(err, ErrInvalidBlobSchedule) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestBlobSchedule_Config(t *testing.T) {
+	var config struct {
+		BlobSchedule BlobSchedule `yaml:"BLOB_SCHEDULE" json:"BLOB_SCHEDULE"`
+	}
+	input := `
+BLOB_SCHEDULE:
+  - EPOCH: 364032
+    MAX_BLOBS_PER_BLOCK: 9
+  - EPOCH: 269568
+    MAX_BLOBS_PER_BLOCK: 6
+  - EPOCH: 412672
+    MAX_BLOBS_PER_BLOCK: 15
+`
+	if err := yaml.Unmarshal([]byte(input), &config); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(config.BlobSchedule, testBlobSchedule()) {
+		t.Errorf("Unexpected schedule: %v", config.BlobSchedule)
+	}
+
+	enc, err := json.Marshal(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"BLOB_SCHEDULE":[{"EPOCH":"269568","MAX_BLOBS_PER_BLOCK":"6"},{"EPOCH":"364032","MAX_BLOBS_PER_BLOCK":"9"},{"EPOCH":"412672","MAX_BLOBS_PER_BLOCK":"15"}]}`
+	if string(enc) != want {
+		t.Errorf("Unexpected JSON: %s", enc)
+	}
+	config.BlobSchedule = nil
+	if err := json.Unmarshal(enc, &config); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(config.BlobSchedule, testBlobSchedule()) {
+		t.Errorf("Unexpected schedule: %v", config.BlobSchedule)
+	}
+}
@@ -0,0 +1,159 @@
+package types
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// BeaconBlockHeader is the spec BeaconBlockHeader container, identical across forks.
+type BeaconBlockHeader struct {
+	Slot          Slot           `json:"slot"`
+	ProposerIndex ValidatorIndex `json:"proposer_index"`
+	ParentRoot    BlockRoot      `json:"parent_root"`
+	StateRoot     StateRoot      `json:"state_root"`
+	BodyRoot      BodyRoot       `json:"body_root"`
+}
+
+// HashTreeRoot returns calculated hash root, which is the root of the block the header belongs to.
+func (h *BeaconBlockHeader) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	err := h.HashTreeRootInto(&root)
+	return root, err
+}
+
+// BlockRoot returns root of the block the header belongs to.
+func (h *BeaconBlockHeader) BlockRoot() (BlockRoot, error) {
+	root, err := h.HashTreeRoot()
+	return BlockRoot{root}, err
+}
+
+// AppendFieldRoots appends hash roots of header fields to dst.
+func (h *BeaconBlockHeader) AppendFieldRoots(dst [][32]byte) ([][32]byte, error) {
+	slot, _ := h.Slot.HashTreeRoot()
+	proposer, _ := h.ProposerIndex.HashTreeRoot()
+	return append(dst, slot, proposer, h.ParentRoot.Root, h.StateRoot.Root, h.BodyRoot.Root), nil
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (h *BeaconBlockHeader) HashTreeRootInto(dst *[32]byte) error {
+	var buf [5][32]byte
+	chunks, _ := h.AppendFieldRoots(buf[:0])
+	return merkleizeInto(dst, chunks, 0)
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the header object.
+func (h *BeaconBlockHeader) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), h.SizeSSZ()); err != nil {
+		return err
+	}
+	h.Slot = Slot(binary.LittleEndian.Uint64(buf[:8]))
+	h.ProposerIndex = ValidatorIndex(binary.LittleEndian.Uint64(buf[8:16]))
+	copy(h.ParentRoot.Root[:], buf[16:48])
+	copy(h.StateRoot.Root[:], buf[48:80])
+	copy(h.BodyRoot.Root[:], buf[80:112])
+	return nil
+}
+
+// MarshalSSZTo marshals header with the provided byte slice.
+func (h *BeaconBlockHeader) MarshalSSZTo(dst []byte) ([]byte, error) {
+	dst = h.Slot.AppendSSZ(dst)
+	dst = h.ProposerIndex.AppendSSZ(dst)
+	dst = append(dst, h.ParentRoot.Root[:]...)
+	dst = append(dst, h.StateRoot.Root[:]...)
+	return append(dst, h.BodyRoot.Root[:]...), nil
+}
+
+// MarshalSSZ marshals header into a serialized object.
+func (h *BeaconBlockHeader) MarshalSSZ() ([]byte, error) {
+	return h.MarshalSSZTo(make([]byte, 0, h.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (h *BeaconBlockHeader) SizeSSZ() int {
+	return 112
+}
+
+// WriteTo writes SSZ serialized header to w.
+func (h *BeaconBlockHeader) WriteTo(w io.Writer) (int64, error) {
+	return writeSSZTo(w, h)
+}
+
+// ReadFrom reads SSZ serialized header from reader (exactly SizeSSZ bytes are consumed).
+func (h *BeaconBlockHeader) ReadFrom(reader io.Reader) (int64, error) {
+	return readSSZFrom(reader, h)
+}
+
+// SignedBeaconBlockHeader is the spec SignedBeaconBlockHeader container.
+type SignedBeaconBlockHeader struct {
+	Message   BeaconBlockHeader `json:"message"`
+	Signature BLSSignature      `json:"signature"`
+}
+
+// HashTreeRoot returns calculated hash root.
+func (h *SignedBeaconBlockHeader) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	err := h.HashTreeRootInto(&root)
+	return root, err
+}
+
+// AppendFieldRoots appends hash roots of signed header fields to dst.
+func (h *SignedBeaconBlockHeader) AppendFieldRoots(dst [][32]byte) ([][32]byte, error) {
+	var chunks [2][32]byte
+	if err := h.Message.HashTreeRootInto(&chunks[0]); err != nil {
+		return dst, err
+	}
+	if err := h.Signature.HashTreeRootInto(&chunks[1]); err != nil {
+		return dst, err
+	}
+	return append(dst, chunks[:]...), nil
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (h *SignedBeaconBlockHeader) HashTreeRootInto(dst *[32]byte) error {
+	var buf [2][32]byte
+	chunks, err := h.AppendFieldRoots(buf[:0])
+	if err != nil {
+		return err
+	}
+	return merkleizeInto(dst, chunks, 0)
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the signed header object.
+func (h *SignedBeaconBlockHeader) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), h.SizeSSZ()); err != nil {
+		return err
+	}
+	if err := h.Message.UnmarshalSSZ(buf[:112]); err != nil {
+		return err
+	}
+	copy(h.Signature[:], buf[112:208])
+	return nil
+}
+
+// MarshalSSZTo marshals signed header with the provided byte slice.
+func (h *SignedBeaconBlockHeader) MarshalSSZTo(dst []byte) ([]byte, error) {
+	dst, _ = h.Message.MarshalSSZTo(dst)
+	return append(dst, h.Signature[:]...), nil
+}
+
+// MarshalSSZ marshals signed header into a serialized object.
+func (h *SignedBeaconBlockHeader) MarshalSSZ() ([]byte, error) {
+	return h.MarshalSSZTo(make([]byte, 0, h.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (h *SignedBeaconBlockHeader) SizeSSZ() int {
+	return 208
+}
+
+// WriteTo writes SSZ serialized signed header to w.
+func (h *SignedBeaconBlockHeader) WriteTo(w io.Writer) (int64, error) {
+	return writeSSZTo(w, h)
+}
+
+// ReadFrom reads SSZ serialized signed header from reader (exactly SizeSSZ bytes are consumed).
+func (h *SignedBeaconBlockHeader) ReadFrom(reader io.Reader) (int64, error) {
+	return readSSZFrom(reader, h)
+}
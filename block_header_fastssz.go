@@ -0,0 +1,32 @@
+//go:build !nofastssz
+
+package types
+
+import fssz "github.com/ferranbt/fastssz"
+
+var _ fssz.HashRoot = (*BeaconBlockHeader)(nil)
+var _ fssz.Marshaler = (*BeaconBlockHeader)(nil)
+var _ fssz.Unmarshaler = (*BeaconBlockHeader)(nil)
+var _ fssz.HashRoot = (*SignedBeaconBlockHeader)(nil)
+var _ fssz.Marshaler = (*SignedBeaconBlockHeader)(nil)
+var _ fssz.Unmarshaler = (*SignedBeaconBlockHeader)(nil)
+
+// HashTreeRootWith appends header root to the provided hasher.
+func (h *BeaconBlockHeader) HashTreeRootWith(hh *fssz.Hasher) error {
+	root, err := h.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	hh.PutBytes(root[:])
+	return nil
+}
+
+// HashTreeRootWith appends signed header root to the provided hasher.
+func (h *SignedBeaconBlockHeader) HashTreeRootWith(hh *fssz.Hasher) error {
+	root, err := h.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	hh.PutBytes(root[:])
+	return nil
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBlockHeader_SSZ(t *testing.T) {
+	h := testBlockHeader()
+	enc, err := h.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(enc) != 208 {
+		t.Errorf("Unexpected length: %d", len(enc))
+	}
+	decoded := &SignedBeaconBlockHeader{}
+	if err := decoded.UnmarshalSSZ(enc); err != nil {
+		t.Fatal(err)
+	}
+	if *decoded != *h {
+		t.Errorf("Unexpected header: %+v", decoded)
+	}
+}
+
+func TestBlockHeader_JSON(t *testing.T) {
+	h := testBlockHeader()
+	enc, err := json.Marshal(h)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded SignedBeaconBlockHeader
+	if err := json.Unmarshal(enc, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded != *h {
+		t.Errorf("Unexpected header: %+v", decoded)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(enc, &fields); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := fields["message"]; !ok || len(fields["signature"]) != 196 {
+		t.Errorf("Unexpected JSON: %s", enc)
+	}
+}
@@ -0,0 +1,131 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// BlockIDKind identifies the form of a Beacon API block or state identifier.
+type BlockIDKind uint8
+
+// Supported identifier kinds.
+const (
+	BlockIDHead BlockIDKind = iota
+	BlockIDGenesis
+	BlockIDFinalized
+	BlockIDJustified
+	BlockIDSlot
+	BlockIDRoot
+)
+
+var blockIDNames = [...]string{
+	BlockIDHead:      "head",
+	BlockIDGenesis:   "genesis",
+	BlockIDFinalized: "finalized",
+	BlockIDJustified: "justified",
+}
+
+// BlockID is a Beacon API block identifier: "head", "genesis", "finalized", "justified", a decimal
+// slot or a 0x-prefixed root.
+type BlockID struct {
+	kind BlockIDKind
+	slot Slot
+	root Root
+}
+
+// StateID is a Beacon API state identifier, which has the same form as BlockID (root is a state root).
+type StateID struct {
+	BlockID
+}
+
+// BlockIDFromSlot returns identifier referring to the given slot.
+func BlockIDFromSlot(slot Slot) BlockID {
+	return BlockID{kind: BlockIDSlot, slot: slot}
+}
+
+// BlockIDFromRoot returns identifier referring to the given root.
+func BlockIDFromRoot(root Root) BlockID {
+	return BlockID{kind: BlockIDRoot, root: root}
+}
+
+// ParseBlockID parses Beacon API block identifier.
+func ParseBlockID(s string) (BlockID, error) {
+	id, err := parseBlockID(s)
+	if err != nil {
+		return BlockID{}, fmt.Errorf("invalid block id %q: %w", s, err)
+	}
+	return id, nil
+}
+
+// ParseStateID parses Beacon API state identifier.
+func ParseStateID(s string) (StateID, error) {
+	id, err := parseBlockID(s)
+	if err != nil {
+		return StateID{}, fmt.Errorf("invalid state id %q: %w", s, err)
+	}
+	return StateID{id}, nil
+}
+
+// parseBlockID parses identifier shared by blocks and states, returning the underlying parse error.
+func parseBlockID(s string) (BlockID, error) {
+	for kind, name := range blockIDNames {
+		if s == name {
+			return BlockID{kind: BlockIDKind(kind)}, nil
+		}
+	}
+	if strings.HasPrefix(s, "0x") {
+		var root Root
+		if err := root.UnmarshalText([]byte(s)); err != nil {
+			return BlockID{}, err
+		}
+		return BlockIDFromRoot(root), nil
+	}
+	slot, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return BlockID{}, err
+	}
+	return BlockIDFromSlot(Slot(slot)), nil
+}
+
+// Kind returns the form of the identifier.
+func (id BlockID) Kind() BlockIDKind {
+	return id.kind
+}
+
+// Slot returns referenced slot, false is returned if identifier is not a slot.
+func (id BlockID) Slot() (Slot, bool) {
+	return id.slot, id.kind == BlockIDSlot
+}
+
+// Root returns referenced root, false is returned if identifier is not a root.
+func (id BlockID) Root() (Root, bool) {
+	return id.root, id.kind == BlockIDRoot
+}
+
+// String returns identifier in its Beacon API form.
+func (id BlockID) String() string {
+	switch id.kind {
+	case BlockIDSlot:
+		return strconv.FormatUint(uint64(id.slot), 10)
+	case BlockIDRoot:
+		return id.root.String()
+	default:
+		return blockIDNames[id.kind]
+	}
+}
+
+// MarshalText encodes identifier in its Beacon API form.
+func (id BlockID) MarshalText() ([]byte, error) {
+	return []byte(id.String()), nil
+}
+
+// UnmarshalText decodes identifier from its Beacon API form.
+func (id *BlockID) UnmarshalText(text []byte) error {
+	parsed, err := ParseBlockID(string(text))
+	if err != nil {
+		return err
+	}
+	*id = parsed
+	return nil
+}
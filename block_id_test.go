@@ -0,0 +1,65 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestParseBlockID(t *testing.T) {
+	rootHex := "0x" + strings.Repeat("ab", 32)
+	for _, input := range []string{"head", "genesis", "finalized", "justified", "12345", rootHex} {
+		id, err := ParseBlockID(input)
+		if err != nil {
+			t.Fatalf("Could not parse %q: %v", input, err)
+		}
+		if id.String() != input {
+			t.Errorf("Unexpected round trip: %q -> %q", input, id.String())
+		}
+	}
+
+	id, _ := ParseBlockID("12345")
+	if slot, ok := id.Slot(); !ok || slot != 12345 || id.Kind() != BlockIDSlot {
+		t.Errorf("Unexpected slot: %d", slot)
+	}
+	if _, ok := id.Root(); ok {
+		t.Error("Slot identifier should not have root")
+	}
+	id, _ = ParseBlockID(rootHex)
+	if root, ok := id.Root(); !ok || root[0] != 0xab {
+		t.Errorf("Unexpected root: %v", root)
+	}
+
+	for _, input := range []string{"", "Head", "-1", "0x1234", "18446744073709551616"} {
+		if _, err := ParseBlockID(input); err == nil {
+			t.Errorf("Expected error for %q", input)
+		}
+		if _, err := ParseStateID(input); err == nil {
+			t.Errorf("Expected error for %q", input)
+		}
+	}
+	if _, err := ParseStateID("18446744073709551616"); !errors.Is(err, strconv.ErrRange) {
+		t.Errorf("Underlying parse error must be wrapped: %v", err)
+	}
+}
+
+func TestStateID_JSON(t *testing.T) {
+	var decoded struct {
+		ID StateID `json:"state_id"`
+	}
+	if err := json.Unmarshal([]byte(`{"state_id":"finalized"}`), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.ID.Kind() != BlockIDFinalized {
+		t.Errorf("Unexpected kind: %v", decoded.ID.Kind())
+	}
+	enc, err := json.Marshal(decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(enc) != `{"state_id":"finalized"}` {
+		t.Errorf("Unexpected JSON: %s", enc)
+	}
+}
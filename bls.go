@@ -0,0 +1,16 @@
+package types
+
+// BLSPubkey represents a 48 byte compressed BLS public key.
+type BLSPubkey Bytes48
+
+// BLSSignature represents a 96 byte compressed BLS signature.
+type BLSSignature Bytes96
+
+// InfiniteSignature is the compressed G2 point at infinity, the only valid signature of an empty
+// aggregate (see spec's G2_POINT_AT_INFINITY).
+var InfiniteSignature = BLSSignature{0xc0}
+
+// IsInfinite returns true if signature is the G2 point at infinity.
+func (s BLSSignature) IsInfinite() bool {
+	return s == InfiniteSignature
+}
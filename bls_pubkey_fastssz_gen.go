@@ -0,0 +1,17 @@
+// Code generated by typegen. DO NOT EDIT.
+
+//go:build !nofastssz
+
+package types
+
+import fssz "github.com/ferranbt/fastssz"
+
+var _ fssz.HashRoot = (BLSPubkey{})
+var _ fssz.Marshaler = (*BLSPubkey)(nil)
+var _ fssz.Unmarshaler = (*BLSPubkey)(nil)
+
+// HashTreeRootWith appends public key to the provided hasher.
+func (p BLSPubkey) HashTreeRootWith(hh *fssz.Hasher) error {
+	hh.PutBytes(p[:])
+	return nil
+}
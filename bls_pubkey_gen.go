@@ -0,0 +1,84 @@
+// Code generated by typegen. DO NOT EDIT.
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// NewBLSPubkey copies b into BLSPubkey, returns an error if b is not exactly 48 bytes long.
+func NewBLSPubkey(b []byte) (BLSPubkey, error) {
+	var v BLSPubkey
+	if len(b) != len(v) {
+		return v, fmt.Errorf("%w: expected 48 bytes, got %d", ErrInvalidBytesLength, len(b))
+	}
+	copy(v[:], b)
+	return v, nil
+}
+
+// Equal returns true if both values hold the same bytes.
+func (p BLSPubkey) Equal(x BLSPubkey) bool {
+	return p == x
+}
+
+// IsZero returns true if all bytes of the public key are zero.
+func (p BLSPubkey) IsZero() bool {
+	return p == BLSPubkey{}
+}
+
+// String returns 0x-prefixed hex representation of the public key.
+func (p BLSPubkey) String() string {
+	return string(appendHex(nil, p[:]))
+}
+
+// MarshalText encodes public key as 0x-prefixed hex string.
+func (p BLSPubkey) MarshalText() ([]byte, error) {
+	return appendHex(make([]byte, 0, 98), p[:]), nil
+}
+
+// UnmarshalText decodes public key from 0x-prefixed hex string.
+func (p *BLSPubkey) UnmarshalText(text []byte) error {
+	return decodeHexInto(p[:], text)
+}
+
+// HashTreeRoot returns calculated hash root (public key is merkleized as a vector of 2 chunks).
+func (p BLSPubkey) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	err := p.HashTreeRootInto(&root)
+	return root, err
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (p BLSPubkey) HashTreeRootInto(dst *[32]byte) error {
+	var chunks [2][32]byte
+	for i := range chunks {
+		copy(chunks[i][:], p[i*32:])
+	}
+	return merkleizeInto(dst, chunks[:], 0)
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the public key object.
+func (p *BLSPubkey) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), p.SizeSSZ()); err != nil {
+		return err
+	}
+	copy(p[:], buf)
+	return nil
+}
+
+// MarshalSSZTo marshals public key with the provided byte slice.
+func (p *BLSPubkey) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return append(dst, p[:]...), nil
+}
+
+// MarshalSSZ marshals public key into a serialized object.
+func (p *BLSPubkey) MarshalSSZ() ([]byte, error) {
+	return append(make([]byte, 0, 48), p[:]...), nil
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (p *BLSPubkey) SizeSSZ() int {
+	return 48
+}
@@ -0,0 +1,17 @@
+// Code generated by typegen. DO NOT EDIT.
+
+//go:build !nofastssz
+
+package types
+
+import fssz "github.com/ferranbt/fastssz"
+
+var _ fssz.HashRoot = (BLSSignature{})
+var _ fssz.Marshaler = (*BLSSignature)(nil)
+var _ fssz.Unmarshaler = (*BLSSignature)(nil)
+
+// HashTreeRootWith appends signature to the provided hasher.
+func (s BLSSignature) HashTreeRootWith(hh *fssz.Hasher) error {
+	hh.PutBytes(s[:])
+	return nil
+}
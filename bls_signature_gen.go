@@ -0,0 +1,84 @@
+// Code generated by typegen. DO NOT EDIT.
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// NewBLSSignature copies b into BLSSignature, returns an error if b is not exactly 96 bytes long.
+func NewBLSSignature(b []byte) (BLSSignature, error) {
+	var v BLSSignature
+	if len(b) != len(v) {
+		return v, fmt.Errorf("%w: expected 96 bytes, got %d", ErrInvalidBytesLength, len(b))
+	}
+	copy(v[:], b)
+	return v, nil
+}
+
+// Equal returns true if both values hold the same bytes.
+func (s BLSSignature) Equal(x BLSSignature) bool {
+	return s == x
+}
+
+// IsZero returns true if all bytes of the signature are zero.
+func (s BLSSignature) IsZero() bool {
+	return s == BLSSignature{}
+}
+
+// String returns 0x-prefixed hex representation of the signature.
+func (s BLSSignature) String() string {
+	return string(appendHex(nil, s[:]))
+}
+
+// MarshalText encodes signature as 0x-prefixed hex string.
+func (s BLSSignature) MarshalText() ([]byte, error) {
+	return appendHex(make([]byte, 0, 194), s[:]), nil
+}
+
+// UnmarshalText decodes signature from 0x-prefixed hex string.
+func (s *BLSSignature) UnmarshalText(text []byte) error {
+	return decodeHexInto(s[:], text)
+}
+
+// HashTreeRoot returns calculated hash root (signature is merkleized as a vector of 3 chunks).
+func (s BLSSignature) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	err := s.HashTreeRootInto(&root)
+	return root, err
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (s BLSSignature) HashTreeRootInto(dst *[32]byte) error {
+	var chunks [3][32]byte
+	for i := range chunks {
+		copy(chunks[i][:], s[i*32:])
+	}
+	return merkleizeInto(dst, chunks[:], 0)
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the signature object.
+func (s *BLSSignature) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), s.SizeSSZ()); err != nil {
+		return err
+	}
+	copy(s[:], buf)
+	return nil
+}
+
+// MarshalSSZTo marshals signature with the provided byte slice.
+func (s *BLSSignature) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return append(dst, s[:]...), nil
+}
+
+// MarshalSSZ marshals signature into a serialized object.
+func (s *BLSSignature) MarshalSSZ() ([]byte, error) {
+	return append(make([]byte, 0, 96), s[:]...), nil
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (s *BLSSignature) SizeSSZ() int {
+	return 96
+}
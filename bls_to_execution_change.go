@@ -0,0 +1,165 @@
+package types
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// BLSToExecutionChange is the spec (Capella) BLSToExecutionChange container: request to switch
+// validator's BLS withdrawal credentials to an execution address.
+type BLSToExecutionChange struct {
+	ValidatorIndex     ValidatorIndex   `json:"validator_index"`
+	FromBLSPubkey      BLSPubkey        `json:"from_bls_pubkey"`
+	ToExecutionAddress ExecutionAddress `json:"to_execution_address"`
+}
+
+// SignedBLSToExecutionChange is the spec SignedBLSToExecutionChange container.
+type SignedBLSToExecutionChange struct {
+	Message   BLSToExecutionChange `json:"message"`
+	Signature BLSSignature         `json:"signature"`
+}
+
+// SigningRoot returns root signed with the withdrawal key. Credential changes are valid across forks:
+// domain is computed using the genesis fork version and genesis validators root of the chain.
+func (c *BLSToExecutionChange) SigningRoot(genesisForkVersion ForkVersion, genesisValidatorsRoot Root) (Root, error) {
+	domain, err := ComputeDomain(DomainBLSToExecutionChange, genesisForkVersion, genesisValidatorsRoot)
+	if err != nil {
+		return Root{}, err
+	}
+	return ComputeSigningRoot(c, domain)
+}
+
+// HashTreeRoot returns calculated hash root.
+func (c *BLSToExecutionChange) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	err := c.HashTreeRootInto(&root)
+	return root, err
+}
+
+// AppendFieldRoots appends hash roots of change fields to dst.
+func (c *BLSToExecutionChange) AppendFieldRoots(dst [][32]byte) ([][32]byte, error) {
+	var chunks [3][32]byte
+	_ = c.ValidatorIndex.HashTreeRootInto(&chunks[0])
+	if err := c.FromBLSPubkey.HashTreeRootInto(&chunks[1]); err != nil {
+		return dst, err
+	}
+	_ = c.ToExecutionAddress.HashTreeRootInto(&chunks[2])
+	return append(dst, chunks[:]...), nil
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (c *BLSToExecutionChange) HashTreeRootInto(dst *[32]byte) error {
+	var buf [3][32]byte
+	chunks, err := c.AppendFieldRoots(buf[:0])
+	if err != nil {
+		return err
+	}
+	return merkleizeInto(dst, chunks, 0)
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the credential change object.
+func (c *BLSToExecutionChange) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), c.SizeSSZ()); err != nil {
+		return err
+	}
+	c.ValidatorIndex = ValidatorIndex(binary.LittleEndian.Uint64(buf[:8]))
+	copy(c.FromBLSPubkey[:], buf[8:56])
+	copy(c.ToExecutionAddress[:], buf[56:76])
+	return nil
+}
+
+// MarshalSSZTo marshals credential change with the provided byte slice.
+func (c *BLSToExecutionChange) MarshalSSZTo(dst []byte) ([]byte, error) {
+	dst = c.ValidatorIndex.AppendSSZ(dst)
+	dst = append(dst, c.FromBLSPubkey[:]...)
+	return append(dst, c.ToExecutionAddress[:]...), nil
+}
+
+// MarshalSSZ marshals credential change into a serialized object.
+func (c *BLSToExecutionChange) MarshalSSZ() ([]byte, error) {
+	return c.MarshalSSZTo(make([]byte, 0, c.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (c *BLSToExecutionChange) SizeSSZ() int {
+	return 76
+}
+
+// WriteTo writes SSZ serialized credential change to w.
+func (c *BLSToExecutionChange) WriteTo(w io.Writer) (int64, error) {
+	return writeSSZTo(w, c)
+}
+
+// ReadFrom reads SSZ serialized credential change from reader (exactly SizeSSZ bytes are consumed).
+func (c *BLSToExecutionChange) ReadFrom(reader io.Reader) (int64, error) {
+	return readSSZFrom(reader, c)
+}
+
+// HashTreeRoot returns calculated hash root.
+func (c *SignedBLSToExecutionChange) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	err := c.HashTreeRootInto(&root)
+	return root, err
+}
+
+// AppendFieldRoots appends hash roots of signed change fields to dst.
+func (c *SignedBLSToExecutionChange) AppendFieldRoots(dst [][32]byte) ([][32]byte, error) {
+	var chunks [2][32]byte
+	if err := c.Message.HashTreeRootInto(&chunks[0]); err != nil {
+		return dst, err
+	}
+	if err := c.Signature.HashTreeRootInto(&chunks[1]); err != nil {
+		return dst, err
+	}
+	return append(dst, chunks[:]...), nil
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (c *SignedBLSToExecutionChange) HashTreeRootInto(dst *[32]byte) error {
+	var buf [2][32]byte
+	chunks, err := c.AppendFieldRoots(buf[:0])
+	if err != nil {
+		return err
+	}
+	return merkleizeInto(dst, chunks, 0)
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the signed credential change object.
+func (c *SignedBLSToExecutionChange) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), c.SizeSSZ()); err != nil {
+		return err
+	}
+	if err := c.Message.UnmarshalSSZ(buf[:76]); err != nil {
+		return err
+	}
+	copy(c.Signature[:], buf[76:172])
+	return nil
+}
+
+// MarshalSSZTo marshals signed credential change with the provided byte slice.
+func (c *SignedBLSToExecutionChange) MarshalSSZTo(dst []byte) ([]byte, error) {
+	dst, _ = c.Message.MarshalSSZTo(dst)
+	return append(dst, c.Signature[:]...), nil
+}
+
+// MarshalSSZ marshals signed credential change into a serialized object.
+func (c *SignedBLSToExecutionChange) MarshalSSZ() ([]byte, error) {
+	return c.MarshalSSZTo(make([]byte, 0, c.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (c *SignedBLSToExecutionChange) SizeSSZ() int {
+	return 172
+}
+
+// WriteTo writes SSZ serialized signed credential change to w.
+func (c *SignedBLSToExecutionChange) WriteTo(w io.Writer) (int64, error) {
+	return writeSSZTo(w, c)
+}
+
+// ReadFrom reads SSZ serialized signed credential change from reader (exactly SizeSSZ bytes are consumed).
+func (c *SignedBLSToExecutionChange) ReadFrom(reader io.Reader) (int64, error) {
+	return readSSZFrom(reader, c)
+}
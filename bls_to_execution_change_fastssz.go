@@ -0,0 +1,32 @@
+//go:build !nofastssz
+
+package types
+
+import fssz "github.com/ferranbt/fastssz"
+
+var _ fssz.HashRoot = (*BLSToExecutionChange)(nil)
+var _ fssz.Marshaler = (*BLSToExecutionChange)(nil)
+var _ fssz.Unmarshaler = (*BLSToExecutionChange)(nil)
+var _ fssz.HashRoot = (*SignedBLSToExecutionChange)(nil)
+var _ fssz.Marshaler = (*SignedBLSToExecutionChange)(nil)
+var _ fssz.Unmarshaler = (*SignedBLSToExecutionChange)(nil)
+
+// HashTreeRootWith appends credential change root to the provided hasher.
+func (c *BLSToExecutionChange) HashTreeRootWith(hh *fssz.Hasher) error {
+	root, err := c.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	hh.PutBytes(root[:])
+	return nil
+}
+
+// HashTreeRootWith appends signed credential change root to the provided hasher.
+func (c *SignedBLSToExecutionChange) HashTreeRootWith(hh *fssz.Hasher) error {
+	root, err := c.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	hh.PutBytes(root[:])
+	return nil
+}
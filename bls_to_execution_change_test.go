@@ -0,0 +1,64 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBLSToExecutionChange_SSZ(t *testing.T) {
+	c := testBLSToExecutionChange()
+	enc, err := c.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(enc) != 172 {
+		t.Errorf("Unexpected length: %d", len(enc))
+	}
+	decoded := &SignedBLSToExecutionChange{}
+	if err := decoded.UnmarshalSSZ(enc); err != nil {
+		t.Fatal(err)
+	}
+	if *decoded != *c {
+		t.Errorf("Unexpected change: %+v", decoded)
+	}
+}
+
+func TestBLSToExecutionChange_JSON(t *testing.T) {
+	c := testBLSToExecutionChange()
+	enc, err := json.Marshal(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded SignedBLSToExecutionChange
+	if err := json.Unmarshal(enc, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded != *c {
+		t.Errorf("Unexpected change: %+v", decoded)
+	}
+	var fields struct {
+		Message map[string]string `json:"message"`
+	}
+	if err := json.Unmarshal(enc, &fields); err != nil {
+		t.Fatal(err)
+	}
+	if fields.Message["validator_index"] != "42" || fields.Message["to_execution_address"] != "0xdead000000000000000000000000000000000000" {
+		t.Errorf("Unexpected JSON: %s", enc)
+	}
+}
+
+func TestBLSToExecutionChange_SigningRoot(t *testing.T) {
+	c := &testBLSToExecutionChange().Message
+	gvr := Root{0x4b}
+	domain, err := ComputeDomain(DomainBLSToExecutionChange, ForkVersion{}, gvr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := ComputeSigningRoot(c, domain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := c.SigningRoot(ForkVersion{}, gvr); err != nil || got != want {
+		t.Errorf("Unexpected signing root: %v, %v", got, err)
+	}
+}
@@ -0,0 +1,164 @@
+// Package bsonconv provides BSON encoding of shared types (go.mongodb.org/mongo-driver), for
+// indexers persisting beacon data into MongoDB.
+//
+// Methods can't be attached to shared types outside of their package, so the package defines
+// counterparts implementing bson.ValueMarshaler and bson.ValueUnmarshaler, to be used as field
+// types of stored documents:
+//
+//	type blockDoc struct {
+//		Slot bsonconv.Slot `bson:"slot"`
+//		Root bsonconv.Root `bson:"root"`
+//	}
+//	doc := blockDoc{Slot: bsonconv.Slot(slot), Root: bsonconv.Root(root)}
+//
+// Numeric types are stored as int64, as BSON has no unsigned integers: values above
+// math.MaxInt64 (e.g. FarFutureEpoch) fail to marshal instead of being stored negative. Roots are
+// stored as generic binary.
+//
+// The package is a separate module, so that the driver is only pulled in by its consumers.
+package bsonconv
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/x/bsonx/bsoncore"
+
+	types "github.com/farazdagi/prysm-shared-types"
+	"github.com/farazdagi/prysm-shared-types/mathutil"
+)
+
+// ErrInvalidValue is returned when BSON value has unexpected type or can't be represented.
+var ErrInvalidValue = errors.New("invalid bson value")
+
+// Slot is types.Slot stored as BSON int64.
+type Slot types.Slot
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+func (s Slot) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return marshalUint64(uint64(s))
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+func (s *Slot) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	v, err := unmarshalUint64(t, data)
+	if err != nil {
+		return err
+	}
+	*s = Slot(v)
+	return nil
+}
+
+// Epoch is types.Epoch stored as BSON int64.
+type Epoch types.Epoch
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+func (e Epoch) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return marshalUint64(uint64(e))
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+func (e *Epoch) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	v, err := unmarshalUint64(t, data)
+	if err != nil {
+		return err
+	}
+	*e = Epoch(v)
+	return nil
+}
+
+// ValidatorIndex is types.ValidatorIndex stored as BSON int64.
+type ValidatorIndex types.ValidatorIndex
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+func (i ValidatorIndex) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return marshalUint64(uint64(i))
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+func (i *ValidatorIndex) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	v, err := unmarshalUint64(t, data)
+	if err != nil {
+		return err
+	}
+	*i = ValidatorIndex(v)
+	return nil
+}
+
+// Gwei is types.Gwei stored as BSON int64.
+type Gwei types.Gwei
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+func (g Gwei) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return marshalUint64(uint64(g))
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+func (g *Gwei) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	v, err := unmarshalUint64(t, data)
+	if err != nil {
+		return err
+	}
+	*g = Gwei(v)
+	return nil
+}
+
+// Root is types.Root stored as BSON generic binary.
+type Root types.Root
+
+// MarshalBSONValue implements bson.ValueMarshaler.
+func (r Root) MarshalBSONValue() (bsontype.Type, []byte, error) {
+	return bsontype.Binary, bsoncore.AppendBinary(nil, bsontype.BinaryGeneric, r[:]), nil
+}
+
+// UnmarshalBSONValue implements bson.ValueUnmarshaler.
+func (r *Root) UnmarshalBSONValue(t bsontype.Type, data []byte) error {
+	if t != bsontype.Binary {
+		return fmt.Errorf("%w: %s, want %s", ErrInvalidValue, t, bsontype.Binary)
+	}
+	subtype, bin, rem, ok := bsoncore.ReadBinary(data)
+	if !ok || len(rem) != 0 {
+		return fmt.Errorf("%w: malformed binary", ErrInvalidValue)
+	}
+	if subtype != bsontype.BinaryGeneric || len(bin) != len(r) {
+		return fmt.Errorf("%w: binary of subtype %#x and %d bytes, want %d byte root", ErrInvalidValue, subtype, len(bin), len(r))
+	}
+	copy(r[:], bin)
+	return nil
+}
+
+// marshalUint64 encodes v as int64, failing if it doesn't fit.
+func marshalUint64(v uint64) (bsontype.Type, []byte, error) {
+	if v > math.MaxInt64 {
+		return 0, nil, fmt.Errorf("%w: %d doesn't fit into int64", mathutil.ErrOverflow, v)
+	}
+	return bsontype.Int64, bsoncore.AppendInt64(nil, int64(v)), nil
+}
+
+// unmarshalUint64 decodes non-negative int64 or int32 value.
+func unmarshalUint64(t bsontype.Type, data []byte) (uint64, error) {
+	var (
+		v   int64
+		rem []byte
+		ok  bool
+	)
+	switch t {
+	case bsontype.Int64:
+		v, rem, ok = bsoncore.ReadInt64(data)
+	case bsontype.Int32:
+		var v32 int32
+		v32, rem, ok = bsoncore.ReadInt32(data)
+		v = int64(v32)
+	default:
+		return 0, fmt.Errorf("%w: %s, want %s", ErrInvalidValue, t, bsontype.Int64)
+	}
+	if !ok || len(rem) != 0 {
+		return 0, fmt.Errorf("%w: malformed %s", ErrInvalidValue, t)
+	}
+	if v < 0 {
+		return 0, fmt.Errorf("%w: negative value %d", ErrInvalidValue, v)
+	}
+	return uint64(v), nil
+}
@@ -0,0 +1,99 @@
+package bsonconv
+
+import (
+	"bytes"
+	"errors"
+	"math"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+
+	types "github.com/farazdagi/prysm-shared-types"
+	"github.com/farazdagi/prysm-shared-types/mathutil"
+)
+
+type blockDoc struct {
+	Slot     Slot           `bson:"slot"`
+	Epoch    Epoch          `bson:"epoch"`
+	Proposer ValidatorIndex `bson:"proposer"`
+	Balance  Gwei           `bson:"balance"`
+	Root     Root           `bson:"root"`
+}
+
+func TestMarshal(t *testing.T) {
+	doc := blockDoc{
+		Slot:     Slot(types.Slot(math.MaxInt64)),
+		Epoch:    7,
+		Proposer: 42,
+		Balance:  32e9,
+		Root:     Root(types.Root{0x01, 31: 0xff}),
+	}
+	data, err := bson.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	raw := bson.Raw(data)
+	for key, want := range map[string]int64{"slot": math.MaxInt64, "epoch": 7, "proposer": 42, "balance": 32e9} {
+		v := raw.Lookup(key)
+		if got, ok := v.Int64OK(); !ok || got != want {
+			t.Errorf("Unexpected %q value: %v, want int64 %d", key, v, want)
+		}
+	}
+	if subtype, bin, ok := raw.Lookup("root").BinaryOK(); !ok || subtype != bsontype.BinaryGeneric || !bytes.Equal(bin, doc.Root[:]) {
+		t.Errorf("Unexpected root value: %v", raw.Lookup("root"))
+	}
+
+	var decoded blockDoc
+	if err := bson.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded != doc {
+		t.Errorf("Unexpected decoded document: %+v, want %+v", decoded, doc)
+	}
+
+	for _, doc := range []interface{}{
+		blockDoc{Slot: math.MaxUint64},
+		blockDoc{Epoch: Epoch(types.FarFutureEpoch)},
+		blockDoc{Balance: math.MaxInt64 + 1},
+	} {
+		if _, err := bson.Marshal(doc); !errors.Is(err, mathutil.ErrOverflow) {
+			t.Errorf("Unexpected error for %+v: %v", doc, err)
+		}
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	// Small numbers are often stored as int32 by other tools.
+	data, err := bson.Marshal(bson.D{{Key: "slot", Value: int32(5)}, {Key: "balance", Value: int64(1)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc blockDoc
+	if err := bson.Unmarshal(data, &doc); err != nil {
+		t.Fatal(err)
+	}
+	if doc.Slot != 5 || doc.Balance != 1 {
+		t.Errorf("Unexpected document: %+v", doc)
+	}
+
+	for _, d := range []bson.D{
+		{{Key: "slot", Value: int64(-1)}},
+		{{Key: "epoch", Value: int32(-1)}},
+		{{Key: "proposer", Value: "42"}},
+		{{Key: "balance", Value: 1.5}},
+		{{Key: "root", Value: primitive.Binary{Subtype: bsontype.BinaryGeneric, Data: make([]byte, 20)}}},
+		{{Key: "root", Value: primitive.Binary{Subtype: bsontype.BinaryUUID, Data: make([]byte, 32)}}},
+		{{Key: "root", Value: "0x00"}},
+	} {
+		data, err := bson.Marshal(d)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := bson.Unmarshal(data, &doc); !errors.Is(err, ErrInvalidValue) {
+			t.Errorf("Unexpected error for %v: %v", d, err)
+		}
+	}
+}
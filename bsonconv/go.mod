@@ -0,0 +1,23 @@
+module github.com/farazdagi/prysm-shared-types/bsonconv
+
+go 1.18
+
+require github.com/farazdagi/prysm-shared-types v0.0.0
+
+require (
+	github.com/bahlo/generic-list-go v0.2.0 // indirect
+	github.com/buger/jsonparser v1.1.1 // indirect
+	github.com/ferranbt/fastssz v0.0.0-20201020132831-68dc48984fd3 // indirect
+	github.com/invopop/jsonschema v0.13.0 // indirect
+	github.com/klauspost/cpuid/v2 v2.0.9 // indirect
+	github.com/mailru/easyjson v0.7.7 // indirect
+	github.com/minio/sha256-simd v1.0.0 // indirect
+	github.com/mitchellh/mapstructure v1.3.2 // indirect
+	github.com/prysmaticlabs/gohashtree v0.0.3-alpha // indirect
+	github.com/wk8/go-ordered-map/v2 v2.1.8 // indirect
+	go.mongodb.org/mongo-driver v1.17.10
+	gopkg.in/yaml.v2 v2.4.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/farazdagi/prysm-shared-types => ../
@@ -0,0 +1,82 @@
+// Code generated by typegen. DO NOT EDIT.
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// NewBytes20 copies b into Bytes20, returns an error if b is not exactly 20 bytes long.
+func NewBytes20(b []byte) (Bytes20, error) {
+	var v Bytes20
+	if len(b) != len(v) {
+		return v, fmt.Errorf("%w: expected 20 bytes, got %d", ErrInvalidBytesLength, len(b))
+	}
+	copy(v[:], b)
+	return v, nil
+}
+
+// Equal returns true if both values hold the same bytes.
+func (b Bytes20) Equal(x Bytes20) bool {
+	return b == x
+}
+
+// IsZero returns true if all bytes of the byte vector are zero.
+func (b Bytes20) IsZero() bool {
+	return b == Bytes20{}
+}
+
+// String returns 0x-prefixed hex representation of the byte vector.
+func (b Bytes20) String() string {
+	return string(appendHex(nil, b[:]))
+}
+
+// MarshalText encodes byte vector as 0x-prefixed hex string.
+func (b Bytes20) MarshalText() ([]byte, error) {
+	return appendHex(make([]byte, 0, 42), b[:]), nil
+}
+
+// UnmarshalText decodes byte vector from 0x-prefixed hex string.
+func (b *Bytes20) UnmarshalText(text []byte) error {
+	return decodeHexInto(b[:], text)
+}
+
+// HashTreeRoot returns calculated hash root (byte vector right-padded to 32 bytes).
+func (b Bytes20) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	copy(root[:], b[:])
+	return root, nil
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (b Bytes20) HashTreeRootInto(dst *[32]byte) error {
+	*dst = [32]byte{}
+	copy(dst[:], b[:])
+	return nil
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the byte vector object.
+func (b *Bytes20) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), b.SizeSSZ()); err != nil {
+		return err
+	}
+	copy(b[:], buf)
+	return nil
+}
+
+// MarshalSSZTo marshals byte vector with the provided byte slice.
+func (b *Bytes20) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return append(dst, b[:]...), nil
+}
+
+// MarshalSSZ marshals byte vector into a serialized object.
+func (b *Bytes20) MarshalSSZ() ([]byte, error) {
+	return append(make([]byte, 0, 20), b[:]...), nil
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (b *Bytes20) SizeSSZ() int {
+	return 20
+}
@@ -0,0 +1,79 @@
+// Code generated by typegen. DO NOT EDIT.
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// NewBytes32 copies b into Bytes32, returns an error if b is not exactly 32 bytes long.
+func NewBytes32(b []byte) (Bytes32, error) {
+	var v Bytes32
+	if len(b) != len(v) {
+		return v, fmt.Errorf("%w: expected 32 bytes, got %d", ErrInvalidBytesLength, len(b))
+	}
+	copy(v[:], b)
+	return v, nil
+}
+
+// Equal returns true if both values hold the same bytes.
+func (b Bytes32) Equal(x Bytes32) bool {
+	return b == x
+}
+
+// IsZero returns true if all bytes of the byte vector are zero.
+func (b Bytes32) IsZero() bool {
+	return b == Bytes32{}
+}
+
+// String returns 0x-prefixed hex representation of the byte vector.
+func (b Bytes32) String() string {
+	return string(appendHex(nil, b[:]))
+}
+
+// MarshalText encodes byte vector as 0x-prefixed hex string.
+func (b Bytes32) MarshalText() ([]byte, error) {
+	return appendHex(make([]byte, 0, 66), b[:]), nil
+}
+
+// UnmarshalText decodes byte vector from 0x-prefixed hex string.
+func (b *Bytes32) UnmarshalText(text []byte) error {
+	return decodeHexInto(b[:], text)
+}
+
+// HashTreeRoot returns calculated hash root (which is the byte vector itself).
+func (b Bytes32) HashTreeRoot() ([32]byte, error) {
+	return b, nil
+}
+
+// HashTreeRootInto writes hash root (the byte vector itself) into dst.
+func (b Bytes32) HashTreeRootInto(dst *[32]byte) error {
+	*dst = b
+	return nil
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the byte vector object.
+func (b *Bytes32) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), b.SizeSSZ()); err != nil {
+		return err
+	}
+	copy(b[:], buf)
+	return nil
+}
+
+// MarshalSSZTo marshals byte vector with the provided byte slice.
+func (b *Bytes32) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return append(dst, b[:]...), nil
+}
+
+// MarshalSSZ marshals byte vector into a serialized object.
+func (b *Bytes32) MarshalSSZ() ([]byte, error) {
+	return append(make([]byte, 0, 32), b[:]...), nil
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (b *Bytes32) SizeSSZ() int {
+	return 32
+}
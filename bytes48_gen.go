@@ -0,0 +1,84 @@
+// Code generated by typegen. DO NOT EDIT.
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// NewBytes48 copies b into Bytes48, returns an error if b is not exactly 48 bytes long.
+func NewBytes48(b []byte) (Bytes48, error) {
+	var v Bytes48
+	if len(b) != len(v) {
+		return v, fmt.Errorf("%w: expected 48 bytes, got %d", ErrInvalidBytesLength, len(b))
+	}
+	copy(v[:], b)
+	return v, nil
+}
+
+// Equal returns true if both values hold the same bytes.
+func (b Bytes48) Equal(x Bytes48) bool {
+	return b == x
+}
+
+// IsZero returns true if all bytes of the byte vector are zero.
+func (b Bytes48) IsZero() bool {
+	return b == Bytes48{}
+}
+
+// String returns 0x-prefixed hex representation of the byte vector.
+func (b Bytes48) String() string {
+	return string(appendHex(nil, b[:]))
+}
+
+// MarshalText encodes byte vector as 0x-prefixed hex string.
+func (b Bytes48) MarshalText() ([]byte, error) {
+	return appendHex(make([]byte, 0, 98), b[:]), nil
+}
+
+// UnmarshalText decodes byte vector from 0x-prefixed hex string.
+func (b *Bytes48) UnmarshalText(text []byte) error {
+	return decodeHexInto(b[:], text)
+}
+
+// HashTreeRoot returns calculated hash root (byte vector is merkleized as a vector of 2 chunks).
+func (b Bytes48) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	err := b.HashTreeRootInto(&root)
+	return root, err
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (b Bytes48) HashTreeRootInto(dst *[32]byte) error {
+	var chunks [2][32]byte
+	for i := range chunks {
+		copy(chunks[i][:], b[i*32:])
+	}
+	return merkleizeInto(dst, chunks[:], 0)
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the byte vector object.
+func (b *Bytes48) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), b.SizeSSZ()); err != nil {
+		return err
+	}
+	copy(b[:], buf)
+	return nil
+}
+
+// MarshalSSZTo marshals byte vector with the provided byte slice.
+func (b *Bytes48) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return append(dst, b[:]...), nil
+}
+
+// MarshalSSZ marshals byte vector into a serialized object.
+func (b *Bytes48) MarshalSSZ() ([]byte, error) {
+	return append(make([]byte, 0, 48), b[:]...), nil
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (b *Bytes48) SizeSSZ() int {
+	return 48
+}
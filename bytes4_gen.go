@@ -0,0 +1,82 @@
+// Code generated by typegen. DO NOT EDIT.
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// NewBytes4 copies b into Bytes4, returns an error if b is not exactly 4 bytes long.
+func NewBytes4(b []byte) (Bytes4, error) {
+	var v Bytes4
+	if len(b) != len(v) {
+		return v, fmt.Errorf("%w: expected 4 bytes, got %d", ErrInvalidBytesLength, len(b))
+	}
+	copy(v[:], b)
+	return v, nil
+}
+
+// Equal returns true if both values hold the same bytes.
+func (b Bytes4) Equal(x Bytes4) bool {
+	return b == x
+}
+
+// IsZero returns true if all bytes of the byte vector are zero.
+func (b Bytes4) IsZero() bool {
+	return b == Bytes4{}
+}
+
+// String returns 0x-prefixed hex representation of the byte vector.
+func (b Bytes4) String() string {
+	return string(appendHex(nil, b[:]))
+}
+
+// MarshalText encodes byte vector as 0x-prefixed hex string.
+func (b Bytes4) MarshalText() ([]byte, error) {
+	return appendHex(make([]byte, 0, 10), b[:]), nil
+}
+
+// UnmarshalText decodes byte vector from 0x-prefixed hex string.
+func (b *Bytes4) UnmarshalText(text []byte) error {
+	return decodeHexInto(b[:], text)
+}
+
+// HashTreeRoot returns calculated hash root (byte vector right-padded to 32 bytes).
+func (b Bytes4) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	copy(root[:], b[:])
+	return root, nil
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (b Bytes4) HashTreeRootInto(dst *[32]byte) error {
+	*dst = [32]byte{}
+	copy(dst[:], b[:])
+	return nil
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the byte vector object.
+func (b *Bytes4) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), b.SizeSSZ()); err != nil {
+		return err
+	}
+	copy(b[:], buf)
+	return nil
+}
+
+// MarshalSSZTo marshals byte vector with the provided byte slice.
+func (b *Bytes4) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return append(dst, b[:]...), nil
+}
+
+// MarshalSSZ marshals byte vector into a serialized object.
+func (b *Bytes4) MarshalSSZ() ([]byte, error) {
+	return append(make([]byte, 0, 4), b[:]...), nil
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (b *Bytes4) SizeSSZ() int {
+	return 4
+}
@@ -0,0 +1,17 @@
+// Code generated by typegen. DO NOT EDIT.
+
+//go:build !nofastssz
+
+package types
+
+import fssz "github.com/ferranbt/fastssz"
+
+var _ fssz.HashRoot = (Bytes8{})
+var _ fssz.Marshaler = (*Bytes8)(nil)
+var _ fssz.Unmarshaler = (*Bytes8)(nil)
+
+// HashTreeRootWith appends byte vector to the provided hasher.
+func (b Bytes8) HashTreeRootWith(hh *fssz.Hasher) error {
+	hh.PutBytes(b[:])
+	return nil
+}
@@ -0,0 +1,82 @@
+// Code generated by typegen. DO NOT EDIT.
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// NewBytes8 copies b into Bytes8, returns an error if b is not exactly 8 bytes long.
+func NewBytes8(b []byte) (Bytes8, error) {
+	var v Bytes8
+	if len(b) != len(v) {
+		return v, fmt.Errorf("%w: expected 8 bytes, got %d", ErrInvalidBytesLength, len(b))
+	}
+	copy(v[:], b)
+	return v, nil
+}
+
+// Equal returns true if both values hold the same bytes.
+func (b Bytes8) Equal(x Bytes8) bool {
+	return b == x
+}
+
+// IsZero returns true if all bytes of the byte vector are zero.
+func (b Bytes8) IsZero() bool {
+	return b == Bytes8{}
+}
+
+// String returns 0x-prefixed hex representation of the byte vector.
+func (b Bytes8) String() string {
+	return string(appendHex(nil, b[:]))
+}
+
+// MarshalText encodes byte vector as 0x-prefixed hex string.
+func (b Bytes8) MarshalText() ([]byte, error) {
+	return appendHex(make([]byte, 0, 18), b[:]), nil
+}
+
+// UnmarshalText decodes byte vector from 0x-prefixed hex string.
+func (b *Bytes8) UnmarshalText(text []byte) error {
+	return decodeHexInto(b[:], text)
+}
+
+// HashTreeRoot returns calculated hash root (byte vector right-padded to 32 bytes).
+func (b Bytes8) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	copy(root[:], b[:])
+	return root, nil
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (b Bytes8) HashTreeRootInto(dst *[32]byte) error {
+	*dst = [32]byte{}
+	copy(dst[:], b[:])
+	return nil
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the byte vector object.
+func (b *Bytes8) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), b.SizeSSZ()); err != nil {
+		return err
+	}
+	copy(b[:], buf)
+	return nil
+}
+
+// MarshalSSZTo marshals byte vector with the provided byte slice.
+func (b *Bytes8) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return append(dst, b[:]...), nil
+}
+
+// MarshalSSZ marshals byte vector into a serialized object.
+func (b *Bytes8) MarshalSSZ() ([]byte, error) {
+	return append(make([]byte, 0, 8), b[:]...), nil
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (b *Bytes8) SizeSSZ() int {
+	return 8
+}
@@ -0,0 +1,84 @@
+// Code generated by typegen. DO NOT EDIT.
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// NewBytes96 copies b into Bytes96, returns an error if b is not exactly 96 bytes long.
+func NewBytes96(b []byte) (Bytes96, error) {
+	var v Bytes96
+	if len(b) != len(v) {
+		return v, fmt.Errorf("%w: expected 96 bytes, got %d", ErrInvalidBytesLength, len(b))
+	}
+	copy(v[:], b)
+	return v, nil
+}
+
+// Equal returns true if both values hold the same bytes.
+func (b Bytes96) Equal(x Bytes96) bool {
+	return b == x
+}
+
+// IsZero returns true if all bytes of the byte vector are zero.
+func (b Bytes96) IsZero() bool {
+	return b == Bytes96{}
+}
+
+// String returns 0x-prefixed hex representation of the byte vector.
+func (b Bytes96) String() string {
+	return string(appendHex(nil, b[:]))
+}
+
+// MarshalText encodes byte vector as 0x-prefixed hex string.
+func (b Bytes96) MarshalText() ([]byte, error) {
+	return appendHex(make([]byte, 0, 194), b[:]), nil
+}
+
+// UnmarshalText decodes byte vector from 0x-prefixed hex string.
+func (b *Bytes96) UnmarshalText(text []byte) error {
+	return decodeHexInto(b[:], text)
+}
+
+// HashTreeRoot returns calculated hash root (byte vector is merkleized as a vector of 3 chunks).
+func (b Bytes96) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	err := b.HashTreeRootInto(&root)
+	return root, err
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (b Bytes96) HashTreeRootInto(dst *[32]byte) error {
+	var chunks [3][32]byte
+	for i := range chunks {
+		copy(chunks[i][:], b[i*32:])
+	}
+	return merkleizeInto(dst, chunks[:], 0)
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the byte vector object.
+func (b *Bytes96) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), b.SizeSSZ()); err != nil {
+		return err
+	}
+	copy(b[:], buf)
+	return nil
+}
+
+// MarshalSSZTo marshals byte vector with the provided byte slice.
+func (b *Bytes96) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return append(dst, b[:]...), nil
+}
+
+// MarshalSSZ marshals byte vector into a serialized object.
+func (b *Bytes96) MarshalSSZ() ([]byte, error) {
+	return append(make([]byte, 0, 96), b[:]...), nil
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (b *Bytes96) SizeSSZ() int {
+	return 96
+}
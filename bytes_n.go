@@ -0,0 +1,20 @@
+package types
+
+import "errors"
+
+// ErrInvalidBytesLength is returned when slice length doesn't match the length of a fixed-size byte type.
+var ErrInvalidBytesLength = errors.New("invalid bytes length")
+
+// Fixed-size byte vectors (SSZ ByteVector[N]), encoded as 0x-prefixed hex in JSON and text. They are
+// the common foundation of fixed-size byte types: ForkVersion, ForkDigest, DomainType,
+// ExecutionAddress, Root, Domain, NodeID, BLSPubkey and BLSSignature are declared on top of them, and
+// share the method set generated by `typegen -bytes` (see generate.go), to which they add their own
+// methods.
+type (
+	Bytes4  [4]byte
+	Bytes8  [8]byte
+	Bytes20 [20]byte
+	Bytes32 [32]byte
+	Bytes48 [48]byte
+	Bytes96 [96]byte
+)
@@ -0,0 +1,131 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestNewBytesN(t *testing.T) {
+	b, err := NewBytes4([]byte{0x01, 0x02, 0x03, 0x04})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !b.Equal(Bytes4{0x01, 0x02, 0x03, 0x04}) || b.IsZero() {
+		t.Errorf("Unexpected bytes: %v", b)
+	}
+	if _, err := NewBytes32(make([]byte, 31)); !errors.Is(err, ErrInvalidBytesLength) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if _, err := NewBytes96(make([]byte, 97)); !errors.Is(err, ErrInvalidBytesLength) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if v, err := NewBytes48(make([]byte, 48)); err != nil || !v.IsZero() {
+		t.Errorf("Unexpected result: %v, %v", v, err)
+	}
+}
+
+func TestBytesN_JSON(t *testing.T) {
+	type container struct {
+		Version Bytes4  `json:"version"`
+		Root    Bytes32 `json:"root"`
+	}
+	c := container{Version: Bytes4{0x01, 0x00, 0x00, 0xff}, Root: Bytes32(Root{0xaa})}
+	data, err := json.Marshal(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"version":"0x010000ff","root":"0xaa` + "00000000000000000000000000000000000000000000000000000000000000" + `"}`
+	if string(data) != want {
+		t.Errorf("Unexpected encoding: %s", data)
+	}
+	var decoded container
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded != c {
+		t.Errorf("Unexpected round trip: %+v", decoded)
+	}
+	if err := json.Unmarshal([]byte(`{"version":"0x0100"}`), &decoded); err == nil {
+		t.Error("Expected error for short hex")
+	}
+	if s := (Bytes8{0xab}).String(); s != "0xab00000000000000" {
+		t.Errorf("Unexpected string: %v", s)
+	}
+}
+
+func TestBytesN_SSZ(t *testing.T) {
+	b := Bytes20{0x01, 0x02}
+	buf, err := b.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(buf) != b.SizeSSZ() {
+		t.Errorf("Unexpected size: %d", len(buf))
+	}
+	var decoded Bytes20
+	if err := decoded.UnmarshalSSZ(buf); err != nil {
+		t.Fatal(err)
+	}
+	if decoded != b || ExecutionAddress(decoded) != (ExecutionAddress{0x01, 0x02}) {
+		t.Errorf("Unexpected round trip: %v", decoded)
+	}
+	if err := decoded.UnmarshalSSZ(buf[:19]); !errors.Is(err, ErrInvalidSSZLength) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	sig := Bytes96(InfiniteSignature)
+	want, err := InfiniteSignature.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := sig.HashTreeRoot(); err != nil || got != want {
+		t.Errorf("Unexpected signature root: %#x, want %#x", got, want)
+	}
+}
+
+func TestBytesN_NamedTypes(t *testing.T) {
+	type byteVector interface {
+		String() string
+		MarshalText() ([]byte, error)
+		HashTreeRoot() ([32]byte, error)
+	}
+	tests := []struct {
+		named, base byteVector
+	}{
+		{named: ForkVersion{0x01}, base: Bytes4{0x01}},
+		{named: ForkDigest{0x02}, base: Bytes4{0x02}},
+		{named: DomainType{0x03}, base: Bytes4{0x03}},
+		{named: ExecutionAddress{0x04}, base: Bytes20{0x04}},
+		{named: Root{0x05}, base: Bytes32{0x05}},
+		{named: Domain{0x06}, base: Bytes32{0x06}},
+		{named: NodeID{0x07}, base: Bytes32{0x07}},
+		{named: BLSPubkey{0x08}, base: Bytes48{0x08}},
+		{named: BLSSignature{0x09}, base: Bytes96{0x09}},
+	}
+	for _, tt := range tests {
+		text, _ := tt.named.MarshalText()
+		baseText, _ := tt.base.MarshalText()
+		root, _ := tt.named.HashTreeRoot()
+		baseRoot, _ := tt.base.HashTreeRoot()
+		if tt.named.String() != tt.base.String() || string(text) != string(baseText) || root != baseRoot {
+			t.Errorf("%T encodes differently from %T: %s, %#x", tt.named, tt.base, text, root)
+		}
+	}
+
+	if _, err := NewBLSPubkey(make([]byte, 47)); !errors.Is(err, ErrInvalidBytesLength) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	pubkey, err := NewBLSPubkey(append([]byte{0xaa}, make([]byte, 47)...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf, err := pubkey.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded BLSPubkey
+	if err := decoded.UnmarshalSSZ(buf); err != nil || !decoded.Equal(pubkey) {
+		t.Errorf("Unexpected round trip: %v, %v", decoded, err)
+	}
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// Checkpoint is the spec Checkpoint container: epoch along with the root of its boundary block.
+type Checkpoint struct {
+	Epoch Epoch     `json:"epoch"`
+	Root  BlockRoot `json:"root"`
+}
+
+// HashTreeRoot returns calculated hash root.
+func (c *Checkpoint) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	err := c.HashTreeRootInto(&root)
+	return root, err
+}
+
+// AppendFieldRoots appends hash roots of checkpoint fields to dst.
+func (c *Checkpoint) AppendFieldRoots(dst [][32]byte) ([][32]byte, error) {
+	epoch, _ := c.Epoch.HashTreeRoot()
+	return append(dst, epoch, c.Root.Root), nil
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (c *Checkpoint) HashTreeRootInto(dst *[32]byte) error {
+	var buf [2][32]byte
+	chunks, _ := c.AppendFieldRoots(buf[:0])
+	return merkleizeInto(dst, chunks, 0)
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the checkpoint object.
+func (c *Checkpoint) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), c.SizeSSZ()); err != nil {
+		return err
+	}
+	c.Epoch = Epoch(binary.LittleEndian.Uint64(buf[:8]))
+	copy(c.Root.Root[:], buf[8:40])
+	return nil
+}
+
+// MarshalSSZTo marshals checkpoint with the provided byte slice.
+func (c *Checkpoint) MarshalSSZTo(dst []byte) ([]byte, error) {
+	dst = c.Epoch.AppendSSZ(dst)
+	return append(dst, c.Root.Root[:]...), nil
+}
+
+// MarshalSSZ marshals checkpoint into a serialized object.
+func (c *Checkpoint) MarshalSSZ() ([]byte, error) {
+	return c.MarshalSSZTo(make([]byte, 0, c.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (c *Checkpoint) SizeSSZ() int {
+	return 40
+}
+
+// WriteTo writes SSZ serialized checkpoint to w.
+func (c *Checkpoint) WriteTo(w io.Writer) (int64, error) {
+	return writeSSZTo(w, c)
+}
+
+// ReadFrom reads SSZ serialized checkpoint from reader (exactly SizeSSZ bytes are consumed).
+func (c *Checkpoint) ReadFrom(reader io.Reader) (int64, error) {
+	return readSSZFrom(reader, c)
+}
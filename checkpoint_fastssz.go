@@ -0,0 +1,19 @@
+//go:build !nofastssz
+
+package types
+
+import fssz "github.com/ferranbt/fastssz"
+
+var _ fssz.HashRoot = (*Checkpoint)(nil)
+var _ fssz.Marshaler = (*Checkpoint)(nil)
+var _ fssz.Unmarshaler = (*Checkpoint)(nil)
+
+// HashTreeRootWith appends checkpoint root to the provided hasher.
+func (c *Checkpoint) HashTreeRootWith(hh *fssz.Hasher) error {
+	root, err := c.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	hh.PutBytes(root[:])
+	return nil
+}
@@ -0,0 +1,31 @@
+package types
+
+// ValidatorChurnLimit returns the number of validators allowed to enter or exit per epoch,
+// see get_validator_churn_limit.
+func ValidatorChurnLimit(activeCount uint64, spec *ChainSpec) uint64 {
+	return Max(spec.MinPerEpochChurnLimit, specDiv(spec, activeCount, spec.ChurnLimitQuotient))
+}
+
+// ActivationChurnLimit returns the number of validators allowed to be activated per epoch,
+// see get_validator_activation_churn_limit (Deneb).
+func ActivationChurnLimit(activeCount uint64, spec *ChainSpec) uint64 {
+	return Min(spec.MaxPerEpochActivationChurnLimit, ValidatorChurnLimit(activeCount, spec))
+}
+
+// BalanceChurnLimit returns the balance allowed to churn per epoch, see get_balance_churn_limit (Electra).
+func BalanceChurnLimit(totalActiveBalance Gwei, spec *ChainSpec) Gwei {
+	churn := Max(spec.MinPerEpochChurnLimitElectra, specDiv(spec, totalActiveBalance, Gwei(spec.ChurnLimitQuotient)))
+	return churn.RoundToIncrement(spec)
+}
+
+// ActivationExitChurnLimit returns the balance allowed to be activated or exited per epoch,
+// see get_activation_exit_churn_limit (Electra).
+func ActivationExitChurnLimit(totalActiveBalance Gwei, spec *ChainSpec) Gwei {
+	return Min(spec.MaxPerEpochActivationExitChurnLimit, BalanceChurnLimit(totalActiveBalance, spec))
+}
+
+// ConsolidationChurnLimit returns the balance allowed to be consolidated per epoch,
+// see get_consolidation_churn_limit (Electra).
+func ConsolidationChurnLimit(totalActiveBalance Gwei, spec *ChainSpec) Gwei {
+	return BalanceChurnLimit(totalActiveBalance, spec) - ActivationExitChurnLimit(totalActiveBalance, spec)
+}
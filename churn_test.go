@@ -0,0 +1,56 @@
+package types
+
+import "testing"
+
+func testChurnSpec() *ChainSpec {
+	spec := testBalanceSpec()
+	spec.MinPerEpochChurnLimit = 4
+	spec.ChurnLimitQuotient = 65536
+	spec.MaxPerEpochActivationChurnLimit = 8
+	spec.MinPerEpochChurnLimitElectra = 128 * GweiPerEth
+	spec.MaxPerEpochActivationExitChurnLimit = 256 * GweiPerEth
+	return spec
+}
+
+func TestValidatorChurnLimit(t *testing.T) {
+	spec := testChurnSpec()
+	tests := []struct {
+		active            uint64
+		churn, activation uint64
+	}{
+		{active: 0, churn: 4, activation: 4},
+		{active: 327_680, churn: 5, activation: 5},
+		{active: 1_000_000, churn: 15, activation: 8},
+	}
+	for _, tt := range tests {
+		if got := ValidatorChurnLimit(tt.active, spec); got != tt.churn {
+			t.Errorf("ValidatorChurnLimit(%d) = %d, want %d", tt.active, got, tt.churn)
+		}
+		if got := ActivationChurnLimit(tt.active, spec); got != tt.activation {
+			t.Errorf("ActivationChurnLimit(%d) = %d, want %d", tt.active, got, tt.activation)
+		}
+	}
+}
+
+func TestBalanceChurnLimit(t *testing.T) {
+	spec := testChurnSpec()
+	tests := []struct {
+		total                         Gwei
+		churn, activationExit, consol Gwei
+	}{
+		{total: 32 * GweiPerEth, churn: 128 * GweiPerEth, activationExit: 128 * GweiPerEth, consol: 0},
+		// 34M ETH staked: 34e6 / 65536 = 518.8 ETH, rounded down to 518 ETH.
+		{total: 34_000_000 * GweiPerEth, churn: 518 * GweiPerEth, activationExit: 256 * GweiPerEth, consol: 262 * GweiPerEth},
+	}
+	for _, tt := range tests {
+		if got := BalanceChurnLimit(tt.total, spec); got != tt.churn {
+			t.Errorf("BalanceChurnLimit(%v) = %v, want %v", tt.total, got, tt.churn)
+		}
+		if got := ActivationExitChurnLimit(tt.total, spec); got != tt.activationExit {
+			t.Errorf("ActivationExitChurnLimit(%v) = %v, want %v", tt.total, got, tt.activationExit)
+		}
+		if got := ConsolidationChurnLimit(tt.total, spec); got != tt.consol {
+			t.Errorf("ConsolidationChurnLimit(%v) = %v, want %v", tt.total, got, tt.consol)
+		}
+	}
+}
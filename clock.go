@@ -0,0 +1,99 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/farazdagi/prysm-shared-types/mathutil"
+)
+
+// Clock maps wall-clock time to slots and epochs of the chain.
+type Clock struct {
+	genesis time.Time
+	spec    *ChainSpec
+	now     func() time.Time
+}
+
+// NewClock creates clock of the chain started at genesis time, using time.Now as the time source.
+func NewClock(genesis time.Time, spec *ChainSpec) *Clock {
+	return &Clock{genesis: genesis, spec: spec, now: time.Now}
+}
+
+// WithNow returns copy of the clock using the given time source (e.g. a fake one in tests).
+func (c *Clock) WithNow(now func() time.Time) *Clock {
+	return &Clock{genesis: c.genesis, spec: c.spec, now: now}
+}
+
+// GenesisTime returns genesis time of the chain.
+func (c *Clock) GenesisTime() time.Time {
+	return c.genesis
+}
+
+// CurrentSlot returns the current wall-clock slot, GenesisSlot if genesis hasn't happened yet.
+func (c *Clock) CurrentSlot() Slot {
+	elapsed := c.now().Sub(c.genesis)
+	if elapsed < 0 {
+		return GenesisSlot
+	}
+	return Slot(specDiv(c.spec, uint64(elapsed/time.Second), c.spec.SecondsPerSlot))
+}
+
+// CurrentEpoch returns the current wall-clock epoch, GenesisEpoch if genesis hasn't happened yet.
+func (c *Clock) CurrentEpoch() Epoch {
+	return c.CurrentSlot().ToEpoch(c.spec)
+}
+
+// SlotStart returns start time of the slot, the offset from genesis saturates at the maximum
+// representable duration (e.g. for FarFutureSlot).
+func (c *Clock) SlotStart(s Slot) time.Time {
+	return c.genesis.Add(SlotsToDuration(s, c.spec))
+}
+
+// Until returns wall-clock time remaining until the start of the slot, negative if slot has started.
+func (c *Clock) Until(s Slot) time.Duration {
+	return c.SlotStart(s).Sub(c.now())
+}
+
+// IntoSlot returns time at the given fraction (0 being the start, 1 the end) of the slot, e.g. 1/3
+// for the attestation deadline. Panics if fraction is not within [0, 1], unless the spec has an error
+// hook (see Spec.WithErrorHook) or panic-free mode is enabled: then the error is reported and
+// fraction is clamped to the range (NaN being treated as 0).
+func (c *Clock) IntoSlot(s Slot, fraction float64) time.Time {
+	if !(fraction >= 0 && fraction <= 1) {
+		mathutil.Fail(c.spec.hook(), fmt.Errorf("%w: slot fraction %v is out of [0, 1]", mathutil.ErrInvalidRange, fraction))
+		if fraction > 1 {
+			fraction = 1
+		} else {
+			fraction = 0
+		}
+	}
+	slotDuration := time.Duration(c.spec.SecondsPerSlot) * time.Second
+	return c.SlotStart(s).Add(time.Duration(fraction * float64(slotDuration)))
+}
+
+// SlotsSince returns number of slots passed since the slot, relative to the current wall-clock slot.
+// The result is negative if slot is in the future, and saturates at int64 bounds.
+func (c *Clock) SlotsSince(s Slot) int64 {
+	return signedDistance(uint64(c.CurrentSlot()), uint64(s))
+}
+
+// EpochsSince returns number of epochs passed since the epoch, relative to the current wall-clock
+// epoch. The result is negative if epoch is in the future, and saturates at int64 bounds.
+func (c *Clock) EpochsSince(e Epoch) int64 {
+	return signedDistance(uint64(c.CurrentEpoch()), uint64(e))
+}
+
+// signedDistance returns `a - b` as a signed value, saturating at int64 bounds.
+func signedDistance(a, b uint64) int64 {
+	if a >= b {
+		if a-b > math.MaxInt64 {
+			return math.MaxInt64
+		}
+		return int64(a - b)
+	}
+	if b-a > math.MaxInt64 {
+		return math.MinInt64
+	}
+	return -int64(b - a)
+}
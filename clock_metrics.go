@@ -0,0 +1,30 @@
+package types
+
+import "time"
+
+// ClockMetrics is a snapshot of chain time gauges, computed on demand from the clock (e.g. on every
+// metrics scrape), so that all services report consistent values. Package promclock exports them
+// as Prometheus gauges.
+type ClockMetrics struct {
+	CurrentSlot         Slot
+	CurrentEpoch        Epoch
+	SecondsIntoSlot     float64
+	SlotsUntilNextEpoch uint64
+}
+
+// Metrics returns chain time gauges as of the current wall-clock time. Before genesis all values
+// refer to the genesis slot, with zero seconds into it.
+func (c *Clock) Metrics() ClockMetrics {
+	now := c.now()
+	slot := c.WithNow(func() time.Time { return now }).CurrentSlot()
+	into := now.Sub(c.SlotStart(slot)).Seconds()
+	if into < 0 {
+		into = 0
+	}
+	return ClockMetrics{
+		CurrentSlot:         slot,
+		CurrentEpoch:        slot.ToEpoch(c.spec),
+		SecondsIntoSlot:     into,
+		SlotsUntilNextEpoch: uint64(c.spec.SlotsPerEpoch - slot.SinceEpochStart(c.spec)),
+	}
+}
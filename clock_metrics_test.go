@@ -0,0 +1,24 @@
+package types
+
+import (
+	"testing"
+	"time"
+)
+
+func TestClock_Metrics(t *testing.T) {
+	genesis := time.Date(2020, 12, 1, 12, 0, 23, 0, time.UTC)
+	spec := &ChainSpec{SlotsPerEpoch: 32, SecondsPerSlot: 12}
+	now := genesis.Add(100*12*time.Second + 4500*time.Millisecond)
+	clock := NewClock(genesis, spec).WithNow(func() time.Time { return now })
+
+	want := ClockMetrics{CurrentSlot: 100, CurrentEpoch: 3, SecondsIntoSlot: 4.5, SlotsUntilNextEpoch: 28}
+	if got := clock.Metrics(); got != want {
+		t.Errorf("Unexpected metrics: %+v", got)
+	}
+
+	now = genesis.Add(-time.Minute)
+	want = ClockMetrics{SlotsUntilNextEpoch: 32}
+	if got := clock.Metrics(); got != want {
+		t.Errorf("Unexpected metrics before genesis: %+v", got)
+	}
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestClock(t *testing.T) {
+	spec := &ChainSpec{SlotsPerEpoch: 32, SecondsPerSlot: 12}
+	genesis := time.Unix(1606824023, 0)
+	now := genesis.Add(-time.Minute)
+	clock := NewClock(genesis, spec).WithNow(func() time.Time { return now })
+
+	if clock.CurrentSlot() != GenesisSlot || clock.SlotsSince(0) != 0 || clock.SlotsSince(10) != -10 {
+		t.Error("Clock before genesis should report genesis slot")
+	}
+
+	now = genesis.Add(100*12*time.Second + 11*time.Second)
+	if clock.CurrentSlot() != 100 || clock.CurrentEpoch() != 3 {
+		t.Errorf("Unexpected current slot: %d", clock.CurrentSlot())
+	}
+	tests := []struct {
+		slot Slot
+		want int64
+	}{
+		{slot: 100, want: 0},
+		{slot: 90, want: 10},
+		{slot: 0, want: 100},
+		{slot: 105, want: -5},
+		{slot: FarFutureSlot, want: math.MinInt64},
+	}
+	for _, tt := range tests {
+		if got := clock.SlotsSince(tt.slot); got != tt.want {
+			t.Errorf("Unexpected slots since %d: %d", tt.slot, got)
+		}
+	}
+	if clock.EpochsSince(1) != 2 || clock.EpochsSince(5) != -2 {
+		t.Errorf("Unexpected epochs since: %d", clock.EpochsSince(1))
+	}
+	if !clock.SlotStart(100).Equal(genesis.Add(1200 * time.Second)) {
+		t.Errorf("Unexpected slot start: %v", clock.SlotStart(100))
+	}
+	if !clock.SlotStart(FarFutureSlot).Equal(genesis.Add(math.MaxInt64)) {
+		t.Errorf("Unexpected far future slot start: %v", clock.SlotStart(FarFutureSlot))
+	}
+	if clock.Until(FarFutureSlot) <= 0 {
+		t.Errorf("Unexpected time until far future slot: %v", clock.Until(FarFutureSlot))
+	}
+}
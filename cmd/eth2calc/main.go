@@ -0,0 +1,273 @@
+// Command eth2calc converts between slots, epochs, wall-clock time and sync committee periods of a
+// known network, and prints fork digests, signing domains and signing roots, e.g.:
+//
+//	eth2calc -network mainnet slot 9000000
+//	eth2calc time 2024-03-13T13:55:35Z
+//	eth2calc period 1000
+//	eth2calc domain DOMAIN_BEACON_ATTESTER 300000
+//	eth2calc signing-root DOMAIN_RANDAO 0x<object root> 300000
+//
+// Epoch arguments of digest, domain and signing-root are optional and default to the current epoch.
+// Since Fulu, fork digests mix in blob parameters of the network's blob schedule. Voluntary exit
+// domains are those of exits verified in the current state, which since Deneb use the Capella fork
+// version whatever the exit epoch (EIP-7044).
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"time"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+// errUsage is returned when command line arguments are invalid.
+var errUsage = errors.New("invalid usage")
+
+// now is the time source, replaced in tests.
+var now = time.Now
+
+const usage = `usage: eth2calc [-network name] command [args]
+
+commands:
+  slot <slot>                              position of the slot
+  epoch <epoch>                            position of the epoch start
+  time <now|unix seconds|RFC3339>          position of the slot at the given time
+  period <sync committee period>           position of the period start
+  digest [epoch]                           fork digest
+  domain <type> [epoch]                    signing domain (type is a spec name or 0x-prefixed hex)
+  signing-root <type> <root> [epoch]       signing root of the object with the given hash root
+`
+
+func main() {
+	// Own flag set, so that flags registered by imported packages are not listed.
+	flags := flag.NewFlagSet("eth2calc", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprint(flags.Output(), usage)
+		flags.PrintDefaults()
+	}
+	networkName := flags.String("network", "mainnet", "network (mainnet, sepolia, holesky or hoodi)")
+	_ = flags.Parse(os.Args[1:])
+
+	if err := run(*networkName, flags.Args(), os.Stdout); err != nil {
+		if errors.Is(err, errUsage) {
+			fmt.Fprintln(os.Stderr, err)
+			flags.Usage()
+			os.Exit(2)
+		}
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// run executes command given by args, writing results to out.
+func run(networkName string, args []string, out io.Writer) error {
+	net, err := lookupNetwork(networkName)
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return fmt.Errorf("%w: no command", errUsage)
+	}
+	calc := &calculator{net: net, spec: mainnetSpec, clock: types.NewClock(net.genesisTime, mainnetSpec).WithNow(now), out: out}
+	cmd, args := args[0], args[1:]
+	switch cmd {
+	case "slot", "epoch", "period", "time":
+		if len(args) != 1 {
+			return fmt.Errorf("%w: %s expects a single argument", errUsage, cmd)
+		}
+		slot, err := calc.parsePosition(cmd, args[0])
+		if err != nil {
+			return err
+		}
+		return calc.printPosition(slot)
+	case "digest":
+		epoch, err := calc.optionalEpoch(args, 0)
+		if err != nil {
+			return err
+		}
+		return calc.printDigest(epoch)
+	case "domain":
+		if len(args) < 1 || len(args) > 2 {
+			return fmt.Errorf("%w: domain expects domain type and optional epoch", errUsage)
+		}
+		epoch, err := calc.optionalEpoch(args, 1)
+		if err != nil {
+			return err
+		}
+		return calc.printDomain(args[0], epoch, nil)
+	case "signing-root":
+		if len(args) < 2 || len(args) > 3 {
+			return fmt.Errorf("%w: signing-root expects domain type, object root and optional epoch", errUsage)
+		}
+		var root types.Root
+		if err := root.UnmarshalText([]byte(args[1])); err != nil {
+			return fmt.Errorf("invalid object root %q: %w", args[1], err)
+		}
+		epoch, err := calc.optionalEpoch(args, 2)
+		if err != nil {
+			return err
+		}
+		return calc.printDomain(args[0], epoch, &root)
+	default:
+		return fmt.Errorf("%w: unknown command %q", errUsage, cmd)
+	}
+}
+
+// calculator prints values computed for a network.
+type calculator struct {
+	net   *network
+	spec  *types.ChainSpec
+	clock *types.Clock
+	out   io.Writer
+}
+
+// parsePosition converts argument of a position command into slot.
+func (c *calculator) parsePosition(cmd, arg string) (types.Slot, error) {
+	if cmd == "time" {
+		t, err := parseTime(arg)
+		if err != nil {
+			return 0, err
+		}
+		return c.clock.WithNow(func() time.Time { return t }).CurrentSlot(), nil
+	}
+	v, err := strconv.ParseUint(arg, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q", cmd, arg)
+	}
+	switch cmd {
+	case "epoch":
+		return types.Epoch(v).StartSlot(c.spec), nil
+	case "period":
+		return types.SyncCommitteePeriod(v).StartSlot(c.spec), nil
+	default:
+		return types.Slot(v), nil
+	}
+}
+
+// optionalEpoch returns epoch given by args[i], or the current epoch if there is no such argument.
+func (c *calculator) optionalEpoch(args []string, i int) (types.Epoch, error) {
+	if len(args) <= i {
+		return c.clock.CurrentEpoch(), nil
+	}
+	v, err := strconv.ParseUint(args[i], 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid epoch %q", args[i])
+	}
+	return types.Epoch(v), nil
+}
+
+func (c *calculator) printPosition(slot types.Slot) error {
+	epoch := slot.ToEpoch(c.spec)
+	fork := c.net.schedule.AtEpoch(epoch)
+	digest, err := c.forkDigest(fork, epoch)
+	if err != nil {
+		return err
+	}
+	c.printf("slot", "%d", slot)
+	c.printf("epoch", "%d (slot %d of %d)", epoch, slot.SinceEpochStart(c.spec), c.spec.SlotsPerEpoch)
+	c.printf("period", "%d", epoch.SyncCommitteePeriod(c.spec))
+	c.printf("time", "%s", c.clock.SlotStart(slot).UTC().Format(time.RFC3339))
+	c.printf("fork", "%s (%s)", fork.Version, fork.ForkVersion)
+	c.printf("fork digest", "%s", digest)
+	return nil
+}
+
+func (c *calculator) printDigest(epoch types.Epoch) error {
+	fork := c.net.schedule.AtEpoch(epoch)
+	digest, err := c.forkDigest(fork, epoch)
+	if err != nil {
+		return err
+	}
+	c.printf("epoch", "%d", epoch)
+	c.printf("fork", "%s (%s)", fork.Version, fork.ForkVersion)
+	if blobs, ok := c.net.blobSchedule.EntryAt(epoch); ok && fork.Version.AtLeast(types.Fulu) {
+		c.printf("blob params", "max %d blobs per block since epoch %d", blobs.MaxBlobsPerBlock, blobs.Epoch)
+	}
+	c.printf("fork digest", "%s", digest)
+	return nil
+}
+
+// forkDigest returns digest of the fork at epoch, with blob parameters mixed in since Fulu.
+func (c *calculator) forkDigest(fork types.ForkScheduleEntry, epoch types.Epoch) (types.ForkDigest, error) {
+	if !fork.Version.AtLeast(types.Fulu) {
+		return types.ComputeForkDigest(fork.ForkVersion, c.net.genesisValidatorsRoot)
+	}
+	blobs, ok := c.net.blobSchedule.EntryAt(epoch)
+	if !ok {
+		return types.ForkDigest{}, fmt.Errorf("no blob parameters at epoch %d", epoch)
+	}
+	return types.ComputeForkDigestWithBlobs(fork.ForkVersion, c.net.genesisValidatorsRoot, blobs)
+}
+
+// printDomain prints domain of the given type at epoch and, if object root is given, the signing root.
+func (c *calculator) printDomain(typeArg string, epoch types.Epoch, objectRoot *types.Root) error {
+	domainType, err := types.ParseDomainType(typeArg)
+	if err != nil {
+		return err
+	}
+	version, gvr, err := c.domainInputs(domainType, epoch)
+	if err != nil {
+		return err
+	}
+	domain, err := types.ComputeDomain(domainType, version, gvr)
+	if err != nil {
+		return err
+	}
+	c.printf("domain type", "%s (%s)", domainType.Name(), domainType)
+	c.printf("epoch", "%d", epoch)
+	c.printf("fork version", "%s", version)
+	c.printf("domain", "%s", domain)
+	if objectRoot == nil {
+		return nil
+	}
+	signingRoot, err := types.ComputeSigningRoot(*objectRoot, domain)
+	if err != nil {
+		return err
+	}
+	c.printf("object root", "%s", objectRoot)
+	c.printf("signing root", "%s", signingRoot)
+	return nil
+}
+
+// domainInputs returns fork version and genesis validators root the domain of the given type is
+// computed with: deposits and builder registrations are fork and chain agnostic, credential changes
+// are fork agnostic, and voluntary exits are signed as verified in the current state (see
+// VoluntaryExit.ForkVersion).
+func (c *calculator) domainInputs(domainType types.DomainType, epoch types.Epoch) (types.ForkVersion, types.Root, error) {
+	genesisVersion, _ := c.net.schedule.ForkVersion(types.Phase0)
+	switch domainType {
+	case types.DomainDeposit, types.DomainApplicationBuilder:
+		return genesisVersion, types.Root{}, nil
+	case types.DomainBLSToExecutionChange:
+		return genesisVersion, c.net.genesisValidatorsRoot, nil
+	case types.DomainVoluntaryExit:
+		exit := types.VoluntaryExit{Epoch: epoch}
+		version, err := exit.ForkVersion(c.net.schedule, c.clock.CurrentEpoch())
+		return version, c.net.genesisValidatorsRoot, err
+	}
+	return c.net.schedule.AtEpoch(epoch).ForkVersion, c.net.genesisValidatorsRoot, nil
+}
+
+func (c *calculator) printf(key, format string, args ...interface{}) {
+	fmt.Fprintf(c.out, "%-14s %s\n", key+":", fmt.Sprintf(format, args...))
+}
+
+// parseTime parses "now", unix timestamp (in seconds) or RFC3339 time.
+func parseTime(s string) (time.Time, error) {
+	if s == "now" {
+		return now(), nil
+	}
+	if sec, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(sec, 0), nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q, expected now, unix seconds or RFC3339", s)
+	}
+	return t, nil
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRun(t *testing.T) {
+	now = func() time.Time { return time.Date(2024, 3, 13, 13, 55, 35, 0, time.UTC) }
+	defer func() { now = time.Now }()
+
+	tests := []struct {
+		name    string
+		network string
+		args    []string
+		want    []string
+	}{
+		{
+			name: "genesis slot",
+			args: []string{"slot", "0"},
+			want: []string{"epoch:         0 (slot 0 of 32)", "time:          2020-12-01T12:00:23Z", "fork digest:   0xb5303f2a"},
+		},
+		{
+			name: "deneb activation time",
+			args: []string{"time", "2024-03-13T13:55:35Z"},
+			want: []string{"slot:          8626176", "epoch:         269568 (slot 0 of 32)", "period:        1053", "fork:          deneb (0x04000000)"},
+		},
+		{
+			name: "unix time",
+			args: []string{"time", "1710338135"},
+			want: []string{"slot:          8626176"},
+		},
+		{
+			name: "period",
+			args: []string{"period", "1053"},
+			want: []string{"epoch:         269568 (slot 0 of 32)"},
+		},
+		{
+			name: "current digest",
+			args: []string{"digest"},
+			want: []string{"epoch:         269568", "fork digest:   0x6a95a1a9"},
+		},
+		{
+			name: "fulu digest",
+			args: []string{"digest", "411392"},
+			want: []string{"fork:          fulu (0x06000000)", "blob params:   max 9 blobs per block since epoch 364032", "fork digest:   0xcc2c5cdb"},
+		},
+		{
+			name: "fulu digest after blob parameter fork",
+			args: []string{"digest", "419072"},
+			want: []string{"blob params:   max 21 blobs per block since epoch 419072", "fork digest:   0x8c9f62fe"},
+		},
+		{
+			name: "fulu slot",
+			args: []string{"epoch", "412672"},
+			want: []string{"fork digest:   0xcb0d1acc"},
+		},
+		{
+			name: "deposit domain",
+			args: []string{"domain", "DOMAIN_DEPOSIT", "300000"},
+			want: []string{"fork version:  0x00000000", "domain:        0x03000000f5a5fd42d16a20302798ef6ed309979b43003d2320d9f0e8ea9831a9"},
+		},
+		{
+			name: "voluntary exit domain after deneb",
+			args: []string{"domain", "0x04000000", "300000"},
+			want: []string{"domain type:   DOMAIN_VOLUNTARY_EXIT (0x04000000)", "fork version:  0x03000000"},
+		},
+		{
+			name: "voluntary exit domain of altair exit",
+			args: []string{"domain", "DOMAIN_VOLUNTARY_EXIT", "100000"},
+			want: []string{"fork version:  0x03000000", "domain:        0x04000000bba4da96"},
+		},
+		{
+			name:    "signing root",
+			network: "hoodi",
+			args:    []string{"signing-root", "DOMAIN_RANDAO", "0x0000000000000000000000000000000000000000000000000000000000000001", "0"},
+			want:    []string{"fork version:  0x50000910", "object root:   0x0000000000000000000000000000000000000000000000000000000000000001", "signing root:"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			network := tt.network
+			if network == "" {
+				network = "mainnet"
+			}
+			var out bytes.Buffer
+			if err := run(network, tt.args, &out); err != nil {
+				t.Fatal(err)
+			}
+			for _, line := range tt.want {
+				if !strings.Contains(out.String(), line) {
+					t.Errorf("Output doesn't contain %q:\n%s", line, out.String())
+				}
+			}
+		})
+	}
+}
+
+func TestRun_Errors(t *testing.T) {
+	for _, args := range [][]string{nil, {"unknown"}, {"slot"}, {"domain"}, {"signing-root", "DOMAIN_RANDAO"}} {
+		if err := run("mainnet", args, &bytes.Buffer{}); !errors.Is(err, errUsage) {
+			t.Errorf("Expected usage error for %v, got %v", args, err)
+		}
+	}
+	for _, args := range [][]string{{"slot", "-1"}, {"time", "yesterday"}, {"domain", "DOMAIN_UNKNOWN"}, {"digest", "x"}} {
+		if err := run("mainnet", args, &bytes.Buffer{}); err == nil || errors.Is(err, errUsage) {
+			t.Errorf("Expected invalid argument error for %v, got %v", args, err)
+		}
+	}
+	if err := run("unknown", []string{"slot", "0"}, &bytes.Buffer{}); err == nil {
+		t.Error("Expected error for unknown network")
+	}
+}
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+// network holds public parameters of a known network.
+type network struct {
+	genesisTime           time.Time
+	genesisValidatorsRoot types.Root
+	schedule              *types.ForkSchedule
+	blobSchedule          types.BlobSchedule
+}
+
+// mainnetSpec holds values of the mainnet preset used by the calculator (shared by all networks).
+var mainnetSpec = types.MainnetChainSpec()
+
+var networks = map[string]*network{
+	"mainnet": mustNetwork(1606824023, "0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95",
+		[]types.ForkVersion{{0x00}, {0x01}, {0x02}, {0x03}, {0x04}, {0x05}, {0x06}},
+		[]types.Epoch{0, 74240, 144896, 194048, 269568, 364032, 411392},
+		types.BlobScheduleEntry{Epoch: 412672, MaxBlobsPerBlock: 15}, types.BlobScheduleEntry{Epoch: 419072, MaxBlobsPerBlock: 21}),
+	"sepolia": mustNetwork(1655733600, "0xd8ea171f3c94aea21ebc42a1ed61052acf3f9209c00e4efbaaddac09ed9b8078",
+		[]types.ForkVersion{{0x90, 0, 0, 0x69}, {0x90, 0, 0, 0x70}, {0x90, 0, 0, 0x71}, {0x90, 0, 0, 0x72},
+			{0x90, 0, 0, 0x73}, {0x90, 0, 0, 0x74}, {0x90, 0, 0, 0x75}},
+		[]types.Epoch{0, 50, 100, 56832, 132608, 222464, 272640},
+		types.BlobScheduleEntry{Epoch: 274176, MaxBlobsPerBlock: 15}, types.BlobScheduleEntry{Epoch: 275712, MaxBlobsPerBlock: 21}),
+	"holesky": mustNetwork(1695902400, "0x9143aa7c615a7f7115e2b6aac319c03529df8242ae705fba9df39b79c59fa8b1",
+		[]types.ForkVersion{{0x01, 0x01, 0x70}, {0x02, 0x01, 0x70}, {0x03, 0x01, 0x70}, {0x04, 0x01, 0x70},
+			{0x05, 0x01, 0x70}, {0x06, 0x01, 0x70}, {0x07, 0x01, 0x70}},
+		[]types.Epoch{0, 0, 0, 256, 29696, 115968, 165120},
+		types.BlobScheduleEntry{Epoch: 166400, MaxBlobsPerBlock: 15}, types.BlobScheduleEntry{Epoch: 167936, MaxBlobsPerBlock: 21}),
+	"hoodi": mustNetwork(1742213400, "0x212f13fc4df078b6cb7db228f1c8307566dcecf900867401a92023d7ba99cb5f",
+		[]types.ForkVersion{{0x10, 0, 0x09, 0x10}, {0x20, 0, 0x09, 0x10}, {0x30, 0, 0x09, 0x10}, {0x40, 0, 0x09, 0x10},
+			{0x50, 0, 0x09, 0x10}, {0x60, 0, 0x09, 0x10}, {0x70, 0, 0x09, 0x10}},
+		[]types.Epoch{0, 0, 0, 0, 0, 2048, 50688},
+		types.BlobScheduleEntry{Epoch: 52480, MaxBlobsPerBlock: 15}, types.BlobScheduleEntry{Epoch: 54016, MaxBlobsPerBlock: 21}),
+}
+
+// Blob limits of Deneb and Electra, later limits are set by blob-parameter-only forks.
+const (
+	maxBlobsPerBlockDeneb   = 6
+	maxBlobsPerBlockElectra = 9
+)
+
+// mustNetwork builds network with forks Phase0 through Fulu activated at the given epochs, and
+// blob-parameter-only forks following Fulu.
+func mustNetwork(genesisTime int64, gvr string, versions []types.ForkVersion, epochs []types.Epoch,
+	bpo ...types.BlobScheduleEntry) *network {
+	var root types.Root
+	if err := root.UnmarshalText([]byte(gvr)); err != nil {
+		panic(err)
+	}
+	entries := make([]types.ForkScheduleEntry, len(versions))
+	for i := range versions {
+		entries[i] = types.ForkScheduleEntry{Version: types.Version(i), ForkVersion: versions[i], Epoch: epochs[i]}
+	}
+	schedule, err := types.NewForkSchedule(entries...)
+	if err != nil {
+		panic(err)
+	}
+	blobSchedule, err := types.NewBlobSchedule(append(bpo,
+		types.BlobScheduleEntry{Epoch: epochs[types.Deneb], MaxBlobsPerBlock: maxBlobsPerBlockDeneb},
+		types.BlobScheduleEntry{Epoch: epochs[types.Electra], MaxBlobsPerBlock: maxBlobsPerBlockElectra})...)
+	if err != nil {
+		panic(err)
+	}
+	return &network{genesisTime: time.Unix(genesisTime, 0).UTC(), genesisValidatorsRoot: root, schedule: schedule,
+		blobSchedule: blobSchedule}
+}
+
+// lookupNetwork returns network by name.
+func lookupNetwork(name string) (*network, error) {
+	n, ok := networks[strings.ToLower(name)]
+	if !ok {
+		names := make([]string, 0, len(networks))
+		for name := range networks {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("unknown network %q (known: %s)", name, strings.Join(names, ", "))
+	}
+	return n, nil
+}
@@ -0,0 +1,118 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// config describes the type to generate methods for.
+type config struct {
+	// Type is the name of the uint64 wrapper (or, if Bytes is set, fixed-size byte array) type.
+	Type string
+	// Package is the name of the package the generated file belongs to.
+	Package string
+	// Recv is the receiver name used in generated methods.
+	Recv string
+	// FarFuture enables generation of FarFuture<Type> constant.
+	FarFuture bool
+	// NoString disables generation of String method, for types providing their own representation.
+	NoString bool
+	// Bytes is the length of the fixed-size byte array type (SSZ ByteVector[Bytes]), zero for uint64
+	// wrappers.
+	Bytes int
+	// Desc overrides human readable name of the type used in doc comments.
+	Desc string
+}
+
+// Name returns human readable lowercase name of the type, e.g. "validator index".
+func (c config) Name() string {
+	if c.Desc != "" {
+		return c.Desc
+	}
+	return strings.ReplaceAll(snakeCase(c.Type), "_", " ")
+}
+
+// HexLen returns length of the 0x-prefixed hex encoding of the byte array type.
+func (c config) HexLen() int {
+	return 2 + 2*c.Bytes
+}
+
+// Chunks returns number of 32 byte chunks the byte array type is merkleized as.
+func (c config) Chunks() int {
+	return (c.Bytes + 31) / 32
+}
+
+// generate returns formatted source of the methods for the configured type.
+// Methods depending on fastssz are returned separately, as they are excluded by `nofastssz` build tag.
+func generate(cfg config) (methods, fastssz []byte, err error) {
+	if cfg.Recv == "" {
+		cfg.Recv = string(unicode.ToLower(rune(cfg.Type[0])))
+	}
+	methodsTmpl, fastsszTmpl, reserved := uint64MethodsTmpl, uint64FastsszTmpl, reservedNames
+	if cfg.Bytes != 0 {
+		if cfg.Bytes < 0 || cfg.FarFuture {
+			return nil, nil, fmt.Errorf("invalid byte array type %s: length %d, far future %v", cfg.Type, cfg.Bytes, cfg.FarFuture)
+		}
+		methodsTmpl, fastsszTmpl, reserved = bytesMethodsTmpl, bytesFastsszTmpl, reservedBytesNames
+	}
+	if reserved[cfg.Recv] {
+		return nil, nil, fmt.Errorf("receiver name %q clashes with names used in generated code", cfg.Recv)
+	}
+	if methods, err = execute(methodsTmpl, cfg); err != nil {
+		return nil, nil, err
+	}
+	if fastssz, err = execute(fastsszTmpl, cfg); err != nil {
+		return nil, nil, err
+	}
+	return methods, fastssz, nil
+}
+
+// execute renders template and formats the result.
+func execute(tmpl *template.Template, cfg config) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, cfg); err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+// snakeCase converts CamelCase identifier into snake_case.
+func snakeCase(s string) string {
+	var b strings.Builder
+	runes := []rune(s)
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			prevLower := i > 0 && unicode.IsLower(runes[i-1])
+			nextLower := i > 0 && i+1 < len(runes) && unicode.IsUpper(runes[i-1]) && unicode.IsLower(runes[i+1])
+			if prevLower || nextLower {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// reservedNames are identifiers used within generated methods, which can't be used as receiver names.
+var reservedNames = map[string]bool{
+	"x": true, "n": true, "hh": true, "buf": true, "dst": true,
+	"data": true, "parsed": true, "marshalled": true, "err": true,
+	"w": true, "r": true, "digits": true, "b": true, "i": true, "width": true,
+}
+
+// reservedBytesNames are identifiers used within methods generated for byte array types.
+var reservedBytesNames = map[string]bool{
+	"x": true, "text": true, "root": true, "dst": true, "chunks": true, "i": true, "buf": true, "hh": true,
+}
+
+var (
+	uint64MethodsTmpl = template.Must(template.New("methods").Parse(methodsTemplate))
+	uint64FastsszTmpl = template.Must(template.New("fastssz").Parse(fastsszTemplate))
+	bytesMethodsTmpl  = template.Must(template.New("bytes").Parse(bytesMethodsTemplate))
+	bytesFastsszTmpl  = template.Must(template.New("bytesfastssz").Parse(bytesFastsszTemplate))
+)
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	src, fastssz, err := generate(config{Type: "ValidatorIndex", Package: "types"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := parser.ParseFile(token.NewFileSet(), "", src, 0)
+	if err != nil {
+		t.Fatalf("Generated code doesn't parse: %v", err)
+	}
+	if f.Name.Name != "types" {
+		t.Errorf("Unexpected package: %s", f.Name.Name)
+	}
+
+	methods := make(map[string]bool)
+	for _, decl := range f.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok {
+			methods[fn.Name.Name] = true
+		}
+	}
+	for _, name := range []string{"AddValidatorIndex", "SafeMul", "Compare", "MarshalJSON", "UnmarshalText", "HashTreeRoot"} {
+		if !methods[name] {
+			t.Errorf("Method %s is not generated", name)
+		}
+	}
+	if methods["IsFarFuture"] {
+		t.Error("IsFarFuture should only be generated when requested")
+	}
+
+	if !bytes.Contains(fastssz, []byte("//go:build !nofastssz")) {
+		t.Error("Fastssz dependent methods should be excluded by build tag")
+	}
+	if bytes.Contains(src, []byte("fastssz")) {
+		t.Error("Methods file should not depend on fastssz")
+	}
+	if _, _, err := generate(config{Type: "Xyz", Package: "types"}); err == nil {
+		t.Error("Expected error on receiver clashing with argument name")
+	}
+}
+
+func TestGenerate_Bytes(t *testing.T) {
+	src, fastssz, err := generate(config{Type: "Pubkey", Package: "types", Bytes: 48})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := parser.ParseFile(token.NewFileSet(), "", src, 0)
+	if err != nil {
+		t.Fatalf("Generated code doesn't parse: %v", err)
+	}
+	methods := make(map[string]bool)
+	for _, decl := range f.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok {
+			methods[fn.Name.Name] = true
+		}
+	}
+	for _, name := range []string{"NewPubkey", "Equal", "IsZero", "String", "UnmarshalText", "HashTreeRootInto", "MarshalSSZTo", "SizeSSZ"} {
+		if !methods[name] {
+			t.Errorf("Method %s is not generated", name)
+		}
+	}
+	if methods["AddPubkey"] || methods["MarshalJSON"] {
+		t.Error("Arithmetic and JSON methods should not be generated for byte arrays")
+	}
+	if !bytes.Contains(src, []byte("var chunks [2][32]byte")) {
+		t.Error("48 byte array should be merkleized as 2 chunks")
+	}
+	if !bytes.Contains(fastssz, []byte("hh.PutBytes(p[:])")) {
+		t.Error("Unexpected fastssz hashing of byte array")
+	}
+
+	if _, _, err := generate(config{Type: "Text", Package: "types", Bytes: 4}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if _, _, err := generate(config{Type: "Xyz", Package: "types", Bytes: 4}); err == nil {
+		t.Error("Expected error on receiver clashing with local variable name")
+	}
+	if _, _, err := generate(config{Type: "Pubkey", Package: "types", Bytes: 48, FarFuture: true}); err == nil {
+		t.Error("Expected error on far future byte array")
+	}
+}
+
+func TestSnakeCase(t *testing.T) {
+	tests := map[string]string{
+		"Slot":           "slot",
+		"ValidatorIndex": "validator_index",
+		"BLSIndex":       "bls_index",
+		"Gwei":           "gwei",
+	}
+	for in, want := range tests {
+		if got := snakeCase(in); got != want {
+			t.Errorf("snakeCase(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
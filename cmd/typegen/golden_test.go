@@ -0,0 +1,68 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+// goldenTypes lists types generated within the root package, their generated files serve as golden files.
+var goldenTypes = []config{
+	{Type: "Slot", Package: "types", FarFuture: true},
+	{Type: "Epoch", Package: "types", FarFuture: true},
+	{Type: "ValidatorIndex", Package: "types"},
+	{Type: "Gwei", Package: "types", NoString: true},
+	{Type: "CommitteeIndex", Package: "types"},
+	{Type: "SyncCommitteeIndex", Package: "types"},
+	{Type: "SubnetID", Package: "types"},
+	{Type: "WithdrawalIndex", Package: "types", Recv: "wi"},
+	{Type: "Bytes4", Package: "types", Bytes: 4, Desc: "byte vector"},
+	{Type: "Bytes8", Package: "types", Bytes: 8, Desc: "byte vector"},
+	{Type: "Bytes20", Package: "types", Bytes: 20, Desc: "byte vector"},
+	{Type: "Bytes32", Package: "types", Bytes: 32, Desc: "byte vector"},
+	{Type: "Bytes48", Package: "types", Bytes: 48, Desc: "byte vector"},
+	{Type: "Bytes96", Package: "types", Bytes: 96, Desc: "byte vector"},
+	{Type: "Root", Package: "types", Bytes: 32},
+	{Type: "Domain", Package: "types", Bytes: 32},
+	{Type: "NodeID", Package: "types", Bytes: 32, Recv: "id"},
+	{Type: "BLSPubkey", Package: "types", Bytes: 48, Recv: "p", Desc: "public key"},
+	{Type: "BLSSignature", Package: "types", Bytes: 96, Recv: "s", Desc: "signature"},
+	{Type: "ExecutionAddress", Package: "types", Bytes: 20, Recv: "a", Desc: "address"},
+	{Type: "ForkVersion", Package: "types", Bytes: 4, Recv: "v"},
+	{Type: "ForkDigest", Package: "types", Bytes: 4, Recv: "d"},
+	{Type: "DomainType", Package: "types", Bytes: 4, Recv: "d"},
+}
+
+func TestGenerate_Golden(t *testing.T) {
+	for _, cfg := range goldenTypes {
+		t.Run(cfg.Type, func(t *testing.T) {
+			methods, fastssz, err := generate(cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			prefix := filepath.Join("..", "..", snakeCase(cfg.Type))
+			compareGolden(t, prefix+"_gen.go", methods)
+			compareGolden(t, prefix+"_fastssz_gen.go", fastssz)
+		})
+	}
+}
+
+// compareGolden checks that got matches content of the golden file (updating the file if requested).
+func compareGolden(t *testing.T, path string, got []byte) {
+	if *update {
+		if err := os.WriteFile(path, got, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("Generated code differs from %s, run `go generate ./...`", path)
+	}
+}
@@ -0,0 +1,57 @@
+// Command typegen generates the full method set (arithmetic, SSZ, JSON, text and string encoding)
+// for a named uint64 wrapper type, or (with -bytes) the SSZ, hex text and comparison method set of a
+// fixed-size byte array type.
+//
+// Intended to be used with go:generate:
+//
+//	//go:generate go run github.com/farazdagi/prysm-shared-types/cmd/typegen -type ValidatorIndex
+//	//go:generate go run github.com/farazdagi/prysm-shared-types/cmd/typegen -type Root -bytes 32
+//
+// The type itself (`type ValidatorIndex uint64`, `type Root Bytes32`) must be declared by the caller.
+// Byte array methods refer to ErrInvalidBytesLength, appendHex, decodeHexInto and merkleizeInto, so
+// they can only be generated within the types package.
+// Methods depending on fastssz (HashTreeRootWith and interface assertions) are written into a
+// separate file, which is excluded when building with `nofastssz` tag.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+)
+
+func main() {
+	var cfg config
+	flag.StringVar(&cfg.Type, "type", "", "name of the uint64 wrapper or byte array type (required)")
+	flag.StringVar(&cfg.Package, "pkg", os.Getenv("GOPACKAGE"), "package name of the generated file")
+	flag.StringVar(&cfg.Recv, "recv", "", "receiver name (defaults to lowercased first letter of the type)")
+	flag.BoolVar(&cfg.FarFuture, "farfuture", false, "generate far future constant, which is preserved by Add* and Mul* methods")
+	flag.BoolVar(&cfg.NoString, "nostring", false, "skip String method (the type provides its own)")
+	flag.IntVar(&cfg.Bytes, "bytes", 0, "generate methods of fixed-size byte array type of the given length")
+	flag.StringVar(&cfg.Desc, "name", "", "human readable name used in doc comments (defaults to the type name split into words)")
+	output := flag.String("output", "", "output file name prefix (defaults to <type>, producing <type>_gen.go and <type>_fastssz_gen.go)")
+	flag.Parse()
+
+	if cfg.Type == "" || cfg.Package == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if *output == "" {
+		*output = snakeCase(cfg.Type)
+	}
+
+	methods, fastssz, err := generate(cfg)
+	if err != nil {
+		log.Fatalf("could not generate %s: %v", cfg.Type, err)
+	}
+	files := map[string][]byte{
+		fmt.Sprintf("%s_gen.go", *output):         methods,
+		fmt.Sprintf("%s_fastssz_gen.go", *output): fastssz,
+	}
+	for name, src := range files {
+		if err := os.WriteFile(name, src, 0644); err != nil {
+			log.Fatalf("could not write %s: %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,450 @@
+package main
+
+const methodsTemplate = `// Code generated by typegen. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/farazdagi/prysm-shared-types/mathutil"
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+{{- $T := .Type}}{{$r := .Recv}}{{$name := .Name}}
+
+{{- if .FarFuture}}
+
+// FarFuture{{$T}} is a {{$name}} which is never reached.
+// Increasing it using Add* and Mul* methods leaves it unchanged.
+const FarFuture{{$T}} = {{$T}}(1<<64 - 1)
+
+// IsFarFuture returns true if {{$name}} is equal to FarFuture{{$T}}.
+func ({{$r}} {{$T}}) IsFarFuture() bool {
+	return {{$r}} == FarFuture{{$T}}
+}
+{{- end}}
+
+// IsZero returns true if {{$name}} has zero value.
+func ({{$r}} {{$T}}) IsZero() bool {
+	return {{$r}} == 0
+}
+
+// Add increases {{$name}} by x, panics on overflow.
+func ({{$r}} {{$T}}) Add(x uint64) {{$T}} {
+	return {{$r}}.Add{{$T}}({{$T}}(x))
+}
+
+// Add{{$T}} increases {{$name}} by another {{$name}}, panics on overflow.
+func ({{$r}} {{$T}}) Add{{$T}}(x {{$T}}) {{$T}} {
+{{- if .FarFuture}}
+	if {{$r}}.IsFarFuture() {
+		return {{$r}}
+	}
+{{- end}}
+	return mathutil.Add({{$r}}, x)
+}
+
+// SafeAdd increases {{$name}} by x, returns an error on overflow.
+func ({{$r}} {{$T}}) SafeAdd(x uint64) ({{$T}}, error) {
+{{- if .FarFuture}}
+	if {{$r}}.IsFarFuture() {
+		return {{$r}}, nil
+	}
+{{- end}}
+	return mathutil.SafeAdd({{$r}}, {{$T}}(x))
+}
+
+// Sub subtracts x from the {{$name}}, panics on underflow.
+func ({{$r}} {{$T}}) Sub(x uint64) {{$T}} {
+	return {{$r}}.Sub{{$T}}({{$T}}(x))
+}
+
+// Sub{{$T}} finds difference between two {{$name}} values, panics on underflow.
+func ({{$r}} {{$T}}) Sub{{$T}}(x {{$T}}) {{$T}} {
+	return mathutil.Sub({{$r}}, x)
+}
+
+// SafeSub subtracts x from the {{$name}}, returns an error on underflow.
+func ({{$r}} {{$T}}) SafeSub(x uint64) ({{$T}}, error) {
+	return mathutil.SafeSub({{$r}}, {{$T}}(x))
+}
+
+// AddInt shifts {{$name}} by signed offset x, returns an error if result overflows or goes below zero.
+func ({{$r}} {{$T}}) AddInt(x int64) ({{$T}}, error) {
+{{- if .FarFuture}}
+	if x >= 0 && {{$r}}.IsFarFuture() {
+		return {{$r}}, nil
+	}
+{{- end}}
+	return mathutil.SafeAddInt({{$r}}, x)
+}
+
+// Mul multiplies {{$name}} by x, panics on overflow.
+func ({{$r}} {{$T}}) Mul(x uint64) {{$T}} {
+	return {{$r}}.Mul{{$T}}({{$T}}(x))
+}
+
+// Mul{{$T}} multiplies {{$name}} by another {{$name}}, panics on overflow.
+func ({{$r}} {{$T}}) Mul{{$T}}(x {{$T}}) {{$T}} {
+{{- if .FarFuture}}
+	if {{$r}}.IsFarFuture() {
+		return {{$r}}
+	}
+{{- end}}
+	return mathutil.Mul({{$r}}, x)
+}
+
+// SafeMul multiplies {{$name}} by x, returns an error on overflow.
+func ({{$r}} {{$T}}) SafeMul(x uint64) ({{$T}}, error) {
+{{- if .FarFuture}}
+	if {{$r}}.IsFarFuture() {
+		return {{$r}}, nil
+	}
+{{- end}}
+	return mathutil.SafeMul({{$r}}, {{$T}}(x))
+}
+
+// Div divides {{$name}} by x, panics if x is zero.
+func ({{$r}} {{$T}}) Div(x uint64) {{$T}} {
+	return {{$r}}.Div{{$T}}({{$T}}(x))
+}
+
+// Div{{$T}} divides {{$name}} by another {{$name}}, panics if x is zero.
+func ({{$r}} {{$T}}) Div{{$T}}(x {{$T}}) {{$T}} {
+	return mathutil.Div({{$r}}, x)
+}
+
+// SafeDiv divides {{$name}} by x, returns an error if x is zero.
+func ({{$r}} {{$T}}) SafeDiv(x uint64) ({{$T}}, error) {
+	return mathutil.SafeDiv({{$r}}, {{$T}}(x))
+}
+
+// Mod returns result of ` + "`{{$name}} % x`" + `, panics if x is zero.
+func ({{$r}} {{$T}}) Mod(x uint64) {{$T}} {
+	return {{$r}}.Mod{{$T}}({{$T}}(x))
+}
+
+// Mod{{$T}} returns result of ` + "`{{$name}} % {{$name}}`" + `, panics if x is zero.
+func ({{$r}} {{$T}}) Mod{{$T}}(x {{$T}}) {{$T}} {
+	return mathutil.Mod({{$r}}, x)
+}
+
+// SafeMod returns result of ` + "`{{$name}} % x`" + `, returns an error if x is zero.
+func ({{$r}} {{$T}}) SafeMod(x uint64) ({{$T}}, error) {
+	return mathutil.SafeMod({{$r}}, {{$T}}(x))
+}
+
+// Compare returns an integer comparing two {{$name}} values (-1, 0 or +1).
+func ({{$r}} {{$T}}) Compare(x {{$T}}) int {
+	switch {
+	case {{$r}} < x:
+		return -1
+	case {{$r}} > x:
+		return 1
+	}
+	return 0
+}
+
+// IsAfter returns true if {{$name}} is strictly greater than x.
+func ({{$r}} {{$T}}) IsAfter(x {{$T}}) bool {
+	return {{$r}} > x
+}
+
+// IsBefore returns true if {{$name}} is strictly less than x.
+func ({{$r}} {{$T}}) IsBefore(x {{$T}}) bool {
+	return {{$r}} < x
+}
+
+// WithinN returns true if {{$name}} is at most n away from x (in either direction).
+func ({{$r}} {{$T}}) WithinN(x {{$T}}, n uint64) bool {
+	if {{$r}} > x {
+		return uint64({{$r}}-x) <= n
+	}
+	return uint64(x-{{$r}}) <= n
+}
+
+{{- if not .NoString}}
+
+// String returns decimal representation of the {{$name}}.
+func ({{$r}} {{$T}}) String() string {
+	return strconv.FormatUint(uint64({{$r}}), 10)
+}
+{{- end}}
+
+// PaddedString returns decimal representation of the {{$name}}, left-padded with zeros to width digits,
+// so that values sort lexicographically (e.g. in file names and object keys). Wider values are not truncated.
+func ({{$r}} {{$T}}) PaddedString(width int) string {
+	return string({{$r}}.AppendPadded(nil, width))
+}
+
+// AppendPadded appends zero-padded decimal representation of the {{$name}} to dst, see PaddedString.
+func ({{$r}} {{$T}}) AppendPadded(dst []byte, width int) []byte {
+	var digits [20]byte
+	b := strconv.AppendUint(digits[:0], uint64({{$r}}), 10)
+	for i := len(b); i < width; i++ {
+		dst = append(dst, '0')
+	}
+	return append(dst, b...)
+}
+
+// MarshalText encodes {{$name}} as a decimal string.
+func ({{$r}} {{$T}}) MarshalText() ([]byte, error) {
+	return strconv.AppendUint(nil, uint64({{$r}}), 10), nil
+}
+
+// UnmarshalText decodes {{$name}} from a decimal string.
+func ({{$r}} *{{$T}}) UnmarshalText(data []byte) error {
+	parsed, err := strconv.ParseUint(string(data), 10, 64)
+	if err != nil {
+		return fmt.Errorf("could not parse {{$name}}: %w", err)
+	}
+	*{{$r}} = {{$T}}(parsed)
+	return nil
+}
+
+// MarshalJSON encodes {{$name}} as a quoted decimal string (as expected by the Beacon API).
+func ({{$r}} {{$T}}) MarshalJSON() ([]byte, error) {
+	data := make([]byte, 0, 22)
+	data = append(data, '"')
+	data = strconv.AppendUint(data, uint64({{$r}}), 10)
+	return append(data, '"'), nil
+}
+
+// UnmarshalJSON decodes {{$name}} from either a quoted decimal string or a JSON number.
+func ({{$r}} *{{$T}}) UnmarshalJSON(data []byte) error {
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		data = data[1 : len(data)-1]
+	}
+	return {{$r}}.UnmarshalText(data)
+}
+
+// HashTreeRoot returns calculated hash root.
+// Root of a basic uint64 value is its little-endian encoding padded to 32 bytes, so no hashing is involved.
+func ({{$r}} {{$T}}) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	binary.LittleEndian.PutUint64(root[:8], uint64({{$r}}))
+	return root, nil
+}
+
+// HashTreeRootInto writes hash root into dst, avoiding copying of the returned array.
+func ({{$r}} {{$T}}) HashTreeRootInto(dst *[32]byte) error {
+	*dst = [32]byte{}
+	binary.LittleEndian.PutUint64(dst[:8], uint64({{$r}}))
+	return nil
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the {{$name}} object.
+func ({{$r}} *{{$T}}) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), {{$r}}.SizeSSZ()); err != nil {
+		return err
+	}
+	*{{$r}} = {{$T}}(binary.LittleEndian.Uint64(buf))
+	return nil
+}
+
+// MarshalSSZTo marshals {{$name}} with the provided byte slice.
+func ({{$r}} *{{$T}}) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return {{$r}}.AppendSSZ(dst), nil
+}
+
+// MarshalSSZ marshals {{$name}} into a serialized object.
+func ({{$r}} *{{$T}}) MarshalSSZ() ([]byte, error) {
+	return {{$r}}.AppendSSZ(make([]byte, 0, 8)), nil
+}
+
+// AppendSSZ appends serialized {{$name}} to dst, allocating only if dst has no spare capacity.
+func ({{$r}} {{$T}}) AppendSSZ(dst []byte) []byte {
+	return append(dst, byte({{$r}}), byte({{$r}}>>8), byte({{$r}}>>16), byte({{$r}}>>24),
+		byte({{$r}}>>32), byte({{$r}}>>40), byte({{$r}}>>48), byte({{$r}}>>56))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func ({{$r}} *{{$T}}) SizeSSZ() int {
+	return 8
+}
+
+// WriteTo writes SSZ serialized {{$name}} to w.
+func ({{$r}} {{$T}}) WriteTo(w io.Writer) (int64, error) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], uint64({{$r}}))
+	n, err := w.Write(buf[:])
+	return int64(n), err
+}
+
+// ReadFrom reads SSZ serialized {{$name}} from r.
+// Exactly 8 bytes are consumed, so values can be read one after another from the same stream.
+func ({{$r}} *{{$T}}) ReadFrom(r io.Reader) (int64, error) {
+	var buf [8]byte
+	n, err := io.ReadFull(r, buf[:])
+	if err != nil {
+		return int64(n), err
+	}
+	*{{$r}} = {{$T}}(binary.LittleEndian.Uint64(buf[:]))
+	return int64(n), nil
+}
+`
+
+const fastsszTemplate = `// Code generated by typegen. DO NOT EDIT.
+
+//go:build !nofastssz
+
+package {{.Package}}
+
+import fssz "github.com/ferranbt/fastssz"
+
+{{- $T := .Type}}{{$r := .Recv}}{{$name := .Name}}
+
+var _ fssz.HashRoot = ({{$T}})(0)
+var _ fssz.Marshaler = (*{{$T}})(nil)
+var _ fssz.Unmarshaler = (*{{$T}})(nil)
+
+// HashTreeRootWith appends {{$name}} to the provided hasher.
+func ({{$r}} {{$T}}) HashTreeRootWith(hh *fssz.Hasher) error {
+	hh.PutUint64(uint64({{$r}}))
+	return nil
+}
+`
+
+const bytesMethodsTemplate = `// Code generated by typegen. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"fmt"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+{{- $T := .Type}}{{$r := .Recv}}{{$name := .Name}}{{$n := .Bytes}}
+
+// New{{$T}} copies b into {{$T}}, returns an error if b is not exactly {{$n}} bytes long.
+func New{{$T}}(b []byte) ({{$T}}, error) {
+	var v {{$T}}
+	if len(b) != len(v) {
+		return v, fmt.Errorf("%w: expected {{$n}} bytes, got %d", ErrInvalidBytesLength, len(b))
+	}
+	copy(v[:], b)
+	return v, nil
+}
+
+// Equal returns true if both values hold the same bytes.
+func ({{$r}} {{$T}}) Equal(x {{$T}}) bool {
+	return {{$r}} == x
+}
+
+// IsZero returns true if all bytes of the {{$name}} are zero.
+func ({{$r}} {{$T}}) IsZero() bool {
+	return {{$r}} == {{$T}}{}
+}
+{{- if not .NoString}}
+
+// String returns 0x-prefixed hex representation of the {{$name}}.
+func ({{$r}} {{$T}}) String() string {
+	return string(appendHex(nil, {{$r}}[:]))
+}
+{{- end}}
+
+// MarshalText encodes {{$name}} as 0x-prefixed hex string.
+func ({{$r}} {{$T}}) MarshalText() ([]byte, error) {
+	return appendHex(make([]byte, 0, {{.HexLen}}), {{$r}}[:]), nil
+}
+
+// UnmarshalText decodes {{$name}} from 0x-prefixed hex string.
+func ({{$r}} *{{$T}}) UnmarshalText(text []byte) error {
+	return decodeHexInto({{$r}}[:], text)
+}
+{{- if lt $n 32}}
+
+// HashTreeRoot returns calculated hash root ({{$name}} right-padded to 32 bytes).
+func ({{$r}} {{$T}}) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	copy(root[:], {{$r}}[:])
+	return root, nil
+}
+
+// HashTreeRootInto writes hash root into dst.
+func ({{$r}} {{$T}}) HashTreeRootInto(dst *[32]byte) error {
+	*dst = [32]byte{}
+	copy(dst[:], {{$r}}[:])
+	return nil
+}
+{{- else if eq $n 32}}
+
+// HashTreeRoot returns calculated hash root (which is the {{$name}} itself).
+func ({{$r}} {{$T}}) HashTreeRoot() ([32]byte, error) {
+	return {{$r}}, nil
+}
+
+// HashTreeRootInto writes hash root (the {{$name}} itself) into dst.
+func ({{$r}} {{$T}}) HashTreeRootInto(dst *[32]byte) error {
+	*dst = {{$r}}
+	return nil
+}
+{{- else}}
+
+// HashTreeRoot returns calculated hash root ({{$name}} is merkleized as a vector of {{.Chunks}} chunks).
+func ({{$r}} {{$T}}) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	err := {{$r}}.HashTreeRootInto(&root)
+	return root, err
+}
+
+// HashTreeRootInto writes hash root into dst.
+func ({{$r}} {{$T}}) HashTreeRootInto(dst *[32]byte) error {
+	var chunks [{{.Chunks}}][32]byte
+	for i := range chunks {
+		copy(chunks[i][:], {{$r}}[i*32:])
+	}
+	return merkleizeInto(dst, chunks[:], 0)
+}
+{{- end}}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the {{$name}} object.
+func ({{$r}} *{{$T}}) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), {{$r}}.SizeSSZ()); err != nil {
+		return err
+	}
+	copy({{$r}}[:], buf)
+	return nil
+}
+
+// MarshalSSZTo marshals {{$name}} with the provided byte slice.
+func ({{$r}} *{{$T}}) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return append(dst, {{$r}}[:]...), nil
+}
+
+// MarshalSSZ marshals {{$name}} into a serialized object.
+func ({{$r}} *{{$T}}) MarshalSSZ() ([]byte, error) {
+	return append(make([]byte, 0, {{$n}}), {{$r}}[:]...), nil
+}
+
+// SizeSSZ returns the size of the serialized object.
+func ({{$r}} *{{$T}}) SizeSSZ() int {
+	return {{$n}}
+}
+`
+
+const bytesFastsszTemplate = `// Code generated by typegen. DO NOT EDIT.
+
+//go:build !nofastssz
+
+package {{.Package}}
+
+import fssz "github.com/ferranbt/fastssz"
+
+{{- $T := .Type}}{{$r := .Recv}}{{$name := .Name}}
+
+var _ fssz.HashRoot = ({{$T}}{})
+var _ fssz.Marshaler = (*{{$T}})(nil)
+var _ fssz.Unmarshaler = (*{{$T}})(nil)
+
+// HashTreeRootWith appends {{$name}} to the provided hasher.
+func ({{$r}} {{$T}}) HashTreeRootWith(hh *fssz.Hasher) error {
+	hh.PutBytes({{$r}}[:])
+	return nil
+}
+`
@@ -0,0 +1,5 @@
+package types
+
+// CommitteeIndex represents index of a committee within a slot.
+// Common methods (arithmetic, encoding) are generated, see committee_index_gen.go.
+type CommitteeIndex uint64
@@ -0,0 +1,17 @@
+// Code generated by typegen. DO NOT EDIT.
+
+//go:build !nofastssz
+
+package types
+
+import fssz "github.com/ferranbt/fastssz"
+
+var _ fssz.HashRoot = (CommitteeIndex)(0)
+var _ fssz.Marshaler = (*CommitteeIndex)(nil)
+var _ fssz.Unmarshaler = (*CommitteeIndex)(nil)
+
+// HashTreeRootWith appends committee index to the provided hasher.
+func (c CommitteeIndex) HashTreeRootWith(hh *fssz.Hasher) error {
+	hh.PutUint64(uint64(c))
+	return nil
+}
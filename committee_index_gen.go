@@ -0,0 +1,244 @@
+// Code generated by typegen. DO NOT EDIT.
+
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/farazdagi/prysm-shared-types/mathutil"
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// IsZero returns true if committee index has zero value.
+func (c CommitteeIndex) IsZero() bool {
+	return c == 0
+}
+
+// Add increases committee index by x, panics on overflow.
+func (c CommitteeIndex) Add(x uint64) CommitteeIndex {
+	return c.AddCommitteeIndex(CommitteeIndex(x))
+}
+
+// AddCommitteeIndex increases committee index by another committee index, panics on overflow.
+func (c CommitteeIndex) AddCommitteeIndex(x CommitteeIndex) CommitteeIndex {
+	return mathutil.Add(c, x)
+}
+
+// SafeAdd increases committee index by x, returns an error on overflow.
+func (c CommitteeIndex) SafeAdd(x uint64) (CommitteeIndex, error) {
+	return mathutil.SafeAdd(c, CommitteeIndex(x))
+}
+
+// Sub subtracts x from the committee index, panics on underflow.
+func (c CommitteeIndex) Sub(x uint64) CommitteeIndex {
+	return c.SubCommitteeIndex(CommitteeIndex(x))
+}
+
+// SubCommitteeIndex finds difference between two committee index values, panics on underflow.
+func (c CommitteeIndex) SubCommitteeIndex(x CommitteeIndex) CommitteeIndex {
+	return mathutil.Sub(c, x)
+}
+
+// SafeSub subtracts x from the committee index, returns an error on underflow.
+func (c CommitteeIndex) SafeSub(x uint64) (CommitteeIndex, error) {
+	return mathutil.SafeSub(c, CommitteeIndex(x))
+}
+
+// AddInt shifts committee index by signed offset x, returns an error if result overflows or goes below zero.
+func (c CommitteeIndex) AddInt(x int64) (CommitteeIndex, error) {
+	return mathutil.SafeAddInt(c, x)
+}
+
+// Mul multiplies committee index by x, panics on overflow.
+func (c CommitteeIndex) Mul(x uint64) CommitteeIndex {
+	return c.MulCommitteeIndex(CommitteeIndex(x))
+}
+
+// MulCommitteeIndex multiplies committee index by another committee index, panics on overflow.
+func (c CommitteeIndex) MulCommitteeIndex(x CommitteeIndex) CommitteeIndex {
+	return mathutil.Mul(c, x)
+}
+
+// SafeMul multiplies committee index by x, returns an error on overflow.
+func (c CommitteeIndex) SafeMul(x uint64) (CommitteeIndex, error) {
+	return mathutil.SafeMul(c, CommitteeIndex(x))
+}
+
+// Div divides committee index by x, panics if x is zero.
+func (c CommitteeIndex) Div(x uint64) CommitteeIndex {
+	return c.DivCommitteeIndex(CommitteeIndex(x))
+}
+
+// DivCommitteeIndex divides committee index by another committee index, panics if x is zero.
+func (c CommitteeIndex) DivCommitteeIndex(x CommitteeIndex) CommitteeIndex {
+	return mathutil.Div(c, x)
+}
+
+// SafeDiv divides committee index by x, returns an error if x is zero.
+func (c CommitteeIndex) SafeDiv(x uint64) (CommitteeIndex, error) {
+	return mathutil.SafeDiv(c, CommitteeIndex(x))
+}
+
+// Mod returns result of `committee index % x`, panics if x is zero.
+func (c CommitteeIndex) Mod(x uint64) CommitteeIndex {
+	return c.ModCommitteeIndex(CommitteeIndex(x))
+}
+
+// ModCommitteeIndex returns result of `committee index % committee index`, panics if x is zero.
+func (c CommitteeIndex) ModCommitteeIndex(x CommitteeIndex) CommitteeIndex {
+	return mathutil.Mod(c, x)
+}
+
+// SafeMod returns result of `committee index % x`, returns an error if x is zero.
+func (c CommitteeIndex) SafeMod(x uint64) (CommitteeIndex, error) {
+	return mathutil.SafeMod(c, CommitteeIndex(x))
+}
+
+// Compare returns an integer comparing two committee index values (-1, 0 or +1).
+func (c CommitteeIndex) Compare(x CommitteeIndex) int {
+	switch {
+	case c < x:
+		return -1
+	case c > x:
+		return 1
+	}
+	return 0
+}
+
+// IsAfter returns true if committee index is strictly greater than x.
+func (c CommitteeIndex) IsAfter(x CommitteeIndex) bool {
+	return c > x
+}
+
+// IsBefore returns true if committee index is strictly less than x.
+func (c CommitteeIndex) IsBefore(x CommitteeIndex) bool {
+	return c < x
+}
+
+// WithinN returns true if committee index is at most n away from x (in either direction).
+func (c CommitteeIndex) WithinN(x CommitteeIndex, n uint64) bool {
+	if c > x {
+		return uint64(c-x) <= n
+	}
+	return uint64(x-c) <= n
+}
+
+// String returns decimal representation of the committee index.
+func (c CommitteeIndex) String() string {
+	return strconv.FormatUint(uint64(c), 10)
+}
+
+// PaddedString returns decimal representation of the committee index, left-padded with zeros to width digits,
+// so that values sort lexicographically (e.g. in file names and object keys). Wider values are not truncated.
+func (c CommitteeIndex) PaddedString(width int) string {
+	return string(c.AppendPadded(nil, width))
+}
+
+// AppendPadded appends zero-padded decimal representation of the committee index to dst, see PaddedString.
+func (c CommitteeIndex) AppendPadded(dst []byte, width int) []byte {
+	var digits [20]byte
+	b := strconv.AppendUint(digits[:0], uint64(c), 10)
+	for i := len(b); i < width; i++ {
+		dst = append(dst, '0')
+	}
+	return append(dst, b...)
+}
+
+// MarshalText encodes committee index as a decimal string.
+func (c CommitteeIndex) MarshalText() ([]byte, error) {
+	return strconv.AppendUint(nil, uint64(c), 10), nil
+}
+
+// UnmarshalText decodes committee index from a decimal string.
+func (c *CommitteeIndex) UnmarshalText(data []byte) error {
+	parsed, err := strconv.ParseUint(string(data), 10, 64)
+	if err != nil {
+		return fmt.Errorf("could not parse committee index: %w", err)
+	}
+	*c = CommitteeIndex(parsed)
+	return nil
+}
+
+// MarshalJSON encodes committee index as a quoted decimal string (as expected by the Beacon API).
+func (c CommitteeIndex) MarshalJSON() ([]byte, error) {
+	data := make([]byte, 0, 22)
+	data = append(data, '"')
+	data = strconv.AppendUint(data, uint64(c), 10)
+	return append(data, '"'), nil
+}
+
+// UnmarshalJSON decodes committee index from either a quoted decimal string or a JSON number.
+func (c *CommitteeIndex) UnmarshalJSON(data []byte) error {
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		data = data[1 : len(data)-1]
+	}
+	return c.UnmarshalText(data)
+}
+
+// HashTreeRoot returns calculated hash root.
+// Root of a basic uint64 value is its little-endian encoding padded to 32 bytes, so no hashing is involved.
+func (c CommitteeIndex) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	binary.LittleEndian.PutUint64(root[:8], uint64(c))
+	return root, nil
+}
+
+// HashTreeRootInto writes hash root into dst, avoiding copying of the returned array.
+func (c CommitteeIndex) HashTreeRootInto(dst *[32]byte) error {
+	*dst = [32]byte{}
+	binary.LittleEndian.PutUint64(dst[:8], uint64(c))
+	return nil
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the committee index object.
+func (c *CommitteeIndex) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), c.SizeSSZ()); err != nil {
+		return err
+	}
+	*c = CommitteeIndex(binary.LittleEndian.Uint64(buf))
+	return nil
+}
+
+// MarshalSSZTo marshals committee index with the provided byte slice.
+func (c *CommitteeIndex) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return c.AppendSSZ(dst), nil
+}
+
+// MarshalSSZ marshals committee index into a serialized object.
+func (c *CommitteeIndex) MarshalSSZ() ([]byte, error) {
+	return c.AppendSSZ(make([]byte, 0, 8)), nil
+}
+
+// AppendSSZ appends serialized committee index to dst, allocating only if dst has no spare capacity.
+func (c CommitteeIndex) AppendSSZ(dst []byte) []byte {
+	return append(dst, byte(c), byte(c>>8), byte(c>>16), byte(c>>24),
+		byte(c>>32), byte(c>>40), byte(c>>48), byte(c>>56))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (c *CommitteeIndex) SizeSSZ() int {
+	return 8
+}
+
+// WriteTo writes SSZ serialized committee index to w.
+func (c CommitteeIndex) WriteTo(w io.Writer) (int64, error) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], uint64(c))
+	n, err := w.Write(buf[:])
+	return int64(n), err
+}
+
+// ReadFrom reads SSZ serialized committee index from r.
+// Exactly 8 bytes are consumed, so values can be read one after another from the same stream.
+func (c *CommitteeIndex) ReadFrom(r io.Reader) (int64, error) {
+	var buf [8]byte
+	n, err := io.ReadFull(r, buf[:])
+	if err != nil {
+		return int64(n), err
+	}
+	*c = CommitteeIndex(binary.LittleEndian.Uint64(buf[:]))
+	return int64(n), nil
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	t.Run("slot", func(t *testing.T) {
+		if Slot(1).Compare(2) != -1 || Slot(2).Compare(1) != 1 || Slot(2).Compare(2) != 0 {
+			t.Error("Unexpected slot comparison result")
+		}
+	})
+
+	t.Run("epoch", func(t *testing.T) {
+		if Epoch(1).Compare(2) != -1 || Epoch(2).Compare(1) != 1 || Epoch(2).Compare(2) != 0 {
+			t.Error("Unexpected epoch comparison result")
+		}
+	})
+
+	t.Run("root", func(t *testing.T) {
+		a, b := Root{0x01}, Root{0x02}
+		if a.Compare(b) != -1 || b.Compare(a) != 1 || a.Compare(a) != 0 {
+			t.Error("Unexpected root comparison result")
+		}
+	})
+
+	t.Run("sort", func(t *testing.T) {
+		slots := []Slot{5, 1, 3}
+		sort.Slice(slots, func(i, j int) bool {
+			return slots[i].Compare(slots[j]) < 0
+		})
+		if slots[0] != 1 || slots[1] != 3 || slots[2] != 5 {
+			t.Errorf("Unexpected order: %v", slots)
+		}
+	})
+}
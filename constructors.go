@@ -0,0 +1,78 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// MaxSafeInteger is the largest integer exactly representable by a JavaScript number (2^53-1).
+const MaxSafeInteger = 1<<53 - 1
+
+// MaxDecimalWidth is the number of decimal digits of the largest uint64 value, padding to this
+// width (see Slot.PaddedString) makes any two values sort lexicographically.
+const MaxDecimalWidth = 20
+
+// ErrNotJSSafe is returned when value exceeds MaxSafeInteger, and cannot be used in JSON number contexts.
+var ErrNotJSSafe = errors.New("value exceeds JavaScript safe integer range")
+
+// SlotFromString parses slot from a decimal string.
+func SlotFromString(s string) (Slot, error) {
+	v, err := parseDecimal(s, "slot")
+	return Slot(v), err
+}
+
+// EpochFromString parses epoch from a decimal string.
+func EpochFromString(s string) (Epoch, error) {
+	v, err := parseDecimal(s, "epoch")
+	return Epoch(v), err
+}
+
+// SlotFromHex parses slot from a 0x-prefixed hex quantity (e.g. "0x1f").
+func SlotFromHex(s string) (Slot, error) {
+	v, err := parseHexQuantity(s, "slot")
+	return Slot(v), err
+}
+
+// EpochFromHex parses epoch from a 0x-prefixed hex quantity (e.g. "0x1f").
+func EpochFromHex(s string) (Epoch, error) {
+	v, err := parseHexQuantity(s, "epoch")
+	return Epoch(v), err
+}
+
+// CheckJSSafe returns ErrNotJSSafe if value cannot be represented exactly by a JavaScript number.
+func CheckJSSafe[T Uint64Like](v T) error {
+	if uint64(v) > MaxSafeInteger {
+		return fmt.Errorf("%w: %d", ErrNotJSSafe, uint64(v))
+	}
+	return nil
+}
+
+// parseDecimal parses unsigned decimal string (no sign, whitespace or leading zeros are allowed).
+func parseDecimal(s, name string) (uint64, error) {
+	if len(s) > 1 && s[0] == '0' {
+		return 0, fmt.Errorf("invalid %s %q: leading zeros", name, s)
+	}
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %w", name, s, err)
+	}
+	return v, nil
+}
+
+// parseHexQuantity parses 0x-prefixed hex string (no leading zeros are allowed, as in JSON-RPC quantities).
+func parseHexQuantity(s, name string) (uint64, error) {
+	digits := strings.TrimPrefix(s, "0x")
+	if len(digits) == len(s) || digits == "" {
+		return 0, fmt.Errorf("invalid %s %q: expected 0x-prefixed hex", name, s)
+	}
+	if len(digits) > 1 && digits[0] == '0' {
+		return 0, fmt.Errorf("invalid %s %q: leading zeros", name, s)
+	}
+	v, err := strconv.ParseUint(digits, 16, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s %q: %w", name, s, err)
+	}
+	return v, nil
+}
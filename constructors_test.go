@@ -0,0 +1,43 @@
+package types
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSlotFromString(t *testing.T) {
+	if slot, err := SlotFromString("12345"); err != nil || slot != 12345 {
+		t.Errorf("Unexpected slot: %d (%v)", slot, err)
+	}
+	if epoch, err := EpochFromString("0"); err != nil || epoch != 0 {
+		t.Errorf("Unexpected epoch: %d (%v)", epoch, err)
+	}
+	for _, input := range []string{"", "+1", "-1", " 1", "01", "1e3", "0x10", "18446744073709551616"} {
+		if _, err := SlotFromString(input); err == nil {
+			t.Errorf("Expected error for %q", input)
+		}
+	}
+}
+
+func TestSlotFromHex(t *testing.T) {
+	if slot, err := SlotFromHex("0x1f"); err != nil || slot != 31 {
+		t.Errorf("Unexpected slot: %d (%v)", slot, err)
+	}
+	if epoch, err := EpochFromHex("0x0"); err != nil || epoch != 0 {
+		t.Errorf("Unexpected epoch: %d (%v)", epoch, err)
+	}
+	for _, input := range []string{"", "0x", "1f", "0x01", "0xzz", "0x10000000000000000"} {
+		if _, err := SlotFromHex(input); err == nil {
+			t.Errorf("Expected error for %q", input)
+		}
+	}
+}
+
+func TestCheckJSSafe(t *testing.T) {
+	if err := CheckJSSafe(Slot(MaxSafeInteger)); err != nil {
+		t.Error(err)
+	}
+	if err := CheckJSSafe(Gwei(MaxSafeInteger + 1)); !errors.Is(err, ErrNotJSSafe) {
+		t.Errorf("Expected ErrNotJSSafe, got: %v", err)
+	}
+}
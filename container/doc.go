@@ -0,0 +1,4 @@
+// Package container contains generic containers keyed by slots, epochs and other shared types.
+//
+// Unless explicitly stated otherwise, containers are not safe for concurrent use.
+package container
@@ -0,0 +1,110 @@
+package container
+
+import (
+	"math"
+	"sync"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+// EpochSnapshot holds counters and Gwei totals accumulated during an epoch.
+type EpochSnapshot[K comparable] struct {
+	Epoch    types.Epoch
+	Counters map[K]uint64
+	Totals   map[K]types.Gwei
+}
+
+// EpochStats accumulates named counters and Gwei totals (e.g. attesting validators and their
+// balance) of the current epoch. Recording a value of a later epoch rolls the stats over, so that
+// the completed epoch's snapshot remains available until the next roll-over. Values of epochs prior
+// to the current one are rejected. It is safe for concurrent use.
+type EpochStats[K comparable] struct {
+	lock      sync.Mutex
+	current   EpochSnapshot[K]
+	completed *EpochSnapshot[K]
+}
+
+// NewEpochStats creates stats accumulating values of the given epoch.
+func NewEpochStats[K comparable](epoch types.Epoch) *EpochStats[K] {
+	return &EpochStats[K]{current: newEpochSnapshot[K](epoch)}
+}
+
+// Inc increases counter of the key by n at epoch, returns false if epoch has already been completed.
+func (s *EpochStats[K]) Inc(epoch types.Epoch, key K, n uint64) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if !s.rollOver(epoch) {
+		return false
+	}
+	s.current.Counters[key] += n
+	return true
+}
+
+// AddGwei increases total of the key by amount at epoch, returns false if epoch has already been
+// completed. Totals saturate at the maximum Gwei value.
+func (s *EpochStats[K]) AddGwei(epoch types.Epoch, key K, amount types.Gwei) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if !s.rollOver(epoch) {
+		return false
+	}
+	total, err := s.current.Totals[key].SafeAdd(uint64(amount))
+	if err != nil {
+		total = math.MaxUint64
+	}
+	s.current.Totals[key] = total
+	return true
+}
+
+// Epoch returns the current epoch.
+func (s *EpochStats[K]) Epoch() types.Epoch {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.current.Epoch
+}
+
+// Current returns copy of the values accumulated so far in the current epoch.
+func (s *EpochStats[K]) Current() EpochSnapshot[K] {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.current.clone()
+}
+
+// Completed returns snapshot of the epoch preceding the last roll-over, false if there was none.
+// Snapshot is shared, and must not be modified.
+func (s *EpochStats[K]) Completed() (EpochSnapshot[K], bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.completed == nil {
+		return EpochSnapshot[K]{}, false
+	}
+	return *s.completed, true
+}
+
+// rollOver completes the current epoch if epoch is a later one, returns false if epoch is stale.
+func (s *EpochStats[K]) rollOver(epoch types.Epoch) bool {
+	switch {
+	case epoch < s.current.Epoch:
+		return false
+	case epoch > s.current.Epoch:
+		completed := s.current
+		s.completed = &completed
+		s.current = newEpochSnapshot[K](epoch)
+	}
+	return true
+}
+
+func newEpochSnapshot[K comparable](epoch types.Epoch) EpochSnapshot[K] {
+	return EpochSnapshot[K]{Epoch: epoch, Counters: make(map[K]uint64), Totals: make(map[K]types.Gwei)}
+}
+
+func (e EpochSnapshot[K]) clone() EpochSnapshot[K] {
+	c := newEpochSnapshot[K](e.Epoch)
+	for k, v := range e.Counters {
+		c.Counters[k] = v
+	}
+	for k, v := range e.Totals {
+		c.Totals[k] = v
+	}
+	return c
+}
@@ -0,0 +1,47 @@
+package container
+
+import (
+	"math"
+	"testing"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+func TestEpochStats(t *testing.T) {
+	stats := NewEpochStats[string](10)
+	if _, ok := stats.Completed(); ok {
+		t.Error("Unexpected completed epoch")
+	}
+	stats.Inc(10, "attested", 2)
+	stats.Inc(10, "attested", 1)
+	stats.AddGwei(10, "balance", 64)
+	if cur := stats.Current(); cur.Epoch != 10 || cur.Counters["attested"] != 3 || cur.Totals["balance"] != 64 {
+		t.Errorf("Unexpected current stats: %+v", cur)
+	}
+
+	if !stats.Inc(12, "attested", 5) || stats.Epoch() != 12 {
+		t.Fatal("Expected roll over")
+	}
+	completed, ok := stats.Completed()
+	if !ok || completed.Epoch != 10 || completed.Counters["attested"] != 3 || completed.Totals["balance"] != 64 {
+		t.Errorf("Unexpected completed stats: %+v", completed)
+	}
+	if cur := stats.Current(); cur.Counters["attested"] != 5 || cur.Totals["balance"] != 0 {
+		t.Errorf("Unexpected current stats after roll over: %+v", cur)
+	}
+
+	if stats.Inc(11, "attested", 1) || stats.AddGwei(10, "balance", 1) {
+		t.Error("Values of completed epochs must be rejected")
+	}
+
+	stats.AddGwei(12, "balance", math.MaxUint64)
+	stats.AddGwei(12, "balance", 1)
+	if got := stats.Current().Totals["balance"]; got != types.Gwei(math.MaxUint64) {
+		t.Errorf("Unexpected saturated total: %v", got)
+	}
+	cur := stats.Current()
+	cur.Counters["attested"] = 100
+	if stats.Current().Counters["attested"] != 5 {
+		t.Error("Current snapshot must be a copy")
+	}
+}
@@ -0,0 +1,208 @@
+package container
+
+import (
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+// orderedMapMaxLevel caps skip list height, enough for 2^32 entries with p=1/4.
+const orderedMapMaxLevel = 16
+
+// OrderedMap is a map keyed by slot (or epoch) keeping entries ordered by key, backed by a skip list.
+// In addition to O(log n) lookups and updates, it supports range scans in both directions and cheap
+// removal of all entries below a bound (as finality advances).
+type OrderedMap[K types.Uint64Like, V any] struct {
+	head  orderedMapNode[K, V] // sentinel, its key and value are unused
+	tail  *orderedMapNode[K, V]
+	level int
+	len   int
+	rnd   uint64
+}
+
+// orderedMapNode is a skip list node, linked forward on every level and backward on the lowest one.
+type orderedMapNode[K types.Uint64Like, V any] struct {
+	key   K
+	value V
+	next  []*orderedMapNode[K, V]
+	prev  *orderedMapNode[K, V]
+}
+
+// NewOrderedMap creates an empty map.
+func NewOrderedMap[K types.Uint64Like, V any]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{
+		head:  orderedMapNode[K, V]{next: make([]*orderedMapNode[K, V], orderedMapMaxLevel)},
+		level: 1,
+		rnd:   0x9e3779b97f4a7c15,
+	}
+}
+
+// Len returns number of entries.
+func (m *OrderedMap[K, V]) Len() int {
+	return m.len
+}
+
+// Get returns value stored for the key.
+func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
+	if n := m.seek(key, nil); n != nil && n.key == key {
+		return n.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Set stores value for the key.
+func (m *OrderedMap[K, V]) Set(key K, value V) {
+	var update [orderedMapMaxLevel]*orderedMapNode[K, V]
+	if n := m.seek(key, &update); n != nil && n.key == key {
+		n.value = value
+		return
+	}
+	level := m.randomLevel()
+	for ; m.level < level; m.level++ {
+		update[m.level] = &m.head
+	}
+	n := &orderedMapNode[K, V]{key: key, value: value, next: make([]*orderedMapNode[K, V], level)}
+	for i := 0; i < level; i++ {
+		n.next[i] = update[i].next[i]
+		update[i].next[i] = n
+	}
+	if update[0] != &m.head {
+		n.prev = update[0]
+	}
+	if n.next[0] != nil {
+		n.next[0].prev = n
+	} else {
+		m.tail = n
+	}
+	m.len++
+}
+
+// Delete removes entry for the key, returns false if there's no such entry.
+func (m *OrderedMap[K, V]) Delete(key K) bool {
+	var update [orderedMapMaxLevel]*orderedMapNode[K, V]
+	n := m.seek(key, &update)
+	if n == nil || n.key != key {
+		return false
+	}
+	for i := range n.next {
+		update[i].next[i] = n.next[i]
+	}
+	if n.next[0] != nil {
+		n.next[0].prev = n.prev
+	} else {
+		m.tail = n.prev
+	}
+	m.shrink()
+	m.len--
+	return true
+}
+
+// DeleteBelow removes all entries with keys lower than the given one, returns number of removed entries.
+func (m *OrderedMap[K, V]) DeleteBelow(key K) int {
+	var update [orderedMapMaxLevel]*orderedMapNode[K, V]
+	first := m.seek(key, &update)
+	removed := 0
+	for n := m.head.next[0]; n != first; n = n.next[0] {
+		removed++
+	}
+	// Every node preceding the bound is removed, so the head links straight to the successors.
+	for i := 0; i < m.level; i++ {
+		m.head.next[i] = update[i].next[i]
+	}
+	if first != nil {
+		first.prev = nil
+	} else {
+		m.tail = nil
+	}
+	m.shrink()
+	m.len -= removed
+	return removed
+}
+
+// Min returns the entry with the lowest key, or false if map is empty.
+func (m *OrderedMap[K, V]) Min() (K, V, bool) {
+	return m.entry(m.head.next[0])
+}
+
+// Max returns the entry with the highest key, or false if map is empty.
+func (m *OrderedMap[K, V]) Max() (K, V, bool) {
+	return m.entry(m.tail)
+}
+
+// AscendRange calls fn for every entry with key in [from, to] in ascending order, until fn returns false.
+func (m *OrderedMap[K, V]) AscendRange(from, to K, fn func(K, V) bool) {
+	for n := m.seek(from, nil); n != nil && n.key <= to; n = n.next[0] {
+		if !fn(n.key, n.value) {
+			return
+		}
+	}
+}
+
+// DescendRange calls fn for every entry with key in [to, from] in descending order, until fn returns false.
+func (m *OrderedMap[K, V]) DescendRange(from, to K, fn func(K, V) bool) {
+	// Start from the last node not exceeding the upper bound.
+	n := m.seek(from, nil)
+	switch {
+	case n == nil:
+		n = m.tail
+	case n.key > from:
+		n = n.prev
+	}
+	for ; n != nil && n.key >= to; n = n.prev {
+		if !fn(n.key, n.value) {
+			return
+		}
+	}
+}
+
+// Keys returns all keys in ascending order.
+func (m *OrderedMap[K, V]) Keys() []K {
+	keys := make([]K, 0, m.len)
+	for n := m.head.next[0]; n != nil; n = n.next[0] {
+		keys = append(keys, n.key)
+	}
+	return keys
+}
+
+// seek returns the first node with key not lower than the given one (nil if there's none).
+// If update is provided, it is filled with the last node preceding the key on every level.
+func (m *OrderedMap[K, V]) seek(key K, update *[orderedMapMaxLevel]*orderedMapNode[K, V]) *orderedMapNode[K, V] {
+	x := &m.head
+	for i := m.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && x.next[i].key < key {
+			x = x.next[i]
+		}
+		if update != nil {
+			update[i] = x
+		}
+	}
+	return x.next[0]
+}
+
+// shrink lowers the list level while the top levels are empty.
+func (m *OrderedMap[K, V]) shrink() {
+	for m.level > 1 && m.head.next[m.level-1] == nil {
+		m.level--
+	}
+}
+
+// randomLevel returns level for a new node, each level being 4 times less likely than the previous one.
+func (m *OrderedMap[K, V]) randomLevel() int {
+	// xorshift64, the map doesn't need quality randomness, only a geometric distribution of levels.
+	m.rnd ^= m.rnd << 13
+	m.rnd ^= m.rnd >> 7
+	m.rnd ^= m.rnd << 17
+	level, r := 1, m.rnd
+	for level < orderedMapMaxLevel && r&3 == 0 {
+		level++
+		r >>= 2
+	}
+	return level
+}
+
+func (m *OrderedMap[K, V]) entry(n *orderedMapNode[K, V]) (K, V, bool) {
+	if n == nil {
+		var zero V
+		return 0, zero, false
+	}
+	return n.key, n.value, true
+}
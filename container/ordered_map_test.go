@@ -0,0 +1,122 @@
+package container
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+func TestOrderedMap(t *testing.T) {
+	m := NewOrderedMap[types.Slot, string]()
+	if _, _, ok := m.Min(); ok {
+		t.Error("Empty map should have no min entry")
+	}
+	for _, s := range []types.Slot{5, 1, 9, 3, 7} {
+		m.Set(s, s.String())
+	}
+	m.Set(3, "three")
+	if v, ok := m.Get(3); !ok || v != "three" {
+		t.Errorf("Unexpected value: %q", v)
+	}
+	if _, ok := m.Get(4); ok {
+		t.Error("Unexpected value for missing key")
+	}
+	if k, _, _ := m.Min(); k != 1 {
+		t.Errorf("Unexpected min key: %d", k)
+	}
+	if k, _, _ := m.Max(); k != 9 {
+		t.Errorf("Unexpected max key: %d", k)
+	}
+
+	var keys []types.Slot
+	collect := func(k types.Slot, _ string) bool {
+		keys = append(keys, k)
+		return true
+	}
+	m.AscendRange(2, 7, collect)
+	if !reflect.DeepEqual(keys, []types.Slot{3, 5, 7}) {
+		t.Errorf("Unexpected ascending keys: %v", keys)
+	}
+	keys = nil
+	m.DescendRange(8, 3, collect)
+	if !reflect.DeepEqual(keys, []types.Slot{7, 5, 3}) {
+		t.Errorf("Unexpected descending keys: %v", keys)
+	}
+	keys = nil
+	m.DescendRange(100, 0, func(k types.Slot, v string) bool {
+		keys = append(keys, k)
+		return len(keys) < 2
+	})
+	if !reflect.DeepEqual(keys, []types.Slot{9, 7}) {
+		t.Errorf("Unexpected descending keys: %v", keys)
+	}
+
+	if !m.Delete(9) || m.Delete(9) {
+		t.Error("Unexpected result of Delete")
+	}
+	if k, _, _ := m.Max(); k != 7 {
+		t.Errorf("Unexpected max key: %d", k)
+	}
+	if n := m.DeleteBelow(5); n != 2 {
+		t.Errorf("Unexpected number of deleted entries: %d", n)
+	}
+	if keys := m.Keys(); !reflect.DeepEqual(keys, []types.Slot{5, 7}) || m.Len() != 2 {
+		t.Errorf("Unexpected keys: %v", keys)
+	}
+	if n := m.DeleteBelow(100); n != 2 || m.Len() != 0 {
+		t.Errorf("Unexpected number of deleted entries: %d", n)
+	}
+	if _, _, ok := m.Max(); ok {
+		t.Error("Empty map should have no max entry")
+	}
+}
+
+func TestOrderedMap_Random(t *testing.T) {
+	m := NewOrderedMap[types.Epoch, int]()
+	ref := make(map[types.Epoch]int)
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		key := types.Epoch(r.Intn(2000))
+		switch r.Intn(10) {
+		case 0:
+			m.Delete(key)
+			delete(ref, key)
+		case 1:
+			if r.Intn(20) == 0 {
+				m.DeleteBelow(key)
+				for k := range ref {
+					if k < key {
+						delete(ref, k)
+					}
+				}
+			}
+		default:
+			m.Set(key, i)
+			ref[key] = i
+		}
+	}
+	keys := make([]types.Epoch, 0, len(ref))
+	for k := range ref {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	if got := m.Keys(); !reflect.DeepEqual(got, keys) || m.Len() != len(ref) {
+		t.Fatalf("Unexpected keys: %v", got)
+	}
+	var descending []types.Epoch
+	m.DescendRange(types.FarFutureEpoch, 0, func(k types.Epoch, v int) bool {
+		if v != ref[k] {
+			t.Errorf("Unexpected value for %d: %d", k, v)
+		}
+		descending = append(descending, k)
+		return true
+	})
+	for i, k := range descending {
+		if keys[len(keys)-1-i] != k {
+			t.Fatalf("Unexpected descending order: %v", descending)
+		}
+	}
+}
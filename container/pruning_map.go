@@ -0,0 +1,94 @@
+package container
+
+import (
+	"sort"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+// PruningMap is a map keyed by epoch (or slot), which can be trimmed as finality advances.
+// When max size is set, inserting new key into a full map evicts the entry with the lowest key.
+type PruningMap[K types.Uint64Like, V any] struct {
+	items   map[K]V
+	keys    []K // sorted in ascending order
+	maxSize int
+}
+
+// NewPruningMap creates map holding at most maxSize entries (zero means no limit).
+func NewPruningMap[K types.Uint64Like, V any](maxSize int) *PruningMap[K, V] {
+	return &PruningMap[K, V]{
+		items:   make(map[K]V),
+		maxSize: maxSize,
+	}
+}
+
+// Get returns value stored for the key.
+func (m *PruningMap[K, V]) Get(key K) (V, bool) {
+	v, ok := m.items[key]
+	return v, ok
+}
+
+// Set stores value for the key, evicting the lowest key if map is full.
+func (m *PruningMap[K, V]) Set(key K, value V) {
+	if _, ok := m.items[key]; !ok {
+		if m.maxSize > 0 && len(m.items) >= m.maxSize {
+			// Inserting key lower than everything in the full map would evict it straight away.
+			if key < m.keys[0] {
+				return
+			}
+			delete(m.items, m.keys[0])
+			m.keys = m.keys[1:]
+		}
+		m.insertKey(key)
+	}
+	m.items[key] = value
+}
+
+// Delete removes entry for the key.
+func (m *PruningMap[K, V]) Delete(key K) {
+	if _, ok := m.items[key]; !ok {
+		return
+	}
+	delete(m.items, key)
+	i := m.search(key)
+	m.keys = append(m.keys[:i], m.keys[i+1:]...)
+}
+
+// PruneBefore removes all entries with keys lower than the given one, returns number of removed entries.
+func (m *PruningMap[K, V]) PruneBefore(key K) int {
+	n := m.search(key)
+	for _, k := range m.keys[:n] {
+		delete(m.items, k)
+	}
+	m.keys = m.keys[n:]
+	return n
+}
+
+// Len returns number of entries.
+func (m *PruningMap[K, V]) Len() int {
+	return len(m.items)
+}
+
+// Keys returns all keys in ascending order.
+func (m *PruningMap[K, V]) Keys() []K {
+	keys := make([]K, len(m.keys))
+	copy(keys, m.keys)
+	return keys
+}
+
+// insertKey adds new key, keeping keys sorted (keys mostly come in ascending order, so this is cheap).
+func (m *PruningMap[K, V]) insertKey(key K) {
+	i := m.search(key)
+	m.keys = append(m.keys, key)
+	if i < len(m.keys)-1 {
+		copy(m.keys[i+1:], m.keys[i:])
+		m.keys[i] = key
+	}
+}
+
+// search returns position of the first key which is not lower than the given one.
+func (m *PruningMap[K, V]) search(key K) int {
+	return sort.Search(len(m.keys), func(i int) bool {
+		return m.keys[i] >= key
+	})
+}
@@ -0,0 +1,62 @@
+package container
+
+import (
+	"reflect"
+	"testing"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+func TestPruningMap(t *testing.T) {
+	m := NewPruningMap[types.Epoch, string](0)
+	for _, e := range []types.Epoch{5, 1, 3, 4, 2} {
+		m.Set(e, e.String())
+	}
+	m.Set(3, "three")
+	if v, ok := m.Get(3); !ok || v != "three" {
+		t.Errorf("Unexpected value: %q", v)
+	}
+	if keys := m.Keys(); !reflect.DeepEqual(keys, []types.Epoch{1, 2, 3, 4, 5}) {
+		t.Errorf("Unexpected keys: %v", keys)
+	}
+
+	if n := m.PruneBefore(3); n != 2 {
+		t.Errorf("Unexpected number of pruned entries: %d", n)
+	}
+	if _, ok := m.Get(2); ok {
+		t.Error("Entry should be pruned")
+	}
+	m.Delete(4)
+	m.Delete(42)
+	if keys := m.Keys(); !reflect.DeepEqual(keys, []types.Epoch{3, 5}) || m.Len() != 2 {
+		t.Errorf("Unexpected keys: %v", keys)
+	}
+	if n := m.PruneBefore(100); n != 2 || m.Len() != 0 {
+		t.Errorf("Unexpected number of pruned entries: %d", n)
+	}
+}
+
+func TestPruningMap_MaxSize(t *testing.T) {
+	m := NewPruningMap[types.Slot, int](3)
+	for s := types.Slot(10); s < 15; s++ {
+		m.Set(s, int(s))
+	}
+	if keys := m.Keys(); !reflect.DeepEqual(keys, []types.Slot{12, 13, 14}) {
+		t.Errorf("Unexpected keys: %v", keys)
+	}
+	// Updating existing key doesn't evict anything.
+	m.Set(12, 0)
+	if m.Len() != 3 {
+		t.Errorf("Unexpected length: %d", m.Len())
+	}
+	// Key lower than everything else in the full map is ignored.
+	m.Set(1, 1)
+	if _, ok := m.Get(1); ok {
+		t.Error("Stale key should not be inserted")
+	}
+	m.Set(13, 42)
+	m.Set(11, 11)
+	if keys := m.Keys(); !reflect.DeepEqual(keys, []types.Slot{12, 13, 14}) {
+		t.Errorf("Unexpected keys: %v", keys)
+	}
+}
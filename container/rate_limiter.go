@@ -0,0 +1,106 @@
+package container
+
+import (
+	"sync"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+// RateLimiter is a token bucket limiter driven by protocol time: every key (e.g. peer ID or API
+// client) may spend up to capacity tokens per period of slots, with the bucket refilled at the start
+// of each period. It is safe for concurrent use.
+type RateLimiter[K comparable] struct {
+	lock     sync.Mutex
+	capacity uint64
+	period   types.Slot
+	buckets  map[K]*rateBucket
+}
+
+// rateBucket holds tokens left for the period starting at the given slot.
+type rateBucket struct {
+	start  types.Slot
+	tokens uint64
+}
+
+// NewSlotRateLimiter creates limiter allowing capacity tokens per key per slot.
+func NewSlotRateLimiter[K comparable](capacity uint64) *RateLimiter[K] {
+	return NewRateLimiter[K](capacity, 1)
+}
+
+// NewEpochRateLimiter creates limiter allowing capacity tokens per key per epoch.
+func NewEpochRateLimiter[K comparable](capacity uint64, spec *types.ChainSpec) *RateLimiter[K] {
+	return NewRateLimiter[K](capacity, spec.SlotsPerEpoch)
+}
+
+// NewRateLimiter creates limiter allowing capacity tokens per key per period slots (zero period is
+// treated as a single slot).
+func NewRateLimiter[K comparable](capacity uint64, period types.Slot) *RateLimiter[K] {
+	if period == 0 {
+		period = 1
+	}
+	return &RateLimiter[K]{
+		capacity: capacity,
+		period:   period,
+		buckets:  make(map[K]*rateBucket),
+	}
+}
+
+// Allow spends a single token of the key at slot, returns false if the key is out of tokens.
+func (l *RateLimiter[K]) Allow(key K, slot types.Slot) bool {
+	return l.AllowN(key, slot, 1)
+}
+
+// AllowN spends n tokens of the key at slot, returns false (spending nothing) if fewer than n tokens
+// are left within the current period.
+func (l *RateLimiter[K]) AllowN(key K, slot types.Slot, n uint64) bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	start := l.periodStart(slot)
+	bucket, ok := l.buckets[key]
+	if !ok {
+		bucket = &rateBucket{start: start, tokens: l.capacity}
+		l.buckets[key] = bucket
+	} else if start > bucket.start {
+		bucket.start, bucket.tokens = start, l.capacity
+	}
+	if bucket.tokens < n {
+		return false
+	}
+	bucket.tokens -= n
+	return true
+}
+
+// Remaining returns number of tokens the key can still spend at slot.
+func (l *RateLimiter[K]) Remaining(key K, slot types.Slot) uint64 {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	bucket, ok := l.buckets[key]
+	if !ok || l.periodStart(slot) > bucket.start {
+		return l.capacity
+	}
+	return bucket.tokens
+}
+
+// Prune drops buckets of periods preceding the one slot belongs to (those are full again anyway).
+func (l *RateLimiter[K]) Prune(slot types.Slot) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	start := l.periodStart(slot)
+	for key, bucket := range l.buckets {
+		if bucket.start < start {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// Len returns number of tracked keys.
+func (l *RateLimiter[K]) Len() int {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	return len(l.buckets)
+}
+
+// periodStart returns the first slot of the period slot belongs to.
+func (l *RateLimiter[K]) periodStart(slot types.Slot) types.Slot {
+	return slot - slot%l.period
+}
@@ -0,0 +1,80 @@
+package container
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+func TestRateLimiter(t *testing.T) {
+	l := NewSlotRateLimiter[string](3)
+	for i := 0; i < 3; i++ {
+		if !l.Allow("a", 10) {
+			t.Fatalf("Request %d should be allowed", i)
+		}
+	}
+	if l.Allow("a", 10) {
+		t.Error("Request over capacity should be denied")
+	}
+	if !l.Allow("b", 10) {
+		t.Error("Keys should have independent buckets")
+	}
+	if l.Allow("a", 9) {
+		t.Error("Past slot should not refill the bucket")
+	}
+	if got := l.Remaining("a", 11); got != 3 {
+		t.Errorf("Unexpected remaining tokens: %d", got)
+	}
+	if l.AllowN("a", 11, 4) || !l.AllowN("a", 11, 2) {
+		t.Error("Unexpected AllowN result")
+	}
+	if got := l.Remaining("a", 11); got != 1 {
+		t.Errorf("Unexpected remaining tokens: %d", got)
+	}
+
+	l.Prune(12)
+	if l.Len() != 0 {
+		t.Errorf("Unexpected length after pruning: %d", l.Len())
+	}
+}
+
+func TestEpochRateLimiter(t *testing.T) {
+	l := NewEpochRateLimiter[types.ValidatorIndex](2, &types.ChainSpec{SlotsPerEpoch: 8})
+	if !l.Allow(1, 8) || !l.Allow(1, 15) {
+		t.Fatal("Requests within capacity should be allowed")
+	}
+	if l.Allow(1, 12) {
+		t.Error("Bucket should be refilled only in the next epoch")
+	}
+	if !l.Allow(1, 16) {
+		t.Error("Bucket should be refilled in the next epoch")
+	}
+	l.Allow(2, 8)
+	l.Prune(16)
+	if l.Len() != 1 || l.Remaining(1, 16) != 1 {
+		t.Error("Only buckets of past epochs should be pruned")
+	}
+}
+
+func TestRateLimiter_Concurrent(t *testing.T) {
+	l := NewSlotRateLimiter[int](100)
+	var allowed int64
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				if l.Allow(0, 5) {
+					atomic.AddInt64(&allowed, 1)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if allowed != 100 {
+		t.Errorf("Unexpected number of allowed requests: %d", allowed)
+	}
+}
@@ -0,0 +1,134 @@
+package container
+
+import (
+	"container/list"
+	"sync"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+// RootLRU is a least recently used cache keyed by root (the fixed size array is used as the map key
+// directly, no conversions to string needed). Entries can optionally be tagged with an epoch, so that
+// they can be expired as the chain advances. It is safe for concurrent use.
+type RootLRU[V any] struct {
+	lock    sync.Mutex
+	items   map[types.Root]*list.Element
+	order   *list.List // front is the most recently used entry
+	maxSize int
+}
+
+// rootLRUEntry is a cached value along with its key and (optional) epoch.
+type rootLRUEntry[V any] struct {
+	root    types.Root
+	value   V
+	epoch   types.Epoch
+	expires bool
+}
+
+// NewRootLRU creates cache holding at most maxSize entries, panics if maxSize is not positive.
+func NewRootLRU[V any](maxSize int) *RootLRU[V] {
+	if maxSize <= 0 {
+		panic("non-positive size")
+	}
+	return &RootLRU[V]{
+		items:   make(map[types.Root]*list.Element, maxSize),
+		order:   list.New(),
+		maxSize: maxSize,
+	}
+}
+
+// Get returns value cached for the root, marking it as recently used.
+func (c *RootLRU[V]) Get(root types.Root) (V, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if el, ok := c.items[root]; ok {
+		c.order.MoveToFront(el)
+		return el.Value.(*rootLRUEntry[V]).value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Contains returns true if value for the root is cached, without affecting its recency.
+func (c *RootLRU[V]) Contains(root types.Root) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, ok := c.items[root]
+	return ok
+}
+
+// Add caches value for the root (which never expires), evicting the least recently used entry if
+// cache is full. Returns true if an entry has been evicted.
+func (c *RootLRU[V]) Add(root types.Root, value V) bool {
+	return c.add(&rootLRUEntry[V]{root: root, value: value})
+}
+
+// AddAt caches value for the root, tagging it with the epoch (see PruneBefore). Evicts the least
+// recently used entry if cache is full, returns true if an entry has been evicted.
+func (c *RootLRU[V]) AddAt(epoch types.Epoch, root types.Root, value V) bool {
+	return c.add(&rootLRUEntry[V]{root: root, value: value, epoch: epoch, expires: true})
+}
+
+// Remove drops entry for the root, returns false if there's no such entry.
+func (c *RootLRU[V]) Remove(root types.Root) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	el, ok := c.items[root]
+	if ok {
+		c.removeElement(el)
+	}
+	return ok
+}
+
+// PruneBefore drops entries tagged with epochs lower than the given one, returns number of removed
+// entries. Entries added without epoch are not affected.
+func (c *RootLRU[V]) PruneBefore(epoch types.Epoch) int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	n := 0
+	for el := c.order.Front(); el != nil; {
+		next := el.Next()
+		if entry := el.Value.(*rootLRUEntry[V]); entry.expires && entry.epoch < epoch {
+			c.removeElement(el)
+			n++
+		}
+		el = next
+	}
+	return n
+}
+
+// Len returns number of cached entries.
+func (c *RootLRU[V]) Len() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.order.Len()
+}
+
+// Purge drops all entries.
+func (c *RootLRU[V]) Purge() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.items = make(map[types.Root]*list.Element, c.maxSize)
+	c.order.Init()
+}
+
+func (c *RootLRU[V]) add(entry *rootLRUEntry[V]) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if el, ok := c.items[entry.root]; ok {
+		el.Value = entry
+		c.order.MoveToFront(el)
+		return false
+	}
+	c.items[entry.root] = c.order.PushFront(entry)
+	if c.order.Len() > c.maxSize {
+		c.removeElement(c.order.Back())
+		return true
+	}
+	return false
+}
+
+func (c *RootLRU[V]) removeElement(el *list.Element) {
+	c.order.Remove(el)
+	delete(c.items, el.Value.(*rootLRUEntry[V]).root)
+}
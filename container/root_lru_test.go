@@ -0,0 +1,79 @@
+package container
+
+import (
+	"sync"
+	"testing"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+func TestRootLRU(t *testing.T) {
+	c := NewRootLRU[int](2)
+	r1, r2, r3 := types.Root{1}, types.Root{2}, types.Root{3}
+	if c.Add(r1, 1) || c.Add(r2, 2) {
+		t.Error("Nothing should be evicted yet")
+	}
+	// Touch r1, so that r2 becomes the least recently used entry.
+	if v, ok := c.Get(r1); !ok || v != 1 {
+		t.Errorf("Unexpected value: %d", v)
+	}
+	if !c.Add(r3, 3) {
+		t.Error("Entry should be evicted")
+	}
+	if c.Contains(r2) || !c.Contains(r1) || !c.Contains(r3) {
+		t.Error("Least recently used entry should be evicted")
+	}
+	if c.Add(r3, 33) {
+		t.Error("Updating entry should not evict")
+	}
+	if v, _ := c.Get(r3); v != 33 {
+		t.Errorf("Unexpected value: %d", v)
+	}
+	if !c.Remove(r1) || c.Remove(r1) || c.Len() != 1 {
+		t.Error("Unexpected result of Remove")
+	}
+	c.Purge()
+	if c.Len() != 0 {
+		t.Errorf("Unexpected length: %d", c.Len())
+	}
+}
+
+func TestRootLRU_PruneBefore(t *testing.T) {
+	c := NewRootLRU[string](10)
+	c.AddAt(1, types.Root{1}, "a")
+	c.AddAt(2, types.Root{2}, "b")
+	c.AddAt(3, types.Root{3}, "c")
+	c.Add(types.Root{4}, "d")
+	if n := c.PruneBefore(3); n != 2 {
+		t.Errorf("Unexpected number of pruned entries: %d", n)
+	}
+	if c.Contains(types.Root{2}) || !c.Contains(types.Root{3}) || !c.Contains(types.Root{4}) {
+		t.Error("Unexpected entries after pruning")
+	}
+	if n := c.PruneBefore(types.FarFutureEpoch); n != 1 || c.Len() != 1 {
+		t.Errorf("Entries without epoch should not expire: %d", n)
+	}
+}
+
+func TestRootLRU_Concurrent(t *testing.T) {
+	c := NewRootLRU[int](64)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				root := types.Root{byte(i), byte(j)}
+				c.AddAt(types.Epoch(j), root, j)
+				c.Get(root)
+				if j%100 == 0 {
+					c.PruneBefore(types.Epoch(j))
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+	if c.Len() > 64 {
+		t.Errorf("Unexpected length: %d", c.Len())
+	}
+}
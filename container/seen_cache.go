@@ -0,0 +1,81 @@
+package container
+
+import (
+	"sync"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+// SeenCache tracks (slot, index) pairs which have already been seen (e.g. attestations of a
+// validator or aggregates of a committee received via gossip). Pairs are kept for retention
+// number of epochs, as long as Prune is called on epoch transitions. It is safe for concurrent use.
+type SeenCache[I types.Uint64Like] struct {
+	lock      sync.Mutex
+	spec      *types.ChainSpec
+	retention types.Epoch
+	seen      map[types.Epoch]map[seenKey[I]]struct{}
+}
+
+// seenKey identifies seen item.
+type seenKey[I types.Uint64Like] struct {
+	slot  types.Slot
+	index I
+}
+
+// NewSeenCache creates cache keeping entries for retention epochs.
+func NewSeenCache[I types.Uint64Like](spec *types.ChainSpec, retention types.Epoch) *SeenCache[I] {
+	return &SeenCache[I]{
+		spec:      spec,
+		retention: retention,
+		seen:      make(map[types.Epoch]map[seenKey[I]]struct{}),
+	}
+}
+
+// MarkSeen records (slot, index) pair, returns true if the pair is seen for the first time.
+func (c *SeenCache[I]) MarkSeen(slot types.Slot, index I) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	epoch := slot.ToEpoch(c.spec)
+	bucket, ok := c.seen[epoch]
+	if !ok {
+		bucket = make(map[seenKey[I]]struct{})
+		c.seen[epoch] = bucket
+	}
+	key := seenKey[I]{slot: slot, index: index}
+	if _, ok := bucket[key]; ok {
+		return false
+	}
+	bucket[key] = struct{}{}
+	return true
+}
+
+// Seen returns true if (slot, index) pair has already been recorded.
+func (c *SeenCache[I]) Seen(slot types.Slot, index I) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	_, ok := c.seen[slot.ToEpoch(c.spec)][seenKey[I]{slot: slot, index: index}]
+	return ok
+}
+
+// Prune drops entries of epochs that are more than retention epochs behind the current one.
+func (c *SeenCache[I]) Prune(current types.Epoch) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for epoch := range c.seen {
+		// Epochs come from the network, so `epoch + retention` may overflow.
+		if epoch < current && current-epoch > c.retention {
+			delete(c.seen, epoch)
+		}
+	}
+}
+
+// Len returns number of recorded pairs.
+func (c *SeenCache[I]) Len() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	n := 0
+	for _, bucket := range c.seen {
+		n += len(bucket)
+	}
+	return n
+}
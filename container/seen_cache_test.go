@@ -0,0 +1,67 @@
+package container
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+func TestSeenCache(t *testing.T) {
+	spec := &types.ChainSpec{SlotsPerEpoch: 8}
+	c := NewSeenCache[types.ValidatorIndex](spec, 1)
+
+	if !c.MarkSeen(1, 10) {
+		t.Error("Pair should be seen for the first time")
+	}
+	if c.MarkSeen(1, 10) {
+		t.Error("Pair should already be seen")
+	}
+	if !c.MarkSeen(1, 11) || !c.MarkSeen(2, 10) {
+		t.Error("Different pairs should be seen for the first time")
+	}
+	c.MarkSeen(9, 10)  // epoch 1
+	c.MarkSeen(17, 10) // epoch 2
+	if c.Len() != 5 {
+		t.Errorf("Unexpected length: %d", c.Len())
+	}
+
+	c.Prune(1)
+	if !c.Seen(1, 10) {
+		t.Error("Epoch 0 is within retention period")
+	}
+	c.Prune(2)
+	if c.Seen(1, 10) || c.Seen(2, 10) || !c.Seen(9, 10) || !c.Seen(17, 10) {
+		t.Error("Only epoch 0 should be pruned")
+	}
+
+	// With a single slot per epoch, epoch of the slot near FarFutureSlot overflows once retention is added.
+	c = NewSeenCache[types.ValidatorIndex](&types.ChainSpec{SlotsPerEpoch: 1}, 2)
+	c.MarkSeen(types.FarFutureSlot-1, 1)
+	c.Prune(3)
+	if !c.Seen(types.FarFutureSlot-1, 1) {
+		t.Error("Entries of future epochs must not be pruned")
+	}
+}
+
+func TestSeenCache_Concurrent(t *testing.T) {
+	c := NewSeenCache[types.CommitteeIndex](&types.ChainSpec{SlotsPerEpoch: 32}, 2)
+	var firstSeen int64
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := types.CommitteeIndex(0); idx < 64; idx++ {
+				if c.MarkSeen(5, idx) {
+					atomic.AddInt64(&firstSeen, 1)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if firstSeen != 64 {
+		t.Errorf("Each pair must be seen for the first time exactly once, got %d", firstSeen)
+	}
+}
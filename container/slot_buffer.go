@@ -0,0 +1,88 @@
+package container
+
+import (
+	"errors"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+// ErrSlotTooOld is returned when value is set for a slot which is already out of the buffer window.
+var ErrSlotTooOld = errors.New("slot is older than buffer window")
+
+// SlotBuffer is a ring buffer holding values for the most recent N slots.
+// Setting value for a slot newer than the current head advances the window, implicitly evicting
+// values of slots falling out of it.
+type SlotBuffer[T any] struct {
+	entries []slotEntry[T]
+	head    types.Slot
+}
+
+// slotEntry is a value stored within the buffer, tagged with its slot.
+type slotEntry[T any] struct {
+	slot  types.Slot
+	value T
+	ok    bool
+}
+
+// NewSlotBuffer creates buffer holding values for size most recent slots, panics if size is zero.
+func NewSlotBuffer[T any](size uint64) *SlotBuffer[T] {
+	if size == 0 {
+		panic("zero size")
+	}
+	return &SlotBuffer[T]{entries: make([]slotEntry[T], size)}
+}
+
+// Head returns the most recent slot of the buffer window.
+func (b *SlotBuffer[T]) Head() types.Slot {
+	return b.head
+}
+
+// Size returns number of slots covered by the buffer window.
+func (b *SlotBuffer[T]) Size() uint64 {
+	return uint64(len(b.entries))
+}
+
+// Advance moves the window head forward to the slot (moving it backwards is a no-op).
+func (b *SlotBuffer[T]) Advance(slot types.Slot) {
+	if slot > b.head {
+		b.head = slot
+	}
+}
+
+// Set stores value for the slot, advancing the window if necessary.
+func (b *SlotBuffer[T]) Set(slot types.Slot, value T) error {
+	b.Advance(slot)
+	if !b.inWindow(slot) {
+		return ErrSlotTooOld
+	}
+	b.entries[b.index(slot)] = slotEntry[T]{slot: slot, value: value, ok: true}
+	return nil
+}
+
+// Get returns value stored for the slot, if slot is still within the window.
+func (b *SlotBuffer[T]) Get(slot types.Slot) (T, bool) {
+	e := b.entries[b.index(slot)]
+	if !e.ok || e.slot != slot || !b.inWindow(slot) {
+		var zero T
+		return zero, false
+	}
+	return e.value, true
+}
+
+// Delete removes value stored for the slot.
+func (b *SlotBuffer[T]) Delete(slot types.Slot) {
+	i := b.index(slot)
+	if b.entries[i].slot == slot {
+		b.entries[i] = slotEntry[T]{}
+	}
+}
+
+// inWindow returns true if slot is one of the last N slots up to the head.
+func (b *SlotBuffer[T]) inWindow(slot types.Slot) bool {
+	return slot <= b.head && uint64(b.head-slot) < b.Size()
+}
+
+// index returns position of the slot within the ring.
+func (b *SlotBuffer[T]) index(slot types.Slot) int {
+	return int(uint64(slot) % b.Size())
+}
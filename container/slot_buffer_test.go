@@ -0,0 +1,57 @@
+package container
+
+import (
+	"errors"
+	"testing"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+func TestSlotBuffer(t *testing.T) {
+	b := NewSlotBuffer[string](4)
+	for s := types.Slot(0); s < 4; s++ {
+		if err := b.Set(s, s.String()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if v, ok := b.Get(2); !ok || v != "2" {
+		t.Errorf("Unexpected value: %q", v)
+	}
+	if b.Head() != 3 {
+		t.Errorf("Unexpected head: %d", b.Head())
+	}
+
+	// Advancing the window evicts the oldest slots.
+	if err := b.Set(5, "5"); err != nil {
+		t.Fatal(err)
+	}
+	for s, want := range map[types.Slot]bool{0: false, 1: false, 2: true, 3: true, 4: false, 5: true} {
+		if _, ok := b.Get(s); ok != want {
+			t.Errorf("Unexpected presence of slot %d: %v", s, ok)
+		}
+	}
+
+	if err := b.Set(1, "1"); !errors.Is(err, ErrSlotTooOld) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if err := b.Set(4, "4"); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	b.Delete(4)
+	if _, ok := b.Get(4); ok {
+		t.Error("Value should be deleted")
+	}
+
+	// Jump far ahead evicts everything.
+	b.Advance(100)
+	for s := types.Slot(0); s < 10; s++ {
+		if _, ok := b.Get(s); ok {
+			t.Errorf("Slot %d should be evicted", s)
+		}
+	}
+	b.Advance(50)
+	if b.Head() != 100 {
+		t.Errorf("Head should not move backwards: %d", b.Head())
+	}
+}
@@ -0,0 +1,99 @@
+package container
+
+import (
+	"container/heap"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+// SlotQueue is a min-heap priority queue of values scheduled at slots, e.g. blocks or attestations
+// which can only be processed once their slot is reached.
+// Values scheduled at the same slot are popped in insertion order.
+type SlotQueue[T any] struct {
+	items slotQueueItems[T]
+	seq   uint64
+}
+
+// NewSlotQueue creates an empty queue.
+func NewSlotQueue[T any]() *SlotQueue[T] {
+	return &SlotQueue[T]{}
+}
+
+// Len returns number of queued values.
+func (q *SlotQueue[T]) Len() int {
+	return len(q.items)
+}
+
+// PushAt schedules value at the slot.
+func (q *SlotQueue[T]) PushAt(slot types.Slot, value T) {
+	heap.Push(&q.items, slotQueueItem[T]{slot: slot, seq: q.seq, value: value})
+	q.seq++
+}
+
+// Peek returns the earliest scheduled value along with its slot, without removing it.
+// Returns false if queue is empty.
+func (q *SlotQueue[T]) Peek() (types.Slot, T, bool) {
+	if len(q.items) == 0 {
+		var zero T
+		return 0, zero, false
+	}
+	return q.items[0].slot, q.items[0].value, true
+}
+
+// Pop removes and returns the earliest scheduled value along with its slot.
+// Returns false if queue is empty.
+func (q *SlotQueue[T]) Pop() (types.Slot, T, bool) {
+	if len(q.items) == 0 {
+		var zero T
+		return 0, zero, false
+	}
+	item := heap.Pop(&q.items).(slotQueueItem[T])
+	return item.slot, item.value, true
+}
+
+// PopDue removes and returns all values scheduled at or before the current slot, ordered by slot.
+func (q *SlotQueue[T]) PopDue(current types.Slot) []T {
+	var due []T
+	for len(q.items) > 0 && q.items[0].slot <= current {
+		due = append(due, heap.Pop(&q.items).(slotQueueItem[T]).value)
+	}
+	return due
+}
+
+// slotQueueItem is a queued value, tagged with its slot and insertion sequence number.
+type slotQueueItem[T any] struct {
+	slot  types.Slot
+	seq   uint64
+	value T
+}
+
+// slotQueueItems implements heap.Interface.
+type slotQueueItems[T any] []slotQueueItem[T]
+
+func (h slotQueueItems[T]) Len() int {
+	return len(h)
+}
+
+func (h slotQueueItems[T]) Less(i, j int) bool {
+	if h[i].slot != h[j].slot {
+		return h[i].slot < h[j].slot
+	}
+	return h[i].seq < h[j].seq
+}
+
+func (h slotQueueItems[T]) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+}
+
+func (h *slotQueueItems[T]) Push(x any) {
+	*h = append(*h, x.(slotQueueItem[T]))
+}
+
+func (h *slotQueueItems[T]) Pop() any {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = slotQueueItem[T]{}
+	*h = old[:n-1]
+	return item
+}
@@ -0,0 +1,39 @@
+package container
+
+import (
+	"reflect"
+	"testing"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+func TestSlotQueue(t *testing.T) {
+	q := NewSlotQueue[string]()
+	if _, _, ok := q.Pop(); ok {
+		t.Error("Empty queue should not pop values")
+	}
+	q.PushAt(5, "e")
+	q.PushAt(2, "b1")
+	q.PushAt(7, "g")
+	q.PushAt(2, "b2")
+	q.PushAt(1, "a")
+	if q.Len() != 5 {
+		t.Errorf("Unexpected length: %d", q.Len())
+	}
+	if slot, v, ok := q.Peek(); !ok || slot != 1 || v != "a" {
+		t.Errorf("Unexpected head: %d %q", slot, v)
+	}
+
+	if due := q.PopDue(0); len(due) != 0 {
+		t.Errorf("Unexpected due values: %v", due)
+	}
+	if due := q.PopDue(2); !reflect.DeepEqual(due, []string{"a", "b1", "b2"}) {
+		t.Errorf("Unexpected due values: %v", due)
+	}
+	if slot, v, ok := q.Pop(); !ok || slot != 5 || v != "e" {
+		t.Errorf("Unexpected popped value: %d %q", slot, v)
+	}
+	if due := q.PopDue(types.FarFutureSlot); !reflect.DeepEqual(due, []string{"g"}) || q.Len() != 0 {
+		t.Errorf("Unexpected due values: %v", due)
+	}
+}
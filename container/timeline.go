@@ -0,0 +1,71 @@
+package container
+
+import (
+	"sort"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+// Timeline stores values which become effective from a given key (slot or epoch) onward, e.g.
+// fork dependent parameters. Lookups find the value with the greatest activation key not exceeding
+// the requested one.
+type Timeline[K types.Uint64Like, T any] struct {
+	entries []timelineEntry[K, T] // sorted by activation key
+}
+
+// timelineEntry is a value along with its activation key.
+type timelineEntry[K types.Uint64Like, T any] struct {
+	from  K
+	value T
+}
+
+// Set makes value effective from the given key onward (until the next activation key).
+func (t *Timeline[K, T]) Set(from K, value T) {
+	i := sort.Search(len(t.entries), func(i int) bool {
+		return t.entries[i].from >= from
+	})
+	if i < len(t.entries) && t.entries[i].from == from {
+		t.entries[i].value = value
+		return
+	}
+	t.entries = append(t.entries, timelineEntry[K, T]{})
+	copy(t.entries[i+1:], t.entries[i:])
+	t.entries[i] = timelineEntry[K, T]{from: from, value: value}
+}
+
+// At returns value effective at the given key, false is returned if key precedes all activations.
+func (t *Timeline[K, T]) At(key K) (T, bool) {
+	i := sort.Search(len(t.entries), func(i int) bool {
+		return t.entries[i].from > key
+	})
+	if i == 0 {
+		var zero T
+		return zero, false
+	}
+	return t.entries[i-1].value, true
+}
+
+// Len returns number of activations.
+func (t *Timeline[K, T]) Len() int {
+	return len(t.entries)
+}
+
+// ValueBySlot stores values effective from a given slot onward.
+type ValueBySlot[T any] struct {
+	Timeline[types.Slot, T]
+}
+
+// AtSlot returns value effective at the slot.
+func (v *ValueBySlot[T]) AtSlot(slot types.Slot) (T, bool) {
+	return v.At(slot)
+}
+
+// ValueByEpoch stores values effective from a given epoch onward.
+type ValueByEpoch[T any] struct {
+	Timeline[types.Epoch, T]
+}
+
+// AtEpoch returns value effective at the epoch.
+func (v *ValueByEpoch[T]) AtEpoch(epoch types.Epoch) (T, bool) {
+	return v.At(epoch)
+}
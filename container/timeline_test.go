@@ -0,0 +1,39 @@
+package container
+
+import (
+	"testing"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+func TestValueBySlot(t *testing.T) {
+	var limits ValueBySlot[int]
+	if _, ok := limits.AtSlot(100); ok {
+		t.Error("Empty timeline should have no values")
+	}
+	limits.Set(64, 6)
+	limits.Set(0, 3)
+	limits.Set(128, 9)
+	limits.Set(64, 8)
+
+	for slot, want := range map[uint64]int{0: 3, 63: 3, 64: 8, 127: 8, 128: 9, 1 << 40: 9} {
+		got, ok := limits.AtSlot(types.Slot(slot))
+		if !ok || got != want {
+			t.Errorf("AtSlot(%d) = %d, want %d", slot, got, want)
+		}
+	}
+	if limits.Len() != 3 {
+		t.Errorf("Unexpected number of activations: %d", limits.Len())
+	}
+}
+
+func TestValueByEpoch(t *testing.T) {
+	var v ValueByEpoch[string]
+	v.Set(10, "deneb")
+	if _, ok := v.AtEpoch(9); ok {
+		t.Error("Value should not be effective before activation")
+	}
+	if got, ok := v.AtEpoch(10); !ok || got != "deneb" {
+		t.Errorf("Unexpected value: %q", got)
+	}
+}
@@ -0,0 +1,104 @@
+package container
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/RoaringBitmap/roaring/roaring64"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+// ErrInvalidValidatorSet is returned when serialized validator set is malformed.
+var ErrInvalidValidatorSet = errors.New("invalid serialized validator set")
+
+// minSerializedBucketSize is the smallest number of bytes a single 64-bit roaring bucket takes:
+// 4 bytes of the key followed by (at least) cookie and container count of the 32-bit bitmap.
+const minSerializedBucketSize = 4 + 8
+
+// ValidatorSet is a compressed set of validator indices backed by a roaring bitmap: indices are
+// partitioned into chunks of 2^16 by their high bits, and each chunk is stored either as a sorted
+// array (sparse chunks), a bitmap (dense chunks) or a list of runs. A set of all active validators
+// takes ~1 bit per index, a sparse set ~2 bytes per index.
+// The zero value is an empty set, ready to use.
+type ValidatorSet struct {
+	bitmap roaring64.Bitmap
+}
+
+// NewValidatorSet creates set holding the given indices.
+func NewValidatorSet(indices ...types.ValidatorIndex) *ValidatorSet {
+	s := &ValidatorSet{}
+	for _, index := range indices {
+		s.Add(index)
+	}
+	return s
+}
+
+// Add inserts index into the set, returns false if it is already present.
+func (s *ValidatorSet) Add(index types.ValidatorIndex) bool {
+	return s.bitmap.CheckedAdd(uint64(index))
+}
+
+// Contains returns true if index is in the set.
+func (s *ValidatorSet) Contains(index types.ValidatorIndex) bool {
+	return s.bitmap.Contains(uint64(index))
+}
+
+// Cardinality returns number of indices in the set.
+func (s *ValidatorSet) Cardinality() int {
+	return int(s.bitmap.GetCardinality())
+}
+
+// Union returns a new set holding indices present in either of the sets.
+func (s *ValidatorSet) Union(other *ValidatorSet) *ValidatorSet {
+	return &ValidatorSet{bitmap: *roaring64.Or(&s.bitmap, &other.bitmap)}
+}
+
+// ForEach calls fn for every index in ascending order, until fn returns false.
+func (s *ValidatorSet) ForEach(fn func(types.ValidatorIndex) bool) {
+	it := s.bitmap.Iterator()
+	for it.HasNext() {
+		if !fn(types.ValidatorIndex(it.Next())) {
+			return
+		}
+	}
+}
+
+// Indices returns all indices of the set in ascending order.
+func (s *ValidatorSet) Indices() []types.ValidatorIndex {
+	indices := make([]types.ValidatorIndex, 0, s.Cardinality())
+	s.ForEach(func(index types.ValidatorIndex) bool {
+		indices = append(indices, index)
+		return true
+	})
+	return indices
+}
+
+// MarshalBinary serializes the set using the portable 64-bit roaring format, so it can be read by
+// other roaring implementations (Java, C++, etc.).
+func (s *ValidatorSet) MarshalBinary() ([]byte, error) {
+	return s.bitmap.MarshalBinary()
+}
+
+// UnmarshalBinary decodes the set from the portable 64-bit roaring format, replacing its contents.
+func (s *ValidatorSet) UnmarshalBinary(data []byte) error {
+	if len(data) < 8 {
+		return fmt.Errorf("%w: unexpected end of data", ErrInvalidValidatorSet)
+	}
+	// Bucket count is trusted by roaring to preallocate, make sure data can actually hold that many.
+	if count := binary.LittleEndian.Uint64(data); count > uint64(len(data)-8)/minSerializedBucketSize {
+		return fmt.Errorf("%w: %d buckets in %d bytes", ErrInvalidValidatorSet, count, len(data))
+	}
+	var bitmap roaring64.Bitmap
+	r := bytes.NewReader(data)
+	if _, err := bitmap.ReadFrom(r); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidValidatorSet, err)
+	}
+	if r.Len() != 0 {
+		return fmt.Errorf("%w: %d trailing bytes", ErrInvalidValidatorSet, r.Len())
+	}
+	s.bitmap = bitmap
+	return nil
+}
@@ -0,0 +1,129 @@
+package container
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+func TestValidatorSet(t *testing.T) {
+	s := NewValidatorSet(5, 1, 1<<16+3, 3)
+	if !s.Add(2) || s.Add(5) {
+		t.Error("Unexpected result of Add")
+	}
+	if s.Cardinality() != 5 {
+		t.Errorf("Unexpected cardinality: %d", s.Cardinality())
+	}
+	for _, index := range []types.ValidatorIndex{1, 2, 3, 5, 1<<16 + 3} {
+		if !s.Contains(index) {
+			t.Errorf("Index %d should be in the set", index)
+		}
+	}
+	for _, index := range []types.ValidatorIndex{0, 4, 1 << 16, 1<<32 + 1} {
+		if s.Contains(index) {
+			t.Errorf("Index %d should not be in the set", index)
+		}
+	}
+	if indices := s.Indices(); !reflect.DeepEqual(indices, []types.ValidatorIndex{1, 2, 3, 5, 1<<16 + 3}) {
+		t.Errorf("Unexpected indices: %v", indices)
+	}
+	var zero ValidatorSet
+	if zero.Contains(0) || zero.Cardinality() != 0 || len(zero.Indices()) != 0 {
+		t.Error("Zero value should be an empty set")
+	}
+}
+
+func TestValidatorSet_Dense(t *testing.T) {
+	s := NewValidatorSet()
+	for i := types.ValidatorIndex(0); i < 300_000; i += 2 {
+		s.Add(i)
+	}
+	if s.Cardinality() != 150_000 {
+		t.Errorf("Unexpected cardinality: %d", s.Cardinality())
+	}
+	if !s.Contains(299_998) || s.Contains(299_999) {
+		t.Error("Unexpected membership")
+	}
+	data, err := s.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Indices span 5 chunks, each dense one is stored as 8KiB bitmap.
+	if len(data) > 5*8192+128 {
+		t.Errorf("Unexpected serialized size: %d", len(data))
+	}
+}
+
+func TestValidatorSet_Union(t *testing.T) {
+	a, b := NewValidatorSet(1, 2, 1<<17), NewValidatorSet(2, 3, 1<<16)
+	for i := types.ValidatorIndex(0); i < 5000; i++ {
+		b.Add(1<<17 + i*3)
+	}
+	u := a.Union(b)
+	if u.Cardinality() != 5004 {
+		t.Errorf("Unexpected cardinality: %d", u.Cardinality())
+	}
+	for _, index := range []types.ValidatorIndex{1, 2, 3, 1 << 16, 1 << 17, 1<<17 + 3*4999} {
+		if !u.Contains(index) {
+			t.Errorf("Index %d should be in the union", index)
+		}
+	}
+	// Operands must be left intact.
+	if a.Cardinality() != 3 || b.Cardinality() != 5003 {
+		t.Error("Union should not modify operands")
+	}
+	u.Add(100)
+	if a.Contains(100) || b.Contains(100) {
+		t.Error("Union should not share chunks with operands")
+	}
+}
+
+func TestValidatorSet_MarshalBinary(t *testing.T) {
+	s := NewValidatorSet(0, 7, 1<<40)
+	for i := types.ValidatorIndex(0); i < 10_000; i++ {
+		s.Add(1<<20 + i)
+	}
+	data, err := s.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded ValidatorSet
+	if err := decoded.UnmarshalBinary(data); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded.Indices(), s.Indices()) {
+		t.Error("Decoded set differs from the original")
+	}
+
+	// Portable 64-bit roaring format: bucket count, then 32-bit key followed by the standard
+	// roaring bitmap (cookie, container count, key/cardinality header, offsets and values).
+	want := []byte{
+		0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00,
+		0x3a, 0x30, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x01, 0x00, 0x10, 0x00, 0x00, 0x00,
+		0x01, 0x00, 0x02, 0x00,
+	}
+	if data, err := NewValidatorSet(2, 1).MarshalBinary(); err != nil || !bytes.Equal(data, want) {
+		t.Errorf("Unexpected serialization: %x, %v", data, err)
+	}
+
+	for _, data := range [][]byte{
+		{},
+		{0x05},
+		{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x00, 0x00, 0x00, 0x00}, // bucket count too large
+		want[:len(want)-1],                       // truncated values
+		append(want[:len(want):len(want)], 0xff), // trailing bytes
+		{0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x02, 0x03, 0x04, 0x00, 0x00, 0x00, 0x00}, // bad cookie
+	} {
+		if err := decoded.UnmarshalBinary(data); !errors.Is(err, ErrInvalidValidatorSet) {
+			t.Errorf("Expected error for %x, got: %v", data, err)
+		}
+	}
+	if !reflect.DeepEqual(decoded.Indices(), s.Indices()) {
+		t.Error("Failed decoding should leave the set intact")
+	}
+}
@@ -0,0 +1,130 @@
+package types
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidCursor is returned when pagination cursor cannot be decoded.
+var ErrInvalidCursor = errors.New("invalid pagination cursor")
+
+// Direction is the direction of paginated listing.
+type Direction uint8
+
+// Pagination directions.
+const (
+	Ascending Direction = iota
+	Descending
+)
+
+// String returns direction name ("asc" or "desc").
+func (d Direction) String() string {
+	switch d {
+	case Ascending:
+		return "asc"
+	case Descending:
+		return "desc"
+	default:
+		return fmt.Sprintf("Direction(%d)", d)
+	}
+}
+
+// ParseDirection parses direction name ("asc" or "desc").
+func ParseDirection(s string) (Direction, error) {
+	switch s {
+	case "asc":
+		return Ascending, nil
+	case "desc":
+		return Descending, nil
+	default:
+		return 0, fmt.Errorf("invalid direction %q", s)
+	}
+}
+
+// cursorVersion is the version of the cursor encoding, bumped on incompatible changes.
+const cursorVersion = 1
+
+// cursorSize is the size of the encoded cursor: version, kind, direction, position and root.
+const cursorSize = 3 + 8 + 32
+
+// CursorPosition is the type of values listings are ordered by.
+type CursorPosition interface {
+	Slot | Epoch
+}
+
+// Cursor is the position of a paginated listing: the last returned item (identified by its slot or
+// epoch and root, to break ties) and the listing direction. It is exchanged with clients as an
+// opaque URL-safe token.
+type Cursor[T CursorPosition] struct {
+	Position  T
+	Root      Root
+	Direction Direction
+}
+
+// ParseCursor decodes cursor token, validating that it was produced for the same position type.
+func ParseCursor[T CursorPosition](token string) (Cursor[T], error) {
+	buf, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(buf) != cursorSize {
+		return Cursor[T]{}, fmt.Errorf("%w: malformed token", ErrInvalidCursor)
+	}
+	if buf[0] != cursorVersion {
+		return Cursor[T]{}, fmt.Errorf("%w: unsupported version %d", ErrInvalidCursor, buf[0])
+	}
+	if buf[1] != cursorKind[T]() {
+		return Cursor[T]{}, fmt.Errorf("%w: token is not a %s cursor", ErrInvalidCursor, cursorKindName[T]())
+	}
+	c := Cursor[T]{Direction: Direction(buf[2])}
+	if c.Direction != Ascending && c.Direction != Descending {
+		return Cursor[T]{}, fmt.Errorf("%w: unknown direction %d", ErrInvalidCursor, buf[2])
+	}
+	c.Position = T(binary.BigEndian.Uint64(buf[3:11]))
+	copy(c.Root[:], buf[11:])
+	return c, nil
+}
+
+// Encode returns cursor as an opaque URL-safe token.
+func (c Cursor[T]) Encode() string {
+	buf := make([]byte, cursorSize)
+	buf[0], buf[1], buf[2] = cursorVersion, cursorKind[T](), byte(c.Direction)
+	binary.BigEndian.PutUint64(buf[3:11], uint64(c.Position))
+	copy(buf[11:], c.Root[:])
+	return base64.RawURLEncoding.EncodeToString(buf)
+}
+
+// String returns cursor token.
+func (c Cursor[T]) String() string {
+	return c.Encode()
+}
+
+// MarshalText encodes cursor as token.
+func (c Cursor[T]) MarshalText() ([]byte, error) {
+	return []byte(c.Encode()), nil
+}
+
+// UnmarshalText decodes cursor from token.
+func (c *Cursor[T]) UnmarshalText(text []byte) error {
+	parsed, err := ParseCursor[T](string(text))
+	if err != nil {
+		return err
+	}
+	*c = parsed
+	return nil
+}
+
+// cursorKind returns tag of the position type, so that slot cursors are not accepted as epoch ones.
+func cursorKind[T CursorPosition]() byte {
+	var zero T
+	if _, ok := interface{}(zero).(Slot); ok {
+		return 's'
+	}
+	return 'e'
+}
+
+func cursorKindName[T CursorPosition]() string {
+	if cursorKind[T]() == 's' {
+		return "slot"
+	}
+	return "epoch"
+}
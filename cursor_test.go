@@ -0,0 +1,59 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+	"testing"
+)
+
+func TestCursor_Encode(t *testing.T) {
+	c := Cursor[Slot]{Position: 123456, Root: Root{0xfe, 0xff}, Direction: Descending}
+	token := c.Encode()
+	if url.QueryEscape(token) != token {
+		t.Errorf("Token is not URL-safe: %s", token)
+	}
+	decoded, err := ParseCursor[Slot](token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded != c {
+		t.Errorf("Unexpected cursor: %+v", decoded)
+	}
+
+	enc, err := json.Marshal(map[string]Cursor[Epoch]{"next": {Position: 5, Direction: Ascending}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var page map[string]Cursor[Epoch]
+	if err := json.Unmarshal(enc, &page); err != nil {
+		t.Fatal(err)
+	}
+	if page["next"].Position != 5 || page["next"].Direction != Ascending {
+		t.Errorf("Unexpected cursor: %+v", page["next"])
+	}
+}
+
+func TestParseCursor_Invalid(t *testing.T) {
+	slotToken := Cursor[Slot]{Position: 1}.Encode()
+	if _, err := ParseCursor[Epoch](slotToken); !errors.Is(err, ErrInvalidCursor) {
+		t.Errorf("Expected error decoding slot cursor as epoch one, got %v", err)
+	}
+	invalidDirection := Cursor[Slot]{Direction: 2}.Encode()
+	for _, token := range []string{"", "!!!", slotToken[:len(slotToken)-2], slotToken + "AA", invalidDirection} {
+		if _, err := ParseCursor[Slot](token); !errors.Is(err, ErrInvalidCursor) {
+			t.Errorf("Expected error for %q, got %v", token, err)
+		}
+	}
+}
+
+func TestParseDirection(t *testing.T) {
+	for _, d := range []Direction{Ascending, Descending} {
+		if parsed, err := ParseDirection(d.String()); err != nil || parsed != d {
+			t.Errorf("Unexpected direction: %v, %v", parsed, err)
+		}
+	}
+	if _, err := ParseDirection("up"); err == nil {
+		t.Error("Expected error for unknown direction")
+	}
+}
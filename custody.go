@@ -0,0 +1,111 @@
+package types
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// ErrInvalidCustodyGroupCount is returned when custody group count exceeds the number of groups.
+var ErrInvalidCustodyGroupCount = errors.New("invalid custody group count")
+
+// CustodyGroup is an index of the group of data columns custodied together (PeerDAS).
+type CustodyGroup uint64
+
+// ColumnIndex is an index of the data column in the extended blob matrix.
+type ColumnIndex uint64
+
+// CustodyGroups returns sorted custody groups assigned to the node, given the number of groups it
+// custodies, see get_custody_groups.
+func (id NodeID) CustodyGroups(count uint64, spec *ChainSpec) ([]CustodyGroup, error) {
+	total := spec.NumberOfCustodyGroups
+	if count > total {
+		return nil, fmt.Errorf("%w: %d exceeds %d", ErrInvalidCustodyGroupCount, count, total)
+	}
+	groups := make([]CustodyGroup, 0, count)
+	if count == total {
+		for g := uint64(0); g < total; g++ {
+			groups = append(groups, CustodyGroup(g))
+		}
+		return groups, nil
+	}
+
+	seen := make(map[CustodyGroup]struct{}, count)
+	current := id
+	for uint64(len(groups)) < count {
+		// Node id is hashed as little-endian uint256.
+		var buf [32]byte
+		for i := range current {
+			buf[i] = current[len(current)-1-i]
+		}
+		digest := sum256(buf[:])
+		group := CustodyGroup(binary.LittleEndian.Uint64(digest[:8]) % total)
+		if _, ok := seen[group]; !ok {
+			seen[group] = struct{}{}
+			groups = append(groups, group)
+		}
+		current = current.next()
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i] < groups[j]
+	})
+	return groups, nil
+}
+
+// CustodyColumns returns sorted data columns custodied by the node, see get_custody_groups and
+// compute_columns_for_custody_group.
+func (id NodeID) CustodyColumns(groupCount uint64, spec *ChainSpec) ([]ColumnIndex, error) {
+	groups, err := id.CustodyGroups(groupCount, spec)
+	if err != nil {
+		return nil, err
+	}
+	columns := make([]ColumnIndex, 0, uint64(len(groups))*columnsPerGroup(spec))
+	for _, g := range groups {
+		columns = append(columns, g.Columns(spec)...)
+	}
+	sort.Slice(columns, func(i, j int) bool {
+		return columns[i] < columns[j]
+	})
+	return columns, nil
+}
+
+// CustodySubnets returns sorted, distinct data column sidecar subnets the node must subscribe to.
+func (id NodeID) CustodySubnets(groupCount uint64, spec *ChainSpec) ([]SubnetID, error) {
+	columns, err := id.CustodyColumns(groupCount, spec)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[SubnetID]struct{})
+	subnets := make([]SubnetID, 0, len(columns))
+	for _, c := range columns {
+		subnet := c.Subnet(spec)
+		if _, ok := seen[subnet]; !ok {
+			seen[subnet] = struct{}{}
+			subnets = append(subnets, subnet)
+		}
+	}
+	sort.Slice(subnets, func(i, j int) bool {
+		return subnets[i] < subnets[j]
+	})
+	return subnets, nil
+}
+
+// Columns returns data columns belonging to the custody group, see compute_columns_for_custody_group.
+func (g CustodyGroup) Columns(spec *ChainSpec) []ColumnIndex {
+	columns := make([]ColumnIndex, columnsPerGroup(spec))
+	for i := range columns {
+		columns[i] = ColumnIndex(spec.NumberOfCustodyGroups*uint64(i) + uint64(g))
+	}
+	return columns
+}
+
+// Subnet returns gossip subnet of the column's sidecars, see compute_subnet_for_data_column_sidecar.
+func (c ColumnIndex) Subnet(spec *ChainSpec) SubnetID {
+	return SubnetID(specMod(spec, uint64(c), spec.DataColumnSidecarSubnetCount))
+}
+
+// columnsPerGroup returns number of data columns in a custody group.
+func columnsPerGroup(spec *ChainSpec) uint64 {
+	return specDiv(spec, spec.NumberOfColumns, spec.NumberOfCustodyGroups)
+}
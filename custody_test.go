@@ -0,0 +1,88 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func testCustodySpec() *ChainSpec {
+	return &ChainSpec{NumberOfColumns: 128, NumberOfCustodyGroups: 128, DataColumnSidecarSubnetCount: 128}
+}
+
+func TestNodeID_CustodyGroups(t *testing.T) {
+	spec := testCustodySpec()
+	var maxID NodeID
+	for i := range maxID {
+		maxID[i] = 0xff
+	}
+	var abID NodeID
+	for i := range abID {
+		abID[i] = 0xab
+	}
+	tests := []struct {
+		id   NodeID
+		want []CustodyGroup
+	}{
+		{id: NodeID{}, want: []CustodyGroup{1, 17, 87, 102}},
+		// Node id wraps around to zero.
+		{id: maxID, want: []CustodyGroup{1, 47, 87, 102}},
+		{id: abID, want: []CustodyGroup{26, 43, 100, 113}},
+	}
+	for _, tt := range tests {
+		got, err := tt.id.CustodyGroups(4, spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Unexpected custody groups of %v: %v", tt.id, got)
+		}
+	}
+
+	all, err := abID.CustodyGroups(128, spec)
+	if err != nil || len(all) != 128 || all[0] != 0 || all[127] != 127 {
+		t.Errorf("Unexpected custody groups: %v", all)
+	}
+	if _, err := abID.CustodyGroups(129, spec); !errors.Is(err, ErrInvalidCustodyGroupCount) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestNodeID_CustodyColumns(t *testing.T) {
+	spec := &ChainSpec{NumberOfColumns: 128, NumberOfCustodyGroups: 64, DataColumnSidecarSubnetCount: 32}
+	if got := CustodyGroup(3).Columns(spec); !reflect.DeepEqual(got, []ColumnIndex{3, 67}) {
+		t.Errorf("Unexpected group columns: %v", got)
+	}
+	columns, err := NodeID{}.CustodyColumns(4, spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(columns) != 8 {
+		t.Errorf("Unexpected custody columns: %v", columns)
+	}
+	subnets, err := NodeID{}.CustodySubnets(4, spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, s := range subnets {
+		if s >= 32 || (i > 0 && s <= subnets[i-1]) {
+			t.Errorf("Unexpected custody subnets: %v", subnets)
+		}
+	}
+}
+
+func TestNodeID_JSON(t *testing.T) {
+	id := NodeID{0xab, 0x01}
+	data, err := json.Marshal(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `"0xab01000000000000000000000000000000000000000000000000000000000000"` {
+		t.Errorf("Unexpected JSON: %s", data)
+	}
+	var decoded NodeID
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded != id {
+		t.Errorf("Unexpected decoded node id: %v, %v", decoded, err)
+	}
+}
@@ -0,0 +1,35 @@
+package types
+
+import "github.com/farazdagi/prysm-shared-types/mathutil"
+
+// SlotDelta represents signed difference between two slots.
+type SlotDelta int64
+
+// EpochDelta represents signed difference between two epochs.
+type EpochDelta int64
+
+// Apply shifts slot by delta, moving it backwards for negative values.
+func (d SlotDelta) Apply(s Slot) Slot {
+	return Slot(applyDelta(uint64(s), int64(d)))
+}
+
+// Apply shifts epoch by delta, moving it backwards for negative values.
+func (d EpochDelta) Apply(e Epoch) Epoch {
+	return Epoch(applyDelta(uint64(e), int64(d)))
+}
+
+// applyDelta adds signed delta to v, panicking if result doesn't fit into uint64.
+func applyDelta(v uint64, d int64) uint64 {
+	if d >= 0 {
+		return mathutil.Add(v, uint64(d))
+	}
+	return mathutil.Sub(v, absInt64(d))
+}
+
+// absInt64 returns absolute value of x, properly handling math.MinInt64.
+func absInt64(x int64) uint64 {
+	if x >= 0 {
+		return uint64(x)
+	}
+	return uint64(-(x + 1)) + 1
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDelta_Apply(t *testing.T) {
+	tests := []struct {
+		name  string
+		slot  Slot
+		delta SlotDelta
+		want  Slot
+	}{
+		{name: "zero", slot: 10, delta: 0, want: 10},
+		{name: "positive", slot: 10, delta: 5, want: 15},
+		{name: "negative", slot: 10, delta: -5, want: 5},
+		{name: "back to genesis", slot: 10, delta: -10, want: 0},
+		{name: "min int64", slot: math.MaxUint64, delta: math.MinInt64, want: math.MaxUint64 - 1<<63},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.delta.Apply(tt.slot); got != tt.want {
+				t.Errorf("Apply() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := EpochDelta(-2).Apply(5); got != 3 {
+		t.Errorf("Apply() = %v, want %v", got, 3)
+	}
+
+	t.Run("underflow", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected panic")
+			}
+		}()
+		SlotDelta(-11).Apply(10)
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected panic")
+			}
+		}()
+		EpochDelta(1).Apply(math.MaxUint64)
+	})
+}
@@ -0,0 +1,139 @@
+// Package deltacodec compresses sorted lists of uint64-backed values (slots, epochs, validator
+// indices) by storing varint encoded differences between consecutive values, which for densely
+// populated lists take a single byte per entry instead of eight.
+//
+// Encoding starts with the number of values, followed by the deltas (the first one relative to zero).
+// Run-length variant stores (delta, run length) pairs instead, so runs of equally spaced values,
+// e.g. consecutive slots, take just a couple of bytes.
+package deltacodec
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/farazdagi/prysm-shared-types/mathutil"
+)
+
+var (
+	// ErrNotSorted is returned when values to be encoded are not in non-decreasing order.
+	ErrNotSorted = errors.New("values are not sorted")
+	// ErrCorrupt is returned when encoded buffer is malformed.
+	ErrCorrupt = errors.New("corrupt delta encoded buffer")
+)
+
+// Append appends delta-varint encoding of sorted values to dst.
+func Append[T mathutil.Uint64Like](dst []byte, values []T) ([]byte, error) {
+	dst = AppendUvarint(dst, uint64(len(values)))
+	var prev T
+	for _, v := range values {
+		if v < prev {
+			return nil, ErrNotSorted
+		}
+		dst = AppendUvarint(dst, uint64(v-prev))
+		prev = v
+	}
+	return dst, nil
+}
+
+// Decode decodes values encoded by Append.
+func Decode[T mathutil.Uint64Like](buf []byte) ([]T, error) {
+	count, buf, err := ReadUvarint(buf)
+	if err != nil {
+		return nil, err
+	}
+	// Every value takes at least one byte, so count is checked before allocating.
+	if count > uint64(len(buf)) {
+		return nil, fmt.Errorf("%w: %d values declared, %d bytes left", ErrCorrupt, count, len(buf))
+	}
+	values := make([]T, count)
+	var prev T
+	for i := range values {
+		var delta uint64
+		if delta, buf, err = ReadUvarint(buf); err != nil {
+			return nil, err
+		}
+		if prev, err = mathutil.SafeAdd(prev, T(delta)); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrCorrupt, err)
+		}
+		values[i] = prev
+	}
+	if len(buf) != 0 {
+		return nil, fmt.Errorf("%w: %d trailing bytes", ErrCorrupt, len(buf))
+	}
+	return values, nil
+}
+
+// AppendRLE appends run-length delta-varint encoding of sorted values to dst.
+func AppendRLE[T mathutil.Uint64Like](dst []byte, values []T) ([]byte, error) {
+	dst = AppendUvarint(dst, uint64(len(values)))
+	var prev T
+	for i := 0; i < len(values); {
+		if values[i] < prev {
+			return nil, ErrNotSorted
+		}
+		delta := values[i] - prev
+		run := 1
+		for i+run < len(values) && values[i+run] >= values[i+run-1] && values[i+run]-values[i+run-1] == delta {
+			run++
+		}
+		dst = AppendUvarint(dst, uint64(delta))
+		dst = AppendUvarint(dst, uint64(run))
+		prev = values[i+run-1]
+		i += run
+	}
+	return dst, nil
+}
+
+// DecodeRLE decodes values encoded by AppendRLE. Number of values is limited by maxCount, protecting
+// against untrusted input declaring huge lists.
+func DecodeRLE[T mathutil.Uint64Like](buf []byte, maxCount uint64) ([]T, error) {
+	count, buf, err := ReadUvarint(buf)
+	if err != nil {
+		return nil, err
+	}
+	if count > maxCount {
+		return nil, fmt.Errorf("%w: %d values declared, at most %d allowed", ErrCorrupt, count, maxCount)
+	}
+	values := make([]T, 0, count)
+	var prev T
+	for uint64(len(values)) < count {
+		var delta, run uint64
+		if delta, buf, err = ReadUvarint(buf); err != nil {
+			return nil, err
+		}
+		if run, buf, err = ReadUvarint(buf); err != nil {
+			return nil, err
+		}
+		if run == 0 || run > count-uint64(len(values)) {
+			return nil, fmt.Errorf("%w: invalid run length %d", ErrCorrupt, run)
+		}
+		for ; run > 0; run-- {
+			if prev, err = mathutil.SafeAdd(prev, T(delta)); err != nil {
+				return nil, fmt.Errorf("%w: %v", ErrCorrupt, err)
+			}
+			values = append(values, prev)
+		}
+	}
+	if len(buf) != 0 {
+		return nil, fmt.Errorf("%w: %d trailing bytes", ErrCorrupt, len(buf))
+	}
+	return values, nil
+}
+
+// AppendUvarint appends varint encoding of v to dst.
+func AppendUvarint(dst []byte, v uint64) []byte {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], v)
+	return append(dst, buf[:n]...)
+}
+
+// ReadUvarint decodes varint from the start of buf, returning the rest of the buffer. Malformed
+// varints result in ErrCorrupt.
+func ReadUvarint(buf []byte) (uint64, []byte, error) {
+	v, n := binary.Uvarint(buf)
+	if n <= 0 {
+		return 0, nil, fmt.Errorf("%w: invalid varint", ErrCorrupt)
+	}
+	return v, buf[n:], nil
+}
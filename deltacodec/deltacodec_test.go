@@ -0,0 +1,115 @@
+package deltacodec
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+func TestRoundTrip(t *testing.T) {
+	tests := map[string][]types.Slot{
+		"empty":       {},
+		"single":      {42},
+		"consecutive": {100, 101, 102, 103, 104},
+		"sparse":      {0, 0, 7, 1000, 1 << 40, types.FarFutureSlot},
+		"mixed runs":  {1, 2, 3, 10, 20, 30, 31},
+	}
+	for name, slots := range tests {
+		t.Run(name, func(t *testing.T) {
+			enc, err := Append(nil, slots)
+			if err != nil {
+				t.Fatal(err)
+			}
+			decoded, err := Decode[types.Slot](enc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(decoded, slots) {
+				t.Errorf("Unexpected values: %v", decoded)
+			}
+
+			enc, err = AppendRLE(nil, slots)
+			if err != nil {
+				t.Fatal(err)
+			}
+			decoded, err = DecodeRLE[types.Slot](enc, 100)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(decoded, slots) {
+				t.Errorf("Unexpected RLE values: %v", decoded)
+			}
+		})
+	}
+}
+
+func TestCompression(t *testing.T) {
+	epochs := make([]types.Epoch, 10_000)
+	for i := range epochs {
+		epochs[i] = types.Epoch(200_000 + i)
+	}
+	enc, err := Append(nil, epochs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(enc) > len(epochs)+8 {
+		t.Errorf("Unexpected encoding size: %d", len(enc))
+	}
+	if enc, err = AppendRLE(nil, epochs); err != nil || len(enc) > 16 {
+		t.Errorf("Unexpected RLE encoding size: %d (%v)", len(enc), err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	if _, err := Append(nil, []types.Slot{2, 1}); !errors.Is(err, ErrNotSorted) {
+		t.Errorf("Expected ErrNotSorted, got: %v", err)
+	}
+	if _, err := AppendRLE(nil, []types.Slot{1, 2, 1}); !errors.Is(err, ErrNotSorted) {
+		t.Errorf("Expected ErrNotSorted, got: %v", err)
+	}
+
+	enc, _ := Append(nil, []types.Slot{1, 2, 3})
+	for name, buf := range map[string][]byte{
+		"empty":      nil,
+		"truncated":  enc[:len(enc)-1],
+		"trailing":   append(append([]byte(nil), enc...), 0),
+		"huge count": {0xff, 0xff, 0xff, 0xff, 0x0f},
+		"overflow":   {2, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 1},
+	} {
+		if _, err := Decode[types.Slot](buf); !errors.Is(err, ErrCorrupt) {
+			t.Errorf("%s: expected ErrCorrupt, got: %v", name, err)
+		}
+	}
+
+	rle, _ := AppendRLE(nil, []types.Slot{1, 2, 3})
+	for name, buf := range map[string][]byte{
+		"too many":  {0xff, 0xff, 0x03, 1, 1},
+		"zero run":  {1, 1, 0},
+		"long run":  {1, 1, 2},
+		"truncated": rle[:len(rle)-1],
+	} {
+		if _, err := DecodeRLE[types.Slot](buf, 1000); !errors.Is(err, ErrCorrupt) {
+			t.Errorf("%s: expected ErrCorrupt, got: %v", name, err)
+		}
+	}
+}
+
+func TestUvarint(t *testing.T) {
+	var buf []byte
+	for _, v := range []uint64{0, 127, 128, math.MaxUint64} {
+		buf = AppendUvarint(buf, v)
+	}
+	for _, want := range []uint64{0, 127, 128, math.MaxUint64} {
+		var v uint64
+		var err error
+		if v, buf, err = ReadUvarint(buf); err != nil || v != want {
+			t.Fatalf("Unexpected value: %d (%v), want %d", v, err, want)
+		}
+	}
+	if _, _, err := ReadUvarint([]byte{0x80}); !errors.Is(err, ErrCorrupt) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
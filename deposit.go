@@ -0,0 +1,175 @@
+package types
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// DepositMessage is the spec DepositMessage container: the part of deposit data covered by signature.
+type DepositMessage struct {
+	Pubkey                BLSPubkey `json:"pubkey"`
+	WithdrawalCredentials Root      `json:"withdrawal_credentials"`
+	Amount                Gwei      `json:"amount"`
+}
+
+// DepositData is the spec DepositData container.
+type DepositData struct {
+	Pubkey                BLSPubkey    `json:"pubkey"`
+	WithdrawalCredentials Root         `json:"withdrawal_credentials"`
+	Amount                Gwei         `json:"amount"`
+	Signature             BLSSignature `json:"signature"`
+}
+
+// Message returns the signed part of deposit data.
+func (d *DepositData) Message() *DepositMessage {
+	return &DepositMessage{Pubkey: d.Pubkey, WithdrawalCredentials: d.WithdrawalCredentials, Amount: d.Amount}
+}
+
+// SigningRoot returns root signed by the depositor. Deposits are fork-agnostic: domain is computed
+// using the genesis fork version of the chain and zero genesis validators root.
+func (d *DepositData) SigningRoot(genesisForkVersion ForkVersion) (Root, error) {
+	domain, err := ComputeDomain(DomainDeposit, genesisForkVersion, Root{})
+	if err != nil {
+		return Root{}, err
+	}
+	return ComputeSigningRoot(d.Message(), domain)
+}
+
+// HashTreeRoot returns calculated hash root.
+func (m *DepositMessage) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	err := m.HashTreeRootInto(&root)
+	return root, err
+}
+
+// AppendFieldRoots appends hash roots of deposit message fields to dst.
+func (m *DepositMessage) AppendFieldRoots(dst [][32]byte) ([][32]byte, error) {
+	var chunks [3][32]byte
+	if err := m.Pubkey.HashTreeRootInto(&chunks[0]); err != nil {
+		return dst, err
+	}
+	chunks[1] = m.WithdrawalCredentials
+	_ = m.Amount.HashTreeRootInto(&chunks[2])
+	return append(dst, chunks[:]...), nil
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (m *DepositMessage) HashTreeRootInto(dst *[32]byte) error {
+	var buf [3][32]byte
+	chunks, err := m.AppendFieldRoots(buf[:0])
+	if err != nil {
+		return err
+	}
+	return merkleizeInto(dst, chunks, 0)
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the deposit message object.
+func (m *DepositMessage) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), m.SizeSSZ()); err != nil {
+		return err
+	}
+	copy(m.Pubkey[:], buf[:48])
+	copy(m.WithdrawalCredentials[:], buf[48:80])
+	m.Amount = Gwei(binary.LittleEndian.Uint64(buf[80:88]))
+	return nil
+}
+
+// MarshalSSZTo marshals deposit message with the provided byte slice.
+func (m *DepositMessage) MarshalSSZTo(dst []byte) ([]byte, error) {
+	dst = append(dst, m.Pubkey[:]...)
+	dst = append(dst, m.WithdrawalCredentials[:]...)
+	return m.Amount.AppendSSZ(dst), nil
+}
+
+// MarshalSSZ marshals deposit message into a serialized object.
+func (m *DepositMessage) MarshalSSZ() ([]byte, error) {
+	return m.MarshalSSZTo(make([]byte, 0, m.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (m *DepositMessage) SizeSSZ() int {
+	return 88
+}
+
+// WriteTo writes SSZ serialized deposit message to w.
+func (m *DepositMessage) WriteTo(w io.Writer) (int64, error) {
+	return writeSSZTo(w, m)
+}
+
+// ReadFrom reads SSZ serialized deposit message from reader (exactly SizeSSZ bytes are consumed).
+func (m *DepositMessage) ReadFrom(reader io.Reader) (int64, error) {
+	return readSSZFrom(reader, m)
+}
+
+// HashTreeRoot returns calculated hash root.
+func (d *DepositData) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	err := d.HashTreeRootInto(&root)
+	return root, err
+}
+
+// AppendFieldRoots appends hash roots of deposit data fields to dst.
+func (d *DepositData) AppendFieldRoots(dst [][32]byte) ([][32]byte, error) {
+	var chunks [4][32]byte
+	if err := d.Pubkey.HashTreeRootInto(&chunks[0]); err != nil {
+		return dst, err
+	}
+	chunks[1] = d.WithdrawalCredentials
+	_ = d.Amount.HashTreeRootInto(&chunks[2])
+	if err := d.Signature.HashTreeRootInto(&chunks[3]); err != nil {
+		return dst, err
+	}
+	return append(dst, chunks[:]...), nil
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (d *DepositData) HashTreeRootInto(dst *[32]byte) error {
+	var buf [4][32]byte
+	chunks, err := d.AppendFieldRoots(buf[:0])
+	if err != nil {
+		return err
+	}
+	return merkleizeInto(dst, chunks, 0)
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the deposit data object.
+func (d *DepositData) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), d.SizeSSZ()); err != nil {
+		return err
+	}
+	copy(d.Pubkey[:], buf[:48])
+	copy(d.WithdrawalCredentials[:], buf[48:80])
+	d.Amount = Gwei(binary.LittleEndian.Uint64(buf[80:88]))
+	copy(d.Signature[:], buf[88:184])
+	return nil
+}
+
+// MarshalSSZTo marshals deposit data with the provided byte slice.
+func (d *DepositData) MarshalSSZTo(dst []byte) ([]byte, error) {
+	dst = append(dst, d.Pubkey[:]...)
+	dst = append(dst, d.WithdrawalCredentials[:]...)
+	dst = d.Amount.AppendSSZ(dst)
+	return append(dst, d.Signature[:]...), nil
+}
+
+// MarshalSSZ marshals deposit data into a serialized object.
+func (d *DepositData) MarshalSSZ() ([]byte, error) {
+	return d.MarshalSSZTo(make([]byte, 0, d.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (d *DepositData) SizeSSZ() int {
+	return 184
+}
+
+// WriteTo writes SSZ serialized deposit data to w.
+func (d *DepositData) WriteTo(w io.Writer) (int64, error) {
+	return writeSSZTo(w, d)
+}
+
+// ReadFrom reads SSZ serialized deposit data from reader (exactly SizeSSZ bytes are consumed).
+func (d *DepositData) ReadFrom(reader io.Reader) (int64, error) {
+	return readSSZFrom(reader, d)
+}
@@ -0,0 +1,32 @@
+//go:build !nofastssz
+
+package types
+
+import fssz "github.com/ferranbt/fastssz"
+
+var _ fssz.HashRoot = (*DepositMessage)(nil)
+var _ fssz.Marshaler = (*DepositMessage)(nil)
+var _ fssz.Unmarshaler = (*DepositMessage)(nil)
+var _ fssz.HashRoot = (*DepositData)(nil)
+var _ fssz.Marshaler = (*DepositData)(nil)
+var _ fssz.Unmarshaler = (*DepositData)(nil)
+
+// HashTreeRootWith appends deposit message root to the provided hasher.
+func (m *DepositMessage) HashTreeRootWith(hh *fssz.Hasher) error {
+	root, err := m.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	hh.PutBytes(root[:])
+	return nil
+}
+
+// HashTreeRootWith appends deposit data root to the provided hasher.
+func (d *DepositData) HashTreeRootWith(hh *fssz.Hasher) error {
+	root, err := d.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	hh.PutBytes(root[:])
+	return nil
+}
@@ -0,0 +1,154 @@
+package types
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// DepositContractTreeDepth is the depth of the deposit contract merkle tree.
+const DepositContractTreeDepth = 32
+
+// depositSnapshotFixedSize is the size of the fixed part of serialized DepositTreeSnapshot.
+const depositSnapshotFixedSize = 84
+
+// ErrInvalidDepositSnapshot is returned when deposit tree snapshot is inconsistent.
+var ErrInvalidDepositSnapshot = errors.New("invalid deposit tree snapshot")
+
+// DepositTreeSnapshot is the EIP-4881 deposit tree snapshot: roots of the finalized subtrees of the
+// deposit contract tree, together with the execution block deposits are finalized at. It is enough
+// to reconstruct the tree and keep appending deposits after checkpoint sync.
+type DepositTreeSnapshot struct {
+	// Finalized holds roots of the maximal finalized subtrees, largest (leftmost) subtree first.
+	// There is one root per set bit of DepositCount.
+	Finalized            []Root `json:"finalized"`
+	DepositRoot          Root   `json:"deposit_root"`
+	DepositCount         uint64 `json:"deposit_count,string"`
+	ExecutionBlockHash   Root   `json:"execution_block_hash"`
+	ExecutionBlockHeight uint64 `json:"execution_block_height,string"`
+}
+
+// CalculateRoot returns deposit root (with deposit count mixed in) recomputed from finalized subtrees.
+func (s *DepositTreeSnapshot) CalculateRoot() (Root, error) {
+	if len(s.Finalized) != bits.OnesCount64(s.DepositCount) {
+		return Root{}, fmt.Errorf("%w: %d finalized roots for deposit count %d", ErrInvalidDepositSnapshot,
+			len(s.Finalized), s.DepositCount)
+	}
+	if s.DepositCount > 1<<DepositContractTreeDepth {
+		return Root{}, fmt.Errorf("%w: deposit count %d exceeds tree capacity", ErrInvalidDepositSnapshot, s.DepositCount)
+	}
+	var buf [64]byte
+	root := zeroHashes[0]
+	size, index := s.DepositCount, len(s.Finalized)
+	for level := 0; level < DepositContractTreeDepth; level++ {
+		if size&1 == 1 {
+			index--
+			copy(buf[:32], s.Finalized[index][:])
+			copy(buf[32:], root[:])
+		} else {
+			copy(buf[:32], root[:])
+			copy(buf[32:], zeroHashes[level][:])
+		}
+		root = sum256(buf[:])
+		size >>= 1
+	}
+	mixed, err := mixInLength(root, s.DepositCount)
+	return Root(mixed), err
+}
+
+// Validate checks that finalized subtrees match deposit count and hash to the deposit root.
+func (s *DepositTreeSnapshot) Validate() error {
+	root, err := s.CalculateRoot()
+	if err != nil {
+		return err
+	}
+	if root != s.DepositRoot {
+		return fmt.Errorf("%w: calculated root %v does not match deposit root %v", ErrInvalidDepositSnapshot,
+			root, s.DepositRoot)
+	}
+	return nil
+}
+
+// HashTreeRoot returns calculated hash root.
+func (s *DepositTreeSnapshot) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	err := s.HashTreeRootInto(&root)
+	return root, err
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (s *DepositTreeSnapshot) HashTreeRootInto(dst *[32]byte) error {
+	if err := validateListLength(len(s.Finalized), DepositContractTreeDepth); err != nil {
+		return err
+	}
+	var chunks [5][32]byte
+	finalized := make([][32]byte, len(s.Finalized))
+	for i := range s.Finalized {
+		finalized[i] = s.Finalized[i]
+	}
+	root, err := merkleize(finalized, DepositContractTreeDepth)
+	if err != nil {
+		return err
+	}
+	if chunks[0], err = mixInLength(root, uint64(len(s.Finalized))); err != nil {
+		return err
+	}
+	chunks[1] = s.DepositRoot
+	binary.LittleEndian.PutUint64(chunks[2][:8], s.DepositCount)
+	chunks[3] = s.ExecutionBlockHash
+	binary.LittleEndian.PutUint64(chunks[4][:8], s.ExecutionBlockHeight)
+	return merkleizeInto(dst, chunks[:], 0)
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the deposit tree snapshot object.
+func (s *DepositTreeSnapshot) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckMinLength(len(buf), depositSnapshotFixedSize); err != nil {
+		return err
+	}
+	if err := sszutil.CheckOffset(binary.LittleEndian.Uint32(buf[:4]), depositSnapshotFixedSize); err != nil {
+		return err
+	}
+	tail := buf[depositSnapshotFixedSize:]
+	if err := sszutil.CheckList(len(tail), 32, DepositContractTreeDepth); err != nil {
+		return err
+	}
+	finalized := make([]Root, len(tail)/32)
+	for i := range finalized {
+		copy(finalized[i][:], tail[i*32:])
+	}
+	s.Finalized = finalized
+	copy(s.DepositRoot[:], buf[4:36])
+	s.DepositCount = binary.LittleEndian.Uint64(buf[36:44])
+	copy(s.ExecutionBlockHash[:], buf[44:76])
+	s.ExecutionBlockHeight = binary.LittleEndian.Uint64(buf[76:84])
+	return nil
+}
+
+// MarshalSSZTo marshals deposit tree snapshot with the provided byte slice.
+func (s *DepositTreeSnapshot) MarshalSSZTo(dst []byte) ([]byte, error) {
+	if err := validateListLength(len(s.Finalized), DepositContractTreeDepth); err != nil {
+		return dst, err
+	}
+	dst = append(dst, depositSnapshotFixedSize, 0, 0, 0)
+	dst = append(dst, s.DepositRoot[:]...)
+	dst = sszutil.AppendUint64(dst, s.DepositCount)
+	dst = append(dst, s.ExecutionBlockHash[:]...)
+	dst = sszutil.AppendUint64(dst, s.ExecutionBlockHeight)
+	for i := range s.Finalized {
+		dst = append(dst, s.Finalized[i][:]...)
+	}
+	return dst, nil
+}
+
+// MarshalSSZ marshals deposit tree snapshot into a serialized object.
+func (s *DepositTreeSnapshot) MarshalSSZ() ([]byte, error) {
+	return s.MarshalSSZTo(make([]byte, 0, s.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (s *DepositTreeSnapshot) SizeSSZ() int {
+	return depositSnapshotFixedSize + len(s.Finalized)*32
+}
@@ -0,0 +1,19 @@
+//go:build !nofastssz
+
+package types
+
+import fssz "github.com/ferranbt/fastssz"
+
+var _ fssz.HashRoot = (*DepositTreeSnapshot)(nil)
+var _ fssz.Marshaler = (*DepositTreeSnapshot)(nil)
+var _ fssz.Unmarshaler = (*DepositTreeSnapshot)(nil)
+
+// HashTreeRootWith appends deposit tree snapshot root to the provided hasher.
+func (s *DepositTreeSnapshot) HashTreeRootWith(hh *fssz.Hasher) error {
+	root, err := s.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	hh.PutBytes(root[:])
+	return nil
+}
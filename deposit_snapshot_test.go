@@ -0,0 +1,109 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// testDepositSnapshot builds snapshot of deposit tree holding n leaves.
+func testDepositSnapshot(t *testing.T, n int) *DepositTreeSnapshot {
+	leaves := make([][32]byte, n)
+	for i := range leaves {
+		leaves[i] = [32]byte{byte(i + 1)}
+	}
+	s := &DepositTreeSnapshot{
+		DepositCount:         uint64(n),
+		ExecutionBlockHash:   Root{0xee},
+		ExecutionBlockHeight: 1234,
+	}
+	pos := 0
+	for k := 31; k >= 0; k-- {
+		size := 1 << k
+		if n&size == 0 {
+			continue
+		}
+		subtree := append([][32]byte{}, leaves[pos:pos+size]...)
+		root, err := merkleize(subtree, uint64(size))
+		if err != nil {
+			t.Fatal(err)
+		}
+		s.Finalized = append(s.Finalized, root)
+		pos += size
+	}
+	root, err := merkleize(append([][32]byte{}, leaves...), 1<<DepositContractTreeDepth)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.DepositRoot, err = mixInLength(root, uint64(n)); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestDepositTreeSnapshot_Validate(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 3, 7, 8, 13} {
+		if err := testDepositSnapshot(t, n).Validate(); err != nil {
+			t.Errorf("Unexpected error for %d deposits: %v", n, err)
+		}
+	}
+
+	s := testDepositSnapshot(t, 5)
+	s.Finalized[1][0] ^= 1
+	if err := s.Validate(); !errors.Is(err, ErrInvalidDepositSnapshot) {
+		t.Errorf("Expected root mismatch, got %v", err)
+	}
+	s = testDepositSnapshot(t, 5)
+	s.Finalized = s.Finalized[:1]
+	if err := s.Validate(); !errors.Is(err, ErrInvalidDepositSnapshot) {
+		t.Errorf("Expected finalized count mismatch, got %v", err)
+	}
+}
+
+func TestDepositTreeSnapshot_SSZ(t *testing.T) {
+	s := testDepositSnapshot(t, 13)
+	enc, err := s.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(enc) != 84+3*32 {
+		t.Errorf("Unexpected length: %d", len(enc))
+	}
+	decoded := &DepositTreeSnapshot{}
+	if err := decoded.UnmarshalSSZ(enc); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, s) {
+		t.Errorf("Unexpected snapshot: %+v", decoded)
+	}
+	if err := decoded.UnmarshalSSZ(enc[:len(enc)-1]); err == nil {
+		t.Error("Expected error on truncated list")
+	}
+	enc[0] = 80
+	if err := decoded.UnmarshalSSZ(enc); err == nil {
+		t.Error("Expected error on invalid offset")
+	}
+}
+
+func TestDepositTreeSnapshot_JSON(t *testing.T) {
+	s := testDepositSnapshot(t, 1)
+	enc, err := json.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(enc, &fields); err != nil {
+		t.Fatal(err)
+	}
+	if fields["deposit_count"] != "1" || fields["execution_block_height"] != "1234" {
+		t.Errorf("Unexpected JSON: %s", enc)
+	}
+	var decoded DepositTreeSnapshot
+	if err := json.Unmarshal(enc, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&decoded, s) {
+		t.Errorf("Unexpected snapshot: %+v", decoded)
+	}
+}
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestComputeDomain(t *testing.T) {
+	// Mainnet deposit domain.
+	domain, err := ComputeDomain(DomainDeposit, ForkVersion{}, Root{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if domain.String() != "0x03000000f5a5fd42d16a20302798ef6ed309979b43003d2320d9f0e8ea9831a9" {
+		t.Errorf("Unexpected domain: %v", domain)
+	}
+}
+
+func TestDepositData_SSZ(t *testing.T) {
+	d := testDepositData()
+	enc, err := d.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := &DepositData{}
+	if err := decoded.UnmarshalSSZ(enc); err != nil {
+		t.Fatal(err)
+	}
+	if *decoded != *d {
+		t.Errorf("Unexpected deposit data: %+v", decoded)
+	}
+
+	msg := d.Message()
+	enc, err = msg.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decodedMsg := &DepositMessage{}
+	if err := decodedMsg.UnmarshalSSZ(enc); err != nil || *decodedMsg != *msg {
+		t.Errorf("Unexpected deposit message: %+v, %v", decodedMsg, err)
+	}
+}
+
+func TestDepositData_SigningRoot(t *testing.T) {
+	d := testDepositData()
+	domain, err := ComputeDomain(DomainDeposit, ForkVersion{}, Root{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	msgRoot, err := d.Message().HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := merkleize([][32]byte{msgRoot, domain}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := d.SigningRoot(ForkVersion{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("Unexpected signing root: %v", got)
+	}
+	// Signature is not part of the signed message.
+	d.Signature = BLSSignature{}
+	if again, _ := d.SigningRoot(ForkVersion{}); again != got {
+		t.Error("Signing root should not depend on signature")
+	}
+}
+
+func TestDepositData_JSON(t *testing.T) {
+	d := testDepositData()
+	enc, err := json.Marshal(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded DepositData
+	if err := json.Unmarshal(enc, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded != *d {
+		t.Errorf("Unexpected deposit data: %+v", decoded)
+	}
+}
@@ -1,2 +1,10 @@
 // Package types contains types shared between various parts of the system (beacon chain, validator, slasher).
+//
+// Types implement fastssz interfaces by default. Building with `nofastssz` tag drops the fastssz
+// dependency (and HashTreeRootWith methods relying on it), leaving only the internal SSZ encoding
+// and hashing, which is useful for lightweight (e.g. TinyGo/WASM) consumers.
+//
+// JSON Schema methods (see JSONSchemaDefinitions) depend on github.com/invopop/jsonschema and are
+// opt-in: they are only built with `jsonschema` tag, so that other consumers don't carry the
+// dependency.
 package types
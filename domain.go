@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DomainType represents a 4 byte signing domain type.
+type DomainType Bytes4
+
+// Domain types, as defined by the spec.
+var (
+	DomainBeaconProposer              = DomainType{0x00, 0x00, 0x00, 0x00}
+	DomainBeaconAttester              = DomainType{0x01, 0x00, 0x00, 0x00}
+	DomainRandao                      = DomainType{0x02, 0x00, 0x00, 0x00}
+	DomainDeposit                     = DomainType{0x03, 0x00, 0x00, 0x00}
+	DomainVoluntaryExit               = DomainType{0x04, 0x00, 0x00, 0x00}
+	DomainSelectionProof              = DomainType{0x05, 0x00, 0x00, 0x00}
+	DomainAggregateAndProof           = DomainType{0x06, 0x00, 0x00, 0x00}
+	DomainSyncCommittee               = DomainType{0x07, 0x00, 0x00, 0x00}
+	DomainSyncCommitteeSelectionProof = DomainType{0x08, 0x00, 0x00, 0x00}
+	DomainContributionAndProof        = DomainType{0x09, 0x00, 0x00, 0x00}
+	DomainBLSToExecutionChange        = DomainType{0x0a, 0x00, 0x00, 0x00}
+	DomainApplicationMask             = DomainType{0x00, 0x00, 0x00, 0x01}
+	DomainApplicationBuilder          = DomainType{0x00, 0x00, 0x00, 0x01}
+)
+
+// domainTypeNames maps known domain types to their spec names.
+// DomainApplicationMask shares its value with DomainApplicationBuilder, so only the latter is named.
+var domainTypeNames = map[DomainType]string{
+	DomainBeaconProposer:              "DOMAIN_BEACON_PROPOSER",
+	DomainBeaconAttester:              "DOMAIN_BEACON_ATTESTER",
+	DomainRandao:                      "DOMAIN_RANDAO",
+	DomainDeposit:                     "DOMAIN_DEPOSIT",
+	DomainVoluntaryExit:               "DOMAIN_VOLUNTARY_EXIT",
+	DomainSelectionProof:              "DOMAIN_SELECTION_PROOF",
+	DomainAggregateAndProof:           "DOMAIN_AGGREGATE_AND_PROOF",
+	DomainSyncCommittee:               "DOMAIN_SYNC_COMMITTEE",
+	DomainSyncCommitteeSelectionProof: "DOMAIN_SYNC_COMMITTEE_SELECTION_PROOF",
+	DomainContributionAndProof:        "DOMAIN_CONTRIBUTION_AND_PROOF",
+	DomainBLSToExecutionChange:        "DOMAIN_BLS_TO_EXECUTION_CHANGE",
+	DomainApplicationBuilder:          "DOMAIN_APPLICATION_BUILDER",
+}
+
+// ParseDomainType parses domain type given either by its spec name (e.g. DOMAIN_RANDAO, case-insensitive)
+// or as 0x-prefixed hex string.
+func ParseDomainType(s string) (DomainType, error) {
+	for d, name := range domainTypeNames {
+		if strings.EqualFold(s, name) {
+			return d, nil
+		}
+	}
+	var d DomainType
+	if err := d.UnmarshalText([]byte(s)); err != nil {
+		return DomainType{}, fmt.Errorf("invalid domain type %q", s)
+	}
+	return d, nil
+}
+
+// Name returns spec name of the domain type (e.g. DOMAIN_RANDAO), or its hex representation if unknown.
+func (d DomainType) Name() string {
+	if name, ok := domainTypeNames[d]; ok {
+		return name
+	}
+	return d.String()
+}
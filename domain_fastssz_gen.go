@@ -0,0 +1,17 @@
+// Code generated by typegen. DO NOT EDIT.
+
+//go:build !nofastssz
+
+package types
+
+import fssz "github.com/ferranbt/fastssz"
+
+var _ fssz.HashRoot = (Domain{})
+var _ fssz.Marshaler = (*Domain)(nil)
+var _ fssz.Unmarshaler = (*Domain)(nil)
+
+// HashTreeRootWith appends domain to the provided hasher.
+func (d Domain) HashTreeRootWith(hh *fssz.Hasher) error {
+	hh.PutBytes(d[:])
+	return nil
+}
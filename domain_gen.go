@@ -0,0 +1,79 @@
+// Code generated by typegen. DO NOT EDIT.
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// NewDomain copies b into Domain, returns an error if b is not exactly 32 bytes long.
+func NewDomain(b []byte) (Domain, error) {
+	var v Domain
+	if len(b) != len(v) {
+		return v, fmt.Errorf("%w: expected 32 bytes, got %d", ErrInvalidBytesLength, len(b))
+	}
+	copy(v[:], b)
+	return v, nil
+}
+
+// Equal returns true if both values hold the same bytes.
+func (d Domain) Equal(x Domain) bool {
+	return d == x
+}
+
+// IsZero returns true if all bytes of the domain are zero.
+func (d Domain) IsZero() bool {
+	return d == Domain{}
+}
+
+// String returns 0x-prefixed hex representation of the domain.
+func (d Domain) String() string {
+	return string(appendHex(nil, d[:]))
+}
+
+// MarshalText encodes domain as 0x-prefixed hex string.
+func (d Domain) MarshalText() ([]byte, error) {
+	return appendHex(make([]byte, 0, 66), d[:]), nil
+}
+
+// UnmarshalText decodes domain from 0x-prefixed hex string.
+func (d *Domain) UnmarshalText(text []byte) error {
+	return decodeHexInto(d[:], text)
+}
+
+// HashTreeRoot returns calculated hash root (which is the domain itself).
+func (d Domain) HashTreeRoot() ([32]byte, error) {
+	return d, nil
+}
+
+// HashTreeRootInto writes hash root (the domain itself) into dst.
+func (d Domain) HashTreeRootInto(dst *[32]byte) error {
+	*dst = d
+	return nil
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the domain object.
+func (d *Domain) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), d.SizeSSZ()); err != nil {
+		return err
+	}
+	copy(d[:], buf)
+	return nil
+}
+
+// MarshalSSZTo marshals domain with the provided byte slice.
+func (d *Domain) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return append(dst, d[:]...), nil
+}
+
+// MarshalSSZ marshals domain into a serialized object.
+func (d *Domain) MarshalSSZ() ([]byte, error) {
+	return append(make([]byte, 0, 32), d[:]...), nil
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (d *Domain) SizeSSZ() int {
+	return 32
+}
@@ -0,0 +1,44 @@
+package types
+
+import "testing"
+
+func TestDomainType_Name(t *testing.T) {
+	if name := DomainRandao.Name(); name != "DOMAIN_RANDAO" {
+		t.Errorf("Unexpected name: %s", name)
+	}
+	if name := DomainApplicationBuilder.Name(); name != "DOMAIN_APPLICATION_BUILDER" {
+		t.Errorf("Unexpected name: %s", name)
+	}
+	if name := (DomainType{0xff}).Name(); name != "0xff000000" {
+		t.Errorf("Unexpected name: %s", name)
+	}
+
+	var decoded DomainType
+	if err := decoded.UnmarshalText([]byte("0x0a000000")); err != nil {
+		t.Fatal(err)
+	}
+	if decoded != DomainBLSToExecutionChange {
+		t.Errorf("Unexpected domain type: %v", decoded)
+	}
+}
+
+func TestParseDomainType(t *testing.T) {
+	tests := []struct {
+		input string
+		want  DomainType
+	}{
+		{"DOMAIN_RANDAO", DomainRandao},
+		{"domain_application_builder", DomainApplicationBuilder},
+		{"0x0a000000", DomainBLSToExecutionChange},
+	}
+	for _, tt := range tests {
+		if got, err := ParseDomainType(tt.input); err != nil || got != tt.want {
+			t.Errorf("Unexpected domain type parsed from %q: %v, %v", tt.input, got, err)
+		}
+	}
+	for _, input := range []string{"", "DOMAIN_UNKNOWN", "0x0a"} {
+		if _, err := ParseDomainType(input); err == nil {
+			t.Errorf("Expected error for %q", input)
+		}
+	}
+}
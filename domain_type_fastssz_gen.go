@@ -0,0 +1,17 @@
+// Code generated by typegen. DO NOT EDIT.
+
+//go:build !nofastssz
+
+package types
+
+import fssz "github.com/ferranbt/fastssz"
+
+var _ fssz.HashRoot = (DomainType{})
+var _ fssz.Marshaler = (*DomainType)(nil)
+var _ fssz.Unmarshaler = (*DomainType)(nil)
+
+// HashTreeRootWith appends domain type to the provided hasher.
+func (d DomainType) HashTreeRootWith(hh *fssz.Hasher) error {
+	hh.PutBytes(d[:])
+	return nil
+}
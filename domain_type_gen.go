@@ -0,0 +1,82 @@
+// Code generated by typegen. DO NOT EDIT.
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// NewDomainType copies b into DomainType, returns an error if b is not exactly 4 bytes long.
+func NewDomainType(b []byte) (DomainType, error) {
+	var v DomainType
+	if len(b) != len(v) {
+		return v, fmt.Errorf("%w: expected 4 bytes, got %d", ErrInvalidBytesLength, len(b))
+	}
+	copy(v[:], b)
+	return v, nil
+}
+
+// Equal returns true if both values hold the same bytes.
+func (d DomainType) Equal(x DomainType) bool {
+	return d == x
+}
+
+// IsZero returns true if all bytes of the domain type are zero.
+func (d DomainType) IsZero() bool {
+	return d == DomainType{}
+}
+
+// String returns 0x-prefixed hex representation of the domain type.
+func (d DomainType) String() string {
+	return string(appendHex(nil, d[:]))
+}
+
+// MarshalText encodes domain type as 0x-prefixed hex string.
+func (d DomainType) MarshalText() ([]byte, error) {
+	return appendHex(make([]byte, 0, 10), d[:]), nil
+}
+
+// UnmarshalText decodes domain type from 0x-prefixed hex string.
+func (d *DomainType) UnmarshalText(text []byte) error {
+	return decodeHexInto(d[:], text)
+}
+
+// HashTreeRoot returns calculated hash root (domain type right-padded to 32 bytes).
+func (d DomainType) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	copy(root[:], d[:])
+	return root, nil
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (d DomainType) HashTreeRootInto(dst *[32]byte) error {
+	*dst = [32]byte{}
+	copy(dst[:], d[:])
+	return nil
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the domain type object.
+func (d *DomainType) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), d.SizeSSZ()); err != nil {
+		return err
+	}
+	copy(d[:], buf)
+	return nil
+}
+
+// MarshalSSZTo marshals domain type with the provided byte slice.
+func (d *DomainType) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return append(dst, d[:]...), nil
+}
+
+// MarshalSSZ marshals domain type into a serialized object.
+func (d *DomainType) MarshalSSZ() ([]byte, error) {
+	return append(make([]byte, 0, 4), d[:]...), nil
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (d *DomainType) SizeSSZ() int {
+	return 4
+}
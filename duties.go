@@ -0,0 +1,72 @@
+package types
+
+// ProposerDuty is a block proposal duty, as returned by the Beacon API proposer duties endpoint.
+type ProposerDuty struct {
+	Pubkey         BLSPubkey      `json:"pubkey"`
+	ValidatorIndex ValidatorIndex `json:"validator_index"`
+	Slot           Slot           `json:"slot"`
+}
+
+// AttesterDuty is an attestation duty, as returned by the Beacon API attester duties endpoint.
+type AttesterDuty struct {
+	Pubkey                  BLSPubkey      `json:"pubkey"`
+	ValidatorIndex          ValidatorIndex `json:"validator_index"`
+	CommitteeIndex          CommitteeIndex `json:"committee_index"`
+	CommitteeLength         uint64         `json:"committee_length,string"`
+	CommitteesAtSlot        uint64         `json:"committees_at_slot,string"`
+	ValidatorCommitteeIndex uint64         `json:"validator_committee_index,string"`
+	Slot                    Slot           `json:"slot"`
+}
+
+// SyncCommitteeDuty is a sync committee duty, as returned by the Beacon API sync committee duties endpoint.
+type SyncCommitteeDuty struct {
+	Pubkey                        BLSPubkey            `json:"pubkey"`
+	ValidatorIndex                ValidatorIndex       `json:"validator_index"`
+	ValidatorSyncCommitteeIndices []SyncCommitteeIndex `json:"validator_sync_committee_indices"`
+}
+
+// CommitteeAssignment is an attestation committee assignment of a validator (see spec's
+// get_committee_assignment), computed by the beacon node and consumed by the validator client.
+type CommitteeAssignment struct {
+	Slot           Slot             `json:"slot"`
+	CommitteeIndex CommitteeIndex   `json:"committee_index"`
+	Committee      []ValidatorIndex `json:"committee"`
+	// Position is the position of the assigned validator within the committee.
+	Position uint64 `json:"validator_committee_index,string"`
+}
+
+// NewCommitteeAssignment returns assignment of the validator to the committee, false if validator
+// is not a member of the committee.
+func NewCommitteeAssignment(slot Slot, index CommitteeIndex, committee []ValidatorIndex, validator ValidatorIndex) (CommitteeAssignment, bool) {
+	for i, member := range committee {
+		if member == validator {
+			return CommitteeAssignment{Slot: slot, CommitteeIndex: index, Committee: committee, Position: uint64(i)}, true
+		}
+	}
+	return CommitteeAssignment{}, false
+}
+
+// ValidatorIndex returns index of the assigned validator, false if position is out of committee bounds.
+func (a *CommitteeAssignment) ValidatorIndex() (ValidatorIndex, bool) {
+	if a.Position >= uint64(len(a.Committee)) {
+		return 0, false
+	}
+	return a.Committee[a.Position], true
+}
+
+// AttesterDuty returns the Beacon API attester duty of the assigned validator.
+func (a *CommitteeAssignment) AttesterDuty(pubkey BLSPubkey, committeesAtSlot uint64) (AttesterDuty, bool) {
+	validator, ok := a.ValidatorIndex()
+	if !ok {
+		return AttesterDuty{}, false
+	}
+	return AttesterDuty{
+		Pubkey:                  pubkey,
+		ValidatorIndex:          validator,
+		CommitteeIndex:          a.CommitteeIndex,
+		CommitteeLength:         uint64(len(a.Committee)),
+		CommitteesAtSlot:        committeesAtSlot,
+		ValidatorCommitteeIndex: a.Position,
+		Slot:                    a.Slot,
+	}, true
+}
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestDuties_JSON(t *testing.T) {
+	pubkey := `"0x` + strings.Repeat("93", 48) + `"`
+	tests := []struct {
+		name  string
+		input string
+		duty  interface{}
+	}{
+		{
+			name:  "proposer",
+			input: `{"pubkey":` + pubkey + `,"validator_index":"1","slot":"100"}`,
+			duty:  &ProposerDuty{},
+		},
+		{
+			name: "attester",
+			input: `{"pubkey":` + pubkey + `,"validator_index":"1","committee_index":"2","committee_length":"128",` +
+				`"committees_at_slot":"64","validator_committee_index":"17","slot":"100"}`,
+			duty: &AttesterDuty{},
+		},
+		{
+			name:  "sync committee",
+			input: `{"pubkey":` + pubkey + `,"validator_index":"1","validator_sync_committee_indices":["0","511"]}`,
+			duty:  &SyncCommitteeDuty{},
+		},
+		{
+			name:  "committee assignment",
+			input: `{"slot":"100","committee_index":"2","committee":["7","3","11"],"validator_committee_index":"1"}`,
+			duty:  &CommitteeAssignment{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := json.Unmarshal([]byte(tt.input), tt.duty); err != nil {
+				t.Fatal(err)
+			}
+			enc, err := json.Marshal(tt.duty)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(enc) != tt.input {
+				t.Errorf("Unexpected JSON: %s", enc)
+			}
+		})
+	}
+}
+
+func TestCommitteeAssignment(t *testing.T) {
+	committee := []ValidatorIndex{7, 3, 11}
+	if _, ok := NewCommitteeAssignment(100, 2, committee, 5); ok {
+		t.Error("Validator is not a member of the committee")
+	}
+	a, ok := NewCommitteeAssignment(100, 2, committee, 11)
+	if !ok || a.Position != 2 {
+		t.Fatalf("Unexpected assignment: %+v", a)
+	}
+	if v, ok := a.ValidatorIndex(); !ok || v != 11 {
+		t.Errorf("Unexpected validator index: %v", v)
+	}
+	duty, ok := a.AttesterDuty(BLSPubkey{0x93}, 64)
+	want := AttesterDuty{
+		Pubkey:                  BLSPubkey{0x93},
+		ValidatorIndex:          11,
+		CommitteeIndex:          2,
+		CommitteeLength:         3,
+		CommitteesAtSlot:        64,
+		ValidatorCommitteeIndex: 2,
+		Slot:                    100,
+	}
+	if !ok || duty != want {
+		t.Errorf("Unexpected duty: %+v", duty)
+	}
+
+	a.Position = 3
+	if _, ok := a.AttesterDuty(BLSPubkey{}, 64); ok {
+		t.Error("Position out of committee bounds must be rejected")
+	}
+}
@@ -0,0 +1,38 @@
+package types
+
+import "math"
+
+// RoundToIncrement rounds balance down to the multiple of spec.EffectiveBalanceIncrement.
+func (g Gwei) RoundToIncrement(spec *ChainSpec) Gwei {
+	return g - specMod(spec, g, spec.EffectiveBalanceIncrement)
+}
+
+// EffectiveBalance returns effective balance corresponding to the balance: balance rounded down to
+// the increment, capped at spec.MaxEffectiveBalance.
+func EffectiveBalance(balance Gwei, spec *ChainSpec) Gwei {
+	return Min(balance.RoundToIncrement(spec), spec.MaxEffectiveBalance)
+}
+
+// HysteresisThresholds returns bounds within which balance may move without effective balance
+// being updated, see process_effective_balance_updates. Bounds saturate at zero and math.MaxUint64.
+func HysteresisThresholds(effective Gwei, spec *ChainSpec) (lower, upper Gwei) {
+	step := specDiv(spec, spec.EffectiveBalanceIncrement, Gwei(spec.HysteresisQuotient))
+	if down := specMul(spec, step, Gwei(spec.HysteresisDownwardMultiplier)); effective > down {
+		lower = effective - down
+	}
+	upper, err := effective.SafeAdd(uint64(specMul(spec, step, Gwei(spec.HysteresisUpwardMultiplier))))
+	if err != nil {
+		upper = math.MaxUint64
+	}
+	return lower, upper
+}
+
+// UpdateEffectiveBalance returns new effective balance given the current one and the actual
+// balance, applying hysteresis as in process_effective_balance_updates.
+func UpdateEffectiveBalance(effective, balance Gwei, spec *ChainSpec) Gwei {
+	lower, upper := HysteresisThresholds(effective, spec)
+	if balance < lower || balance > upper {
+		return EffectiveBalance(balance, spec)
+	}
+	return effective
+}
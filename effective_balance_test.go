@@ -0,0 +1,53 @@
+package types
+
+import "testing"
+
+func testBalanceSpec() *ChainSpec {
+	return &ChainSpec{
+		SlotsPerEpoch:                32,
+		EffectiveBalanceIncrement:    GweiPerEth,
+		MaxEffectiveBalance:          32 * GweiPerEth,
+		HysteresisQuotient:           4,
+		HysteresisDownwardMultiplier: 1,
+		HysteresisUpwardMultiplier:   5,
+	}
+}
+
+func TestEffectiveBalance(t *testing.T) {
+	spec := testBalanceSpec()
+	tests := map[Gwei]Gwei{
+		0:                           0,
+		GweiPerEth - 1:              0,
+		31*GweiPerEth + 999_999_999: 31 * GweiPerEth,
+		33 * GweiPerEth:             32 * GweiPerEth,
+	}
+	for balance, want := range tests {
+		if got := EffectiveBalance(balance, spec); got != want {
+			t.Errorf("EffectiveBalance(%d) = %d, want %d", uint64(balance), uint64(got), uint64(want))
+		}
+	}
+}
+
+func TestUpdateEffectiveBalance(t *testing.T) {
+	spec := testBalanceSpec()
+	lower, upper := HysteresisThresholds(32*GweiPerEth, spec)
+	if lower != 31_750_000_000 || upper != 33_250_000_000 {
+		t.Errorf("Unexpected thresholds: %d, %d", uint64(lower), uint64(upper))
+	}
+
+	tests := []struct {
+		effective, balance, want Gwei
+	}{
+		{effective: 32 * GweiPerEth, balance: 31_750_000_000, want: 32 * GweiPerEth},
+		{effective: 32 * GweiPerEth, balance: 31_749_999_999, want: 31 * GweiPerEth},
+		{effective: 31 * GweiPerEth, balance: 32_250_000_000, want: 31 * GweiPerEth},
+		{effective: 31 * GweiPerEth, balance: 32_250_000_001, want: 32 * GweiPerEth},
+		{effective: 0, balance: 0, want: 0},
+	}
+	for _, tt := range tests {
+		if got := UpdateEffectiveBalance(tt.effective, tt.balance, spec); got != tt.want {
+			t.Errorf("UpdateEffectiveBalance(%d, %d) = %d, want %d",
+				uint64(tt.effective), uint64(tt.balance), uint64(got), uint64(tt.want))
+		}
+	}
+}
@@ -1,100 +1,65 @@
 package types
 
-import (
-	"fmt"
-
-	fssz "github.com/ferranbt/fastssz"
-)
-
-var _ fssz.HashRoot = (Epoch)(0)
-var _ fssz.Marshaler = (*Epoch)(nil)
-var _ fssz.Unmarshaler = (*Epoch)(nil)
+// GenesisEpoch is the very first epoch of the chain.
+const GenesisEpoch = Epoch(0)
 
 // Epoch represents a single epoch.
+// Common methods (arithmetic, encoding) are generated, see epoch_gen.go.
 type Epoch uint64
 
-// Mul multiplies epoch by x.
-func (e Epoch) Mul(x uint64) Epoch {
-	return Epoch(uint64(e) * x)
-}
-
-// Div divides epoch by x.
-func (e Epoch) Div(x uint64) Epoch {
-	if x == 0 {
-		panic("divbyzero")
-	}
-	return Epoch(uint64(e) / x)
-}
-
-// Add increases epoch by x.
-func (e Epoch) Add(x uint64) Epoch {
-	return Epoch(uint64(e) + x)
+// IsGenesis returns true if epoch is equal to GenesisEpoch.
+func (e Epoch) IsGenesis() bool {
+	return e == GenesisEpoch
 }
 
 // AddSlot increases epoch using slot value.
 func (e Epoch) AddSlot(x Slot) Epoch {
-	return e + Epoch(x)
+	return e.AddEpoch(Epoch(x))
 }
 
-// AddEpoch increases epoch using another epoch value.
-func (e Epoch) AddEpoch(x Epoch) Epoch {
-	return Epoch(uint64(e) + uint64(x))
+// ModSlot returns result of `epoch % slot`.
+func (e Epoch) ModSlot(x Slot) Epoch {
+	return e.ModEpoch(Epoch(x))
 }
 
-// Sub subtracts x from the epoch.
-func (e Epoch) Sub(x uint64) Epoch {
-	if uint64(e) < x {
-		panic("underflow")
+// Prev returns the previous epoch, saturating at genesis (see spec's get_previous_epoch).
+func (e Epoch) Prev() Epoch {
+	if e.IsGenesis() {
+		return e
 	}
-	return Epoch(uint64(e) - x)
+	return e - 1
 }
 
-// Mod returns result of `epoch % x`.
-func (e Epoch) Mod(x uint64) Epoch {
-	return Epoch(uint64(e) % x)
-}
-
-// Mod returns result of `epoch % slot`.
-func (e Epoch) ModSlot(x Slot) Epoch {
-	return Epoch(uint64(e) % uint64(x))
+// Next returns the next epoch, saturating at FarFutureEpoch.
+func (e Epoch) Next() Epoch {
+	if e.IsFarFuture() {
+		return e
+	}
+	return e + 1
 }
 
-// HashTreeRoot returns calculated hash root.
-func (e Epoch) HashTreeRoot() ([32]byte, error) {
-	return fssz.HashWithDefaultHasher(e)
+// StartSlot returns the first slot of the epoch, panics on overflow (unless the spec has an error
+// hook, see Spec.WithErrorHook).
+func (e Epoch) StartSlot(spec *ChainSpec) Slot {
+	return specMul(spec, spec.SlotsPerEpoch, Slot(e))
 }
 
-// HashWithDefaultHasher hashes a HashRoot object with a Hasher from the default HasherPool.
-func (e Epoch) HashTreeRootWith(hh *fssz.Hasher) error {
-	hh.PutUint64(uint64(e))
-	return nil
+// ActivationExitEpoch returns the epoch at which activations and exits initiated in the epoch take
+// effect, see compute_activation_exit_epoch. Saturates at FarFutureEpoch.
+func (e Epoch) ActivationExitEpoch(spec *ChainSpec) Epoch {
+	return saturatingAddEpoch(e, 1+spec.MaxSeedLookahead)
 }
 
-// UnmarshalSSZ deserializes the provided bytes buffer into the epoch object.
-func (e *Epoch) UnmarshalSSZ(buf []byte) error {
-	if len(buf) != e.SizeSSZ() {
-		return fmt.Errorf("expected buffer of length %d received %d", e.SizeSSZ(), len(buf))
-	}
-	*e = Epoch(fssz.UnmarshallUint64(buf))
-	return nil
+// MaxLookaheadEpoch returns the furthest epoch whose validator set changes are already determined
+// at the epoch (e + MAX_SEED_LOOKAHEAD). Saturates at FarFutureEpoch.
+func (e Epoch) MaxLookaheadEpoch(spec *ChainSpec) Epoch {
+	return saturatingAddEpoch(e, spec.MaxSeedLookahead)
 }
 
-// MarshalSSZTo marshals epoch with the provided byte slice.
-func (e *Epoch) MarshalSSZTo(dst []byte) ([]byte, error) {
-	marshalled, err := e.MarshalSSZ()
-	if err != nil {
-		return nil, err
+// saturatingAddEpoch returns e + x, capped at FarFutureEpoch.
+func saturatingAddEpoch(e, x Epoch) Epoch {
+	if sum, err := e.SafeAdd(uint64(x)); err == nil {
+		return sum
 	}
-	return append(dst, marshalled...), nil
-}
-
-// MarshalSSZ marshals epoch into a serialized object.
-func (e *Epoch) MarshalSSZ() ([]byte, error) {
-	marshalled := fssz.MarshalUint64([]byte{}, uint64(*e))
-	return marshalled, nil
-}
-
-// SizeSSZ returns the size of the serialized object.
-func (e *Epoch) SizeSSZ() int {
-	return 8
+	return FarFutureEpoch
 }
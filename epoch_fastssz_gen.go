@@ -0,0 +1,17 @@
+// Code generated by typegen. DO NOT EDIT.
+
+//go:build !nofastssz
+
+package types
+
+import fssz "github.com/ferranbt/fastssz"
+
+var _ fssz.HashRoot = (Epoch)(0)
+var _ fssz.Marshaler = (*Epoch)(nil)
+var _ fssz.Unmarshaler = (*Epoch)(nil)
+
+// HashTreeRootWith appends epoch to the provided hasher.
+func (e Epoch) HashTreeRootWith(hh *fssz.Hasher) error {
+	hh.PutUint64(uint64(e))
+	return nil
+}
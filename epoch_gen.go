@@ -0,0 +1,268 @@
+// Code generated by typegen. DO NOT EDIT.
+
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/farazdagi/prysm-shared-types/mathutil"
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// FarFutureEpoch is a epoch which is never reached.
+// Increasing it using Add* and Mul* methods leaves it unchanged.
+const FarFutureEpoch = Epoch(1<<64 - 1)
+
+// IsFarFuture returns true if epoch is equal to FarFutureEpoch.
+func (e Epoch) IsFarFuture() bool {
+	return e == FarFutureEpoch
+}
+
+// IsZero returns true if epoch has zero value.
+func (e Epoch) IsZero() bool {
+	return e == 0
+}
+
+// Add increases epoch by x, panics on overflow.
+func (e Epoch) Add(x uint64) Epoch {
+	return e.AddEpoch(Epoch(x))
+}
+
+// AddEpoch increases epoch by another epoch, panics on overflow.
+func (e Epoch) AddEpoch(x Epoch) Epoch {
+	if e.IsFarFuture() {
+		return e
+	}
+	return mathutil.Add(e, x)
+}
+
+// SafeAdd increases epoch by x, returns an error on overflow.
+func (e Epoch) SafeAdd(x uint64) (Epoch, error) {
+	if e.IsFarFuture() {
+		return e, nil
+	}
+	return mathutil.SafeAdd(e, Epoch(x))
+}
+
+// Sub subtracts x from the epoch, panics on underflow.
+func (e Epoch) Sub(x uint64) Epoch {
+	return e.SubEpoch(Epoch(x))
+}
+
+// SubEpoch finds difference between two epoch values, panics on underflow.
+func (e Epoch) SubEpoch(x Epoch) Epoch {
+	return mathutil.Sub(e, x)
+}
+
+// SafeSub subtracts x from the epoch, returns an error on underflow.
+func (e Epoch) SafeSub(x uint64) (Epoch, error) {
+	return mathutil.SafeSub(e, Epoch(x))
+}
+
+// AddInt shifts epoch by signed offset x, returns an error if result overflows or goes below zero.
+func (e Epoch) AddInt(x int64) (Epoch, error) {
+	if x >= 0 && e.IsFarFuture() {
+		return e, nil
+	}
+	return mathutil.SafeAddInt(e, x)
+}
+
+// Mul multiplies epoch by x, panics on overflow.
+func (e Epoch) Mul(x uint64) Epoch {
+	return e.MulEpoch(Epoch(x))
+}
+
+// MulEpoch multiplies epoch by another epoch, panics on overflow.
+func (e Epoch) MulEpoch(x Epoch) Epoch {
+	if e.IsFarFuture() {
+		return e
+	}
+	return mathutil.Mul(e, x)
+}
+
+// SafeMul multiplies epoch by x, returns an error on overflow.
+func (e Epoch) SafeMul(x uint64) (Epoch, error) {
+	if e.IsFarFuture() {
+		return e, nil
+	}
+	return mathutil.SafeMul(e, Epoch(x))
+}
+
+// Div divides epoch by x, panics if x is zero.
+func (e Epoch) Div(x uint64) Epoch {
+	return e.DivEpoch(Epoch(x))
+}
+
+// DivEpoch divides epoch by another epoch, panics if x is zero.
+func (e Epoch) DivEpoch(x Epoch) Epoch {
+	return mathutil.Div(e, x)
+}
+
+// SafeDiv divides epoch by x, returns an error if x is zero.
+func (e Epoch) SafeDiv(x uint64) (Epoch, error) {
+	return mathutil.SafeDiv(e, Epoch(x))
+}
+
+// Mod returns result of `epoch % x`, panics if x is zero.
+func (e Epoch) Mod(x uint64) Epoch {
+	return e.ModEpoch(Epoch(x))
+}
+
+// ModEpoch returns result of `epoch % epoch`, panics if x is zero.
+func (e Epoch) ModEpoch(x Epoch) Epoch {
+	return mathutil.Mod(e, x)
+}
+
+// SafeMod returns result of `epoch % x`, returns an error if x is zero.
+func (e Epoch) SafeMod(x uint64) (Epoch, error) {
+	return mathutil.SafeMod(e, Epoch(x))
+}
+
+// Compare returns an integer comparing two epoch values (-1, 0 or +1).
+func (e Epoch) Compare(x Epoch) int {
+	switch {
+	case e < x:
+		return -1
+	case e > x:
+		return 1
+	}
+	return 0
+}
+
+// IsAfter returns true if epoch is strictly greater than x.
+func (e Epoch) IsAfter(x Epoch) bool {
+	return e > x
+}
+
+// IsBefore returns true if epoch is strictly less than x.
+func (e Epoch) IsBefore(x Epoch) bool {
+	return e < x
+}
+
+// WithinN returns true if epoch is at most n away from x (in either direction).
+func (e Epoch) WithinN(x Epoch, n uint64) bool {
+	if e > x {
+		return uint64(e-x) <= n
+	}
+	return uint64(x-e) <= n
+}
+
+// String returns decimal representation of the epoch.
+func (e Epoch) String() string {
+	return strconv.FormatUint(uint64(e), 10)
+}
+
+// PaddedString returns decimal representation of the epoch, left-padded with zeros to width digits,
+// so that values sort lexicographically (e.g. in file names and object keys). Wider values are not truncated.
+func (e Epoch) PaddedString(width int) string {
+	return string(e.AppendPadded(nil, width))
+}
+
+// AppendPadded appends zero-padded decimal representation of the epoch to dst, see PaddedString.
+func (e Epoch) AppendPadded(dst []byte, width int) []byte {
+	var digits [20]byte
+	b := strconv.AppendUint(digits[:0], uint64(e), 10)
+	for i := len(b); i < width; i++ {
+		dst = append(dst, '0')
+	}
+	return append(dst, b...)
+}
+
+// MarshalText encodes epoch as a decimal string.
+func (e Epoch) MarshalText() ([]byte, error) {
+	return strconv.AppendUint(nil, uint64(e), 10), nil
+}
+
+// UnmarshalText decodes epoch from a decimal string.
+func (e *Epoch) UnmarshalText(data []byte) error {
+	parsed, err := strconv.ParseUint(string(data), 10, 64)
+	if err != nil {
+		return fmt.Errorf("could not parse epoch: %w", err)
+	}
+	*e = Epoch(parsed)
+	return nil
+}
+
+// MarshalJSON encodes epoch as a quoted decimal string (as expected by the Beacon API).
+func (e Epoch) MarshalJSON() ([]byte, error) {
+	data := make([]byte, 0, 22)
+	data = append(data, '"')
+	data = strconv.AppendUint(data, uint64(e), 10)
+	return append(data, '"'), nil
+}
+
+// UnmarshalJSON decodes epoch from either a quoted decimal string or a JSON number.
+func (e *Epoch) UnmarshalJSON(data []byte) error {
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		data = data[1 : len(data)-1]
+	}
+	return e.UnmarshalText(data)
+}
+
+// HashTreeRoot returns calculated hash root.
+// Root of a basic uint64 value is its little-endian encoding padded to 32 bytes, so no hashing is involved.
+func (e Epoch) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	binary.LittleEndian.PutUint64(root[:8], uint64(e))
+	return root, nil
+}
+
+// HashTreeRootInto writes hash root into dst, avoiding copying of the returned array.
+func (e Epoch) HashTreeRootInto(dst *[32]byte) error {
+	*dst = [32]byte{}
+	binary.LittleEndian.PutUint64(dst[:8], uint64(e))
+	return nil
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the epoch object.
+func (e *Epoch) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), e.SizeSSZ()); err != nil {
+		return err
+	}
+	*e = Epoch(binary.LittleEndian.Uint64(buf))
+	return nil
+}
+
+// MarshalSSZTo marshals epoch with the provided byte slice.
+func (e *Epoch) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return e.AppendSSZ(dst), nil
+}
+
+// MarshalSSZ marshals epoch into a serialized object.
+func (e *Epoch) MarshalSSZ() ([]byte, error) {
+	return e.AppendSSZ(make([]byte, 0, 8)), nil
+}
+
+// AppendSSZ appends serialized epoch to dst, allocating only if dst has no spare capacity.
+func (e Epoch) AppendSSZ(dst []byte) []byte {
+	return append(dst, byte(e), byte(e>>8), byte(e>>16), byte(e>>24),
+		byte(e>>32), byte(e>>40), byte(e>>48), byte(e>>56))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (e *Epoch) SizeSSZ() int {
+	return 8
+}
+
+// WriteTo writes SSZ serialized epoch to w.
+func (e Epoch) WriteTo(w io.Writer) (int64, error) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], uint64(e))
+	n, err := w.Write(buf[:])
+	return int64(n), err
+}
+
+// ReadFrom reads SSZ serialized epoch from r.
+// Exactly 8 bytes are consumed, so values can be read one after another from the same stream.
+func (e *Epoch) ReadFrom(r io.Reader) (int64, error) {
+	var buf [8]byte
+	n, err := io.ReadFull(r, buf[:])
+	if err != nil {
+		return int64(n), err
+	}
+	*e = Epoch(binary.LittleEndian.Uint64(buf[:]))
+	return int64(n), nil
+}
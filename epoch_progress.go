@@ -0,0 +1,30 @@
+package types
+
+// EpochProgress describes position of a slot within its epoch.
+type EpochProgress struct {
+	// Epoch is the epoch slot belongs to.
+	Epoch Epoch
+	// Elapsed is the number of slots of the epoch up to and including the slot.
+	Elapsed Slot
+	// Remaining is the number of slots of the epoch after the slot.
+	Remaining Slot
+}
+
+// Progress returns progress through the slot's epoch.
+func (s Slot) Progress(spec *ChainSpec) EpochProgress {
+	elapsed := s.SinceEpochStart(spec) + 1
+	return EpochProgress{
+		Epoch:     s.ToEpoch(spec),
+		Elapsed:   elapsed,
+		Remaining: spec.SlotsPerEpoch - elapsed,
+	}
+}
+
+// Percent returns share of the epoch's slots elapsed, in [0, 100] range.
+func (p EpochProgress) Percent() float64 {
+	total := p.Elapsed + p.Remaining
+	if total == 0 {
+		return 0
+	}
+	return float64(p.Elapsed) * 100 / float64(total)
+}
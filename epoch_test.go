@@ -0,0 +1,92 @@
+package types
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/farazdagi/prysm-shared-types/mathutil"
+)
+
+func TestEpoch_PrevNext(t *testing.T) {
+	tests := []struct {
+		epoch, prev, next Epoch
+	}{
+		{epoch: 0, prev: 0, next: 1},
+		{epoch: 1, prev: 0, next: 2},
+		{epoch: 100, prev: 99, next: 101},
+		{epoch: math.MaxUint64, prev: math.MaxUint64 - 1, next: math.MaxUint64},
+	}
+	for _, tt := range tests {
+		if got := tt.epoch.Prev(); got != tt.prev {
+			t.Errorf("Prev(%d) = %v, want %v", tt.epoch, got, tt.prev)
+		}
+		if got := tt.epoch.Next(); got != tt.next {
+			t.Errorf("Next(%d) = %v, want %v", tt.epoch, got, tt.next)
+		}
+	}
+}
+
+func TestEpoch_FarFuture(t *testing.T) {
+	if !FarFutureEpoch.IsFarFuture() || Epoch(0).IsFarFuture() {
+		t.Error("Unexpected IsFarFuture result")
+	}
+	if got := FarFutureEpoch.Add(1); got != FarFutureEpoch {
+		t.Errorf("Far future epoch should stay unchanged, got %v", got)
+	}
+	if got := FarFutureEpoch.AddEpoch(5).AddSlot(5).Mul(2); got != FarFutureEpoch {
+		t.Errorf("Far future epoch should stay unchanged, got %v", got)
+	}
+	if got := FarFutureSlot.Add(1).AddSlot(1).AddEpoch(1).MulSlot(2).MulEpoch(2); got != FarFutureSlot {
+		t.Errorf("Far future slot should stay unchanged, got %v", got)
+	}
+}
+
+func TestGenesis(t *testing.T) {
+	if !GenesisEpoch.IsGenesis() || !GenesisEpoch.IsZero() || Epoch(1).IsGenesis() || Epoch(1).IsZero() {
+		t.Error("Unexpected genesis epoch check result")
+	}
+	if !GenesisSlot.IsGenesis() || !GenesisSlot.IsZero() || Slot(1).IsGenesis() || Slot(1).IsZero() {
+		t.Error("Unexpected genesis slot check result")
+	}
+	if !(Root{}).IsZero() || (Root{0x01}).IsZero() {
+		t.Error("Unexpected zero root check result")
+	}
+}
+
+func TestEpoch_ActivationExitEpoch(t *testing.T) {
+	spec := &ChainSpec{MaxSeedLookahead: 4}
+	tests := []struct {
+		epoch, activation, lookahead Epoch
+	}{
+		{epoch: 0, activation: 5, lookahead: 4},
+		{epoch: 100, activation: 105, lookahead: 104},
+		{epoch: FarFutureEpoch - 2, activation: FarFutureEpoch, lookahead: FarFutureEpoch},
+	}
+	for _, tt := range tests {
+		if got := tt.epoch.ActivationExitEpoch(spec); got != tt.activation {
+			t.Errorf("ActivationExitEpoch(%d) = %d, want %d", tt.epoch, got, tt.activation)
+		}
+		if got := tt.epoch.MaxLookaheadEpoch(spec); got != tt.lookahead {
+			t.Errorf("MaxLookaheadEpoch(%d) = %d, want %d", tt.epoch, got, tt.lookahead)
+		}
+	}
+}
+
+func TestEpoch_AddInt(t *testing.T) {
+	if e, err := Epoch(10).AddInt(-3); err != nil || e != 7 {
+		t.Errorf("Unexpected result: %v, %v", e, err)
+	}
+	if e, err := Epoch(10).AddInt(3); err != nil || e != 13 {
+		t.Errorf("Unexpected result: %v, %v", e, err)
+	}
+	if _, err := Epoch(2).AddInt(-3); !errors.Is(err, mathutil.ErrUnderflow) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if e, err := FarFutureEpoch.AddInt(1); err != nil || !e.IsFarFuture() {
+		t.Errorf("Far future epoch must be left unchanged: %v, %v", e, err)
+	}
+	if _, err := Slot(1).AddInt(math.MinInt64); !errors.Is(err, mathutil.ErrUnderflow) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
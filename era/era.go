@@ -0,0 +1,149 @@
+// Package era implements slot index records of era files (e2store files holding blocks and states
+// of a single SLOTS_PER_HISTORICAL_ROOT span), shared by archival tooling and era based backfill.
+package era
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+
+	types "github.com/farazdagi/prysm-shared-types"
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// SlotIndexType is the e2store record type of slot index records ("i2").
+var SlotIndexType = [2]byte{0x69, 0x32}
+
+// headerSize is the size of e2store record header: type (2 bytes), data length (4) and reserved (2).
+const headerSize = 8
+
+// ErrInvalidSlotIndex is returned when slot index record is malformed.
+var ErrInvalidSlotIndex = errors.New("invalid slot index")
+
+// SlotIndex is the slot index record of an era file, locating records of consecutive slots starting
+// at StartSlot. Offsets are relative to the beginning of the slot index record itself (and hence
+// negative for records preceding it), zero offset marks an empty slot.
+type SlotIndex struct {
+	StartSlot types.Slot
+	Offsets   []int64
+}
+
+// SlotIndexSize returns size of the slot index record (header included) holding count offsets.
+func SlotIndexSize(count int) int {
+	return headerSize + 8 + 8*count + 8
+}
+
+// Count returns number of slots covered by the index.
+func (i *SlotIndex) Count() int {
+	return len(i.Offsets)
+}
+
+// Slots returns range of slots covered by the index, ok is false if the index is empty.
+func (i *SlotIndex) Slots() (r types.SlotRange, ok bool) {
+	if len(i.Offsets) == 0 {
+		return types.SlotRange{}, false
+	}
+	return types.SlotRange{Start: i.StartSlot, End: i.StartSlot + types.Slot(len(i.Offsets)-1)}, true
+}
+
+// Offset returns offset of the slot's record, false if slot is not covered by the index or is empty.
+func (i *SlotIndex) Offset(slot types.Slot) (int64, bool) {
+	r, ok := i.Slots()
+	if !ok || !r.Contains(slot) {
+		return 0, false
+	}
+	offset := i.Offsets[slot-i.StartSlot]
+	return offset, offset != 0
+}
+
+// Validate checks that index covers at least one slot, and its slots do not overflow.
+func (i *SlotIndex) Validate() error {
+	if len(i.Offsets) == 0 {
+		return fmt.Errorf("%w: no offsets", ErrInvalidSlotIndex)
+	}
+	if uint64(i.StartSlot) > math.MaxUint64-uint64(len(i.Offsets)-1) {
+		return fmt.Errorf("%w: slots overflow starting at %d", ErrInvalidSlotIndex, i.StartSlot)
+	}
+	return nil
+}
+
+// MarshalBinary encodes index as an e2store record: header, starting slot, offsets and count, all
+// values being little-endian 64-bit integers.
+func (i *SlotIndex) MarshalBinary() ([]byte, error) {
+	if err := i.Validate(); err != nil {
+		return nil, err
+	}
+	size := SlotIndexSize(len(i.Offsets))
+	buf := make([]byte, headerSize, size)
+	copy(buf, SlotIndexType[:])
+	binary.LittleEndian.PutUint32(buf[2:6], uint32(size-headerSize))
+	buf = i.StartSlot.AppendSSZ(buf)
+	for _, offset := range i.Offsets {
+		buf = sszutil.AppendUint64(buf, uint64(offset))
+	}
+	return sszutil.AppendUint64(buf, uint64(len(i.Offsets))), nil
+}
+
+// UnmarshalBinary decodes index from an e2store record.
+func (i *SlotIndex) UnmarshalBinary(data []byte) error {
+	if len(data) < SlotIndexSize(0) {
+		return fmt.Errorf("%w: record of %d bytes is too short", ErrInvalidSlotIndex, len(data))
+	}
+	if data[0] != SlotIndexType[0] || data[1] != SlotIndexType[1] {
+		return fmt.Errorf("%w: unexpected record type %#x", ErrInvalidSlotIndex, data[:2])
+	}
+	if length := binary.LittleEndian.Uint32(data[2:6]); uint64(length) != uint64(len(data)-headerSize) {
+		return fmt.Errorf("%w: data length %d, record holds %d bytes", ErrInvalidSlotIndex, length, len(data)-headerSize)
+	}
+	if data[6] != 0 || data[7] != 0 {
+		return fmt.Errorf("%w: non-zero reserved bytes", ErrInvalidSlotIndex)
+	}
+	count := binary.LittleEndian.Uint64(data[len(data)-8:])
+	if count == 0 || count > uint64(len(data)) || SlotIndexSize(int(count)) != len(data) {
+		return fmt.Errorf("%w: count %d does not match record of %d bytes", ErrInvalidSlotIndex, count, len(data))
+	}
+	index := SlotIndex{
+		StartSlot: types.Slot(binary.LittleEndian.Uint64(data[headerSize:])),
+		Offsets:   make([]int64, count),
+	}
+	for j := range index.Offsets {
+		index.Offsets[j] = int64(binary.LittleEndian.Uint64(data[headerSize+8+8*j:]))
+	}
+	if err := index.Validate(); err != nil {
+		return err
+	}
+	*i = index
+	return nil
+}
+
+// ReadSlotIndex reads slot index record ending at the given position of r (era files end with the
+// slot index, preceded by further indices). Returned position is the start of the record, which
+// offsets are relative to.
+func ReadSlotIndex(r io.ReaderAt, end int64) (*SlotIndex, int64, error) {
+	var countBuf [8]byte
+	if end < int64(SlotIndexSize(0)) {
+		return nil, 0, fmt.Errorf("%w: %d bytes cannot hold a record", ErrInvalidSlotIndex, end)
+	}
+	if _, err := r.ReadAt(countBuf[:], end-8); err != nil {
+		return nil, 0, err
+	}
+	count := binary.LittleEndian.Uint64(countBuf[:])
+	if count == 0 || count > uint64(end)/8 {
+		return nil, 0, fmt.Errorf("%w: count %d does not fit into %d bytes", ErrInvalidSlotIndex, count, end)
+	}
+	start := end - int64(SlotIndexSize(int(count)))
+	if start < 0 {
+		return nil, 0, fmt.Errorf("%w: count %d does not fit into %d bytes", ErrInvalidSlotIndex, count, end)
+	}
+	data := make([]byte, end-start)
+	if _, err := r.ReadAt(data, start); err != nil {
+		return nil, 0, err
+	}
+	index := &SlotIndex{}
+	if err := index.UnmarshalBinary(data); err != nil {
+		return nil, 0, err
+	}
+	return index, start, nil
+}
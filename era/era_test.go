@@ -0,0 +1,108 @@
+package era
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"reflect"
+	"testing"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+func TestSlotIndex_Binary(t *testing.T) {
+	index := &SlotIndex{StartSlot: 8192, Offsets: []int64{-300, 0, -100}}
+	enc, err := index.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "6932" + "28000000" + "0000" +
+		"0020000000000000" +
+		"d4feffffffffffff" + "0000000000000000" + "9cffffffffffffff" +
+		"0300000000000000"
+	if hex.EncodeToString(enc) != want || len(enc) != SlotIndexSize(3) {
+		t.Errorf("Unexpected encoding: %x", enc)
+	}
+	decoded := &SlotIndex{}
+	if err := decoded.UnmarshalBinary(enc); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, index) {
+		t.Errorf("Unexpected decoded index: %+v", decoded)
+	}
+
+	malformed := map[string][]byte{
+		"short":    enc[:20],
+		"type":     append([]byte{0x65, 0x32}, enc[2:]...),
+		"length":   append(append([]byte(nil), enc[:2]...), append([]byte{0x20}, enc[3:]...)...),
+		"reserved": append(append([]byte(nil), enc[:6]...), append([]byte{1}, enc[7:]...)...),
+		"count":    append(append([]byte(nil), enc[:len(enc)-8]...), 2, 0, 0, 0, 0, 0, 0, 0),
+	}
+	for name, data := range malformed {
+		if err := decoded.UnmarshalBinary(data); !errors.Is(err, ErrInvalidSlotIndex) {
+			t.Errorf("%s: unexpected error: %v", name, err)
+		}
+	}
+	if _, err := (&SlotIndex{}).MarshalBinary(); !errors.Is(err, ErrInvalidSlotIndex) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if _, err := (&SlotIndex{StartSlot: types.FarFutureSlot, Offsets: []int64{1, 2}}).MarshalBinary(); !errors.Is(err, ErrInvalidSlotIndex) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestSlotIndex_Offset(t *testing.T) {
+	index := &SlotIndex{StartSlot: 8192, Offsets: []int64{-300, 0, -100}}
+	tests := []struct {
+		slot   types.Slot
+		offset int64
+		ok     bool
+	}{
+		{slot: 8191},
+		{slot: 8192, offset: -300, ok: true},
+		{slot: 8193},
+		{slot: 8194, offset: -100, ok: true},
+		{slot: 8195},
+	}
+	for _, tt := range tests {
+		if offset, ok := index.Offset(tt.slot); offset != tt.offset || ok != tt.ok {
+			t.Errorf("Unexpected offset of slot %d: %d, %v", tt.slot, offset, ok)
+		}
+	}
+	if r, ok := index.Slots(); !ok || r != (types.SlotRange{Start: 8192, End: 8194}) {
+		t.Errorf("Unexpected slots: %v", r)
+	}
+}
+
+func TestReadSlotIndex(t *testing.T) {
+	stateIndex := &SlotIndex{StartSlot: 16384, Offsets: []int64{-64}}
+	blockIndex := &SlotIndex{StartSlot: 8192, Offsets: []int64{-512, -256}}
+	var file bytes.Buffer
+	file.Write(make([]byte, 1024)) // records
+	for _, index := range []*SlotIndex{blockIndex, stateIndex} {
+		enc, err := index.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		file.Write(enc)
+	}
+
+	r := bytes.NewReader(file.Bytes())
+	state, start, err := ReadSlotIndex(r, int64(file.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(state, stateIndex) || start != int64(file.Len()-SlotIndexSize(1)) {
+		t.Errorf("Unexpected state index at %d: %+v", start, state)
+	}
+	block, start, err := ReadSlotIndex(r, start)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(block, blockIndex) || start != 1024 {
+		t.Errorf("Unexpected block index at %d: %+v", start, block)
+	}
+	if _, _, err := ReadSlotIndex(r, 1024); !errors.Is(err, ErrInvalidSlotIndex) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
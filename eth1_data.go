@@ -0,0 +1,79 @@
+package types
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// Eth1Data is the spec Eth1Data container: deposit contract state as of the execution block.
+type Eth1Data struct {
+	DepositRoot  Root   `json:"deposit_root"`
+	DepositCount uint64 `json:"deposit_count,string"`
+	BlockHash    Root   `json:"block_hash"`
+}
+
+// Equal returns true if both votes are for the same data (to be used in eth1 vote counting).
+func (d *Eth1Data) Equal(x *Eth1Data) bool {
+	return *d == *x
+}
+
+// HashTreeRoot returns calculated hash root.
+func (d *Eth1Data) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	err := d.HashTreeRootInto(&root)
+	return root, err
+}
+
+// AppendFieldRoots appends hash roots of eth1 data fields to dst.
+func (d *Eth1Data) AppendFieldRoots(dst [][32]byte) ([][32]byte, error) {
+	var count [32]byte
+	binary.LittleEndian.PutUint64(count[:8], d.DepositCount)
+	return append(dst, d.DepositRoot, count, d.BlockHash), nil
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (d *Eth1Data) HashTreeRootInto(dst *[32]byte) error {
+	var buf [3][32]byte
+	chunks, _ := d.AppendFieldRoots(buf[:0])
+	return merkleizeInto(dst, chunks, 0)
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the eth1 data object.
+func (d *Eth1Data) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), d.SizeSSZ()); err != nil {
+		return err
+	}
+	copy(d.DepositRoot[:], buf[:32])
+	d.DepositCount = binary.LittleEndian.Uint64(buf[32:40])
+	copy(d.BlockHash[:], buf[40:72])
+	return nil
+}
+
+// MarshalSSZTo marshals eth1 data with the provided byte slice.
+func (d *Eth1Data) MarshalSSZTo(dst []byte) ([]byte, error) {
+	dst = append(dst, d.DepositRoot[:]...)
+	dst = sszutil.AppendUint64(dst, d.DepositCount)
+	return append(dst, d.BlockHash[:]...), nil
+}
+
+// MarshalSSZ marshals eth1 data into a serialized object.
+func (d *Eth1Data) MarshalSSZ() ([]byte, error) {
+	return d.MarshalSSZTo(make([]byte, 0, d.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (d *Eth1Data) SizeSSZ() int {
+	return 72
+}
+
+// WriteTo writes SSZ serialized eth1 data to w.
+func (d *Eth1Data) WriteTo(w io.Writer) (int64, error) {
+	return writeSSZTo(w, d)
+}
+
+// ReadFrom reads SSZ serialized eth1 data from reader (exactly SizeSSZ bytes are consumed).
+func (d *Eth1Data) ReadFrom(reader io.Reader) (int64, error) {
+	return readSSZFrom(reader, d)
+}
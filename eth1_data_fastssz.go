@@ -0,0 +1,19 @@
+//go:build !nofastssz
+
+package types
+
+import fssz "github.com/ferranbt/fastssz"
+
+var _ fssz.HashRoot = (*Eth1Data)(nil)
+var _ fssz.Marshaler = (*Eth1Data)(nil)
+var _ fssz.Unmarshaler = (*Eth1Data)(nil)
+
+// HashTreeRootWith appends eth1 data root to the provided hasher.
+func (d *Eth1Data) HashTreeRootWith(hh *fssz.Hasher) error {
+	root, err := d.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	hh.PutBytes(root[:])
+	return nil
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEth1Data_SSZ(t *testing.T) {
+	d := testEth1Data()
+	enc, err := d.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := &Eth1Data{}
+	if err := decoded.UnmarshalSSZ(enc); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.Equal(d) {
+		t.Errorf("Unexpected eth1 data: %+v", decoded)
+	}
+	decoded.DepositCount++
+	if decoded.Equal(d) {
+		t.Error("Votes for different deposit counts should differ")
+	}
+}
+
+func TestEth1Data_JSON(t *testing.T) {
+	input := `{"deposit_root":"0xde00000000000000000000000000000000000000000000000000000000000000","deposit_count":"21000","block_hash":"0xbb00000000000000000000000000000000000000000000000000000000000000"}`
+	var d Eth1Data
+	if err := json.Unmarshal([]byte(input), &d); err != nil {
+		t.Fatal(err)
+	}
+	if !d.Equal(testEth1Data()) {
+		t.Errorf("Unexpected eth1 data: %+v", d)
+	}
+	enc, err := json.Marshal(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(enc) != input {
+		t.Errorf("Unexpected JSON: %s", enc)
+	}
+}
@@ -0,0 +1,80 @@
+// Package eth2client converts between shared types and attestantio/go-eth2-client spec types
+// (phase0.Slot, phase0.Epoch, phase0.Root etc).
+//
+// As with the geth package, this is a separate module, so that go-eth2-client is only pulled in
+// by consumers that actually use it.
+package eth2client
+
+import (
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+// FromRoot converts root into phase0.Root.
+func FromRoot(root types.Root) phase0.Root {
+	return phase0.Root(root)
+}
+
+// ToRoot converts phase0.Root into root.
+func ToRoot(root phase0.Root) types.Root {
+	return types.Root(root)
+}
+
+// FromSlot converts slot into phase0.Slot.
+func FromSlot(slot types.Slot) phase0.Slot {
+	return phase0.Slot(slot)
+}
+
+// ToSlot converts phase0.Slot into slot.
+func ToSlot(slot phase0.Slot) types.Slot {
+	return types.Slot(slot)
+}
+
+// FromEpoch converts epoch into phase0.Epoch.
+func FromEpoch(epoch types.Epoch) phase0.Epoch {
+	return phase0.Epoch(epoch)
+}
+
+// ToEpoch converts phase0.Epoch into epoch.
+func ToEpoch(epoch phase0.Epoch) types.Epoch {
+	return types.Epoch(epoch)
+}
+
+// FromValidatorIndex converts validator index into phase0.ValidatorIndex.
+func FromValidatorIndex(index types.ValidatorIndex) phase0.ValidatorIndex {
+	return phase0.ValidatorIndex(index)
+}
+
+// ToValidatorIndex converts phase0.ValidatorIndex into validator index.
+func ToValidatorIndex(index phase0.ValidatorIndex) types.ValidatorIndex {
+	return types.ValidatorIndex(index)
+}
+
+// FromGwei converts gwei into phase0.Gwei.
+func FromGwei(gwei types.Gwei) phase0.Gwei {
+	return phase0.Gwei(gwei)
+}
+
+// ToGwei converts phase0.Gwei into gwei.
+func ToGwei(gwei phase0.Gwei) types.Gwei {
+	return types.Gwei(gwei)
+}
+
+// FromSlots converts slice of slots into slice of phase0.Slot.
+func FromSlots(slots []types.Slot) []phase0.Slot {
+	converted := make([]phase0.Slot, len(slots))
+	for i, slot := range slots {
+		converted[i] = phase0.Slot(slot)
+	}
+	return converted
+}
+
+// ToSlots converts slice of phase0.Slot into slice of slots.
+func ToSlots(slots []phase0.Slot) []types.Slot {
+	converted := make([]types.Slot, len(slots))
+	for i, slot := range slots {
+		converted[i] = types.Slot(slot)
+	}
+	return converted
+}
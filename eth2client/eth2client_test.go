@@ -0,0 +1,33 @@
+package eth2client
+
+import (
+	"testing"
+
+	"github.com/attestantio/go-eth2-client/spec/phase0"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+func TestConversions(t *testing.T) {
+	root := types.Root{0xaa, 31: 0xbb}
+	if r := FromRoot(root); r.String() != "0xaa000000000000000000000000000000000000000000000000000000000000bb" || ToRoot(r) != root {
+		t.Errorf("Unexpected root: %s", r)
+	}
+	if s := FromSlot(123); s != phase0.Slot(123) || ToSlot(s) != 123 {
+		t.Errorf("Unexpected slot: %d", s)
+	}
+	if e := FromEpoch(9); e != phase0.Epoch(9) || ToEpoch(e) != 9 {
+		t.Errorf("Unexpected epoch: %d", e)
+	}
+	if i := FromValidatorIndex(5); i != phase0.ValidatorIndex(5) || ToValidatorIndex(i) != 5 {
+		t.Errorf("Unexpected validator index: %d", i)
+	}
+	if g := FromGwei(32e9); g != phase0.Gwei(32e9) || ToGwei(g) != 32e9 {
+		t.Errorf("Unexpected gwei: %d", g)
+	}
+
+	slots := ToSlots(FromSlots([]types.Slot{1, 2, 3}))
+	if len(slots) != 3 || slots[2] != 3 {
+		t.Errorf("Unexpected slots: %v", slots)
+	}
+}
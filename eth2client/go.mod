@@ -0,0 +1,36 @@
+module github.com/farazdagi/prysm-shared-types/eth2client
+
+go 1.25.0
+
+require (
+	github.com/attestantio/go-eth2-client v0.29.0
+	github.com/farazdagi/prysm-shared-types v0.0.0
+)
+
+require (
+	github.com/OffchainLabs/go-bitfield v0.0.0-20251031151322-f427d04d8506 // indirect
+	github.com/bahlo/generic-list-go v0.2.0 // indirect
+	github.com/buger/jsonparser v1.1.1 // indirect
+	github.com/casbin/govaluate v1.10.0 // indirect
+	github.com/fatih/color v1.10.0 // indirect
+	github.com/ferranbt/fastssz v0.0.0-20201020132831-68dc48984fd3 // indirect
+	github.com/goccy/go-yaml v1.9.2 // indirect
+	github.com/invopop/jsonschema v0.13.0 // indirect
+	github.com/klauspost/cpuid/v2 v2.3.0 // indirect
+	github.com/mailru/easyjson v0.7.7 // indirect
+	github.com/mattn/go-colorable v0.1.14 // indirect
+	github.com/mattn/go-isatty v0.0.20 // indirect
+	github.com/minio/sha256-simd v1.0.0 // indirect
+	github.com/mitchellh/mapstructure v1.3.2 // indirect
+	github.com/pk910/dynamic-ssz v1.3.2 // indirect
+	github.com/pk910/hashtree-bindings v0.2.2 // indirect
+	github.com/pkg/errors v0.9.1 // indirect
+	github.com/prysmaticlabs/gohashtree v0.0.3-alpha // indirect
+	github.com/wk8/go-ordered-map/v2 v2.1.8 // indirect
+	golang.org/x/sys v0.36.0 // indirect
+	golang.org/x/xerrors v0.0.0-20200804184101-5ec99f83aff1 // indirect
+	gopkg.in/yaml.v2 v2.4.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
+
+replace github.com/farazdagi/prysm-shared-types => ../
@@ -0,0 +1,88 @@
+package types
+
+import "fmt"
+
+// EventTopic is a Beacon API event stream topic.
+type EventTopic string
+
+// Event stream topics with typed payloads in this package.
+const (
+	EventTopicHead                EventTopic = "head"
+	EventTopicBlock               EventTopic = "block"
+	EventTopicFinalizedCheckpoint EventTopic = "finalized_checkpoint"
+	EventTopicChainReorg          EventTopic = "chain_reorg"
+	EventTopicPayloadAttributes   EventTopic = "payload_attributes"
+)
+
+// ParseEventTopic parses one of the supported event stream topics.
+func ParseEventTopic(s string) (EventTopic, error) {
+	switch topic := EventTopic(s); topic {
+	case EventTopicHead, EventTopicBlock, EventTopicFinalizedCheckpoint, EventTopicChainReorg, EventTopicPayloadAttributes:
+		return topic, nil
+	}
+	return "", fmt.Errorf("unknown event topic %q", s)
+}
+
+// HeadEvent is the payload of the "head" event.
+type HeadEvent struct {
+	Slot                      Slot `json:"slot"`
+	Block                     Root `json:"block"`
+	State                     Root `json:"state"`
+	EpochTransition           bool `json:"epoch_transition"`
+	PreviousDutyDependentRoot Root `json:"previous_duty_dependent_root"`
+	CurrentDutyDependentRoot  Root `json:"current_duty_dependent_root"`
+	ExecutionOptimistic       bool `json:"execution_optimistic"`
+}
+
+// BlockEvent is the payload of the "block" event.
+type BlockEvent struct {
+	Slot                Slot `json:"slot"`
+	Block               Root `json:"block"`
+	ExecutionOptimistic bool `json:"execution_optimistic"`
+}
+
+// FinalizedCheckpointEvent is the payload of the "finalized_checkpoint" event.
+type FinalizedCheckpointEvent struct {
+	Block               Root  `json:"block"`
+	State               Root  `json:"state"`
+	Epoch               Epoch `json:"epoch"`
+	ExecutionOptimistic bool  `json:"execution_optimistic"`
+}
+
+// ChainReorgEvent is the payload of the "chain_reorg" event.
+type ChainReorgEvent struct {
+	Slot                Slot   `json:"slot"`
+	Depth               uint64 `json:"depth,string"`
+	OldHeadBlock        Root   `json:"old_head_block"`
+	NewHeadBlock        Root   `json:"new_head_block"`
+	OldHeadState        Root   `json:"old_head_state"`
+	NewHeadState        Root   `json:"new_head_state"`
+	Epoch               Epoch  `json:"epoch"`
+	ExecutionOptimistic bool   `json:"execution_optimistic"`
+}
+
+// PayloadAttributesEvent is the payload of the "payload_attributes" event.
+type PayloadAttributesEvent struct {
+	Version Version               `json:"version"`
+	Data    PayloadAttributesData `json:"data"`
+}
+
+// PayloadAttributesData describes the upcoming proposal the payload attributes are built for.
+type PayloadAttributesData struct {
+	ProposerIndex     ValidatorIndex    `json:"proposer_index"`
+	ProposalSlot      Slot              `json:"proposal_slot"`
+	ParentBlockNumber uint64            `json:"parent_block_number,string"`
+	ParentBlockRoot   Root              `json:"parent_block_root"`
+	ParentBlockHash   Root              `json:"parent_block_hash"`
+	PayloadAttributes PayloadAttributes `json:"payload_attributes"`
+}
+
+// PayloadAttributes are the engine API payload attributes; fields introduced by later forks are
+// omitted when not set.
+type PayloadAttributes struct {
+	Timestamp             uint64           `json:"timestamp,string"`
+	PrevRandao            Root             `json:"prev_randao"`
+	SuggestedFeeRecipient ExecutionAddress `json:"suggested_fee_recipient"`
+	Withdrawals           []Withdrawal     `json:"withdrawals,omitempty"`
+	ParentBeaconBlockRoot *Root            `json:"parent_beacon_block_root,omitempty"`
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestEvents_JSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		event interface{}
+		input string
+	}{
+		{
+			name:  "head",
+			event: &HeadEvent{},
+			input: `{"slot":"10","block":"0x9a2fefd2fdb57f74993c7780ea5b9030d2897b615b89f808011ca5aebed54eaf","state":"0x600e852a08c1200654ddf11025f1ceacb3c2e74bdd5c630cde0838b2591b69f9","epoch_transition":false,"previous_duty_dependent_root":"0x5e0043f107cb57913498fbf2f99ff55e730bf1e151f02f221e977c91a90a0e91","current_duty_dependent_root":"0x5e0043f107cb57913498fbf2f99ff55e730bf1e151f02f221e977c91a90a0e91","execution_optimistic":false}`,
+		},
+		{
+			name:  "block",
+			event: &BlockEvent{},
+			input: `{"slot":"10","block":"0x9a2fefd2fdb57f74993c7780ea5b9030d2897b615b89f808011ca5aebed54eaf","execution_optimistic":true}`,
+		},
+		{
+			name:  "finalized checkpoint",
+			event: &FinalizedCheckpointEvent{},
+			input: `{"block":"0x9a2fefd2fdb57f74993c7780ea5b9030d2897b615b89f808011ca5aebed54eaf","state":"0x600e852a08c1200654ddf11025f1ceacb3c2e74bdd5c630cde0838b2591b69f9","epoch":"2","execution_optimistic":false}`,
+		},
+		{
+			name:  "chain reorg",
+			event: &ChainReorgEvent{},
+			input: `{"slot":"200","depth":"50","old_head_block":"0x9a2fefd2fdb57f74993c7780ea5b9030d2897b615b89f808011ca5aebed54eaf","new_head_block":"0x76262e91970d375a19bfe8a867288d7b9cde43c8635f598d93d39d041706fc76","old_head_state":"0x9a2fefd2fdb57f74993c7780ea5b9030d2897b615b89f808011ca5aebed54eaf","new_head_state":"0x600e852a08c1200654ddf11025f1ceacb3c2e74bdd5c630cde0838b2591b69f9","epoch":"2","execution_optimistic":false}`,
+		},
+		{
+			name:  "payload attributes",
+			event: &PayloadAttributesEvent{},
+			input: `{"version":"capella","data":{"proposer_index":"123","proposal_slot":"10","parent_block_number":"9","parent_block_root":"0x9a2fefd2fdb57f74993c7780ea5b9030d2897b615b89f808011ca5aebed54eaf","parent_block_hash":"0x76262e91970d375a19bfe8a867288d7b9cde43c8635f598d93d39d041706fc76","payload_attributes":{"timestamp":"123456","prev_randao":"0x9a2fefd2fdb57f74993c7780ea5b9030d2897b615b89f808011ca5aebed54eaf","suggested_fee_recipient":"0x0000000000000000000000000000000000000000","withdrawals":[{"index":"5","validator_index":"10","address":"0x0000000000000000000000000000000000000000","amount":"15640"}]}}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := json.Unmarshal([]byte(tt.input), tt.event); err != nil {
+				t.Fatal(err)
+			}
+			enc, err := json.Marshal(tt.event)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(enc) != tt.input {
+				t.Errorf("Unexpected JSON: %s", enc)
+			}
+		})
+	}
+}
+
+func TestParseEventTopic(t *testing.T) {
+	if topic, err := ParseEventTopic("chain_reorg"); err != nil || topic != EventTopicChainReorg {
+		t.Errorf("Unexpected topic: %v, %v", topic, err)
+	}
+	if _, err := ParseEventTopic("unknown"); err == nil {
+		t.Error("Expected error for unknown topic")
+	}
+}
@@ -0,0 +1,4 @@
+package types
+
+// ExecutionAddress represents a 20 byte execution layer address.
+type ExecutionAddress Bytes20
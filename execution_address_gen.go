@@ -0,0 +1,82 @@
+// Code generated by typegen. DO NOT EDIT.
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// NewExecutionAddress copies b into ExecutionAddress, returns an error if b is not exactly 20 bytes long.
+func NewExecutionAddress(b []byte) (ExecutionAddress, error) {
+	var v ExecutionAddress
+	if len(b) != len(v) {
+		return v, fmt.Errorf("%w: expected 20 bytes, got %d", ErrInvalidBytesLength, len(b))
+	}
+	copy(v[:], b)
+	return v, nil
+}
+
+// Equal returns true if both values hold the same bytes.
+func (a ExecutionAddress) Equal(x ExecutionAddress) bool {
+	return a == x
+}
+
+// IsZero returns true if all bytes of the address are zero.
+func (a ExecutionAddress) IsZero() bool {
+	return a == ExecutionAddress{}
+}
+
+// String returns 0x-prefixed hex representation of the address.
+func (a ExecutionAddress) String() string {
+	return string(appendHex(nil, a[:]))
+}
+
+// MarshalText encodes address as 0x-prefixed hex string.
+func (a ExecutionAddress) MarshalText() ([]byte, error) {
+	return appendHex(make([]byte, 0, 42), a[:]), nil
+}
+
+// UnmarshalText decodes address from 0x-prefixed hex string.
+func (a *ExecutionAddress) UnmarshalText(text []byte) error {
+	return decodeHexInto(a[:], text)
+}
+
+// HashTreeRoot returns calculated hash root (address right-padded to 32 bytes).
+func (a ExecutionAddress) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	copy(root[:], a[:])
+	return root, nil
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (a ExecutionAddress) HashTreeRootInto(dst *[32]byte) error {
+	*dst = [32]byte{}
+	copy(dst[:], a[:])
+	return nil
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the address object.
+func (a *ExecutionAddress) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), a.SizeSSZ()); err != nil {
+		return err
+	}
+	copy(a[:], buf)
+	return nil
+}
+
+// MarshalSSZTo marshals address with the provided byte slice.
+func (a *ExecutionAddress) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return append(dst, a[:]...), nil
+}
+
+// MarshalSSZ marshals address into a serialized object.
+func (a *ExecutionAddress) MarshalSSZ() ([]byte, error) {
+	return append(make([]byte, 0, 20), a[:]...), nil
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (a *ExecutionAddress) SizeSSZ() int {
+	return 20
+}
@@ -0,0 +1,21 @@
+package types
+
+import "testing"
+
+func TestExecutionAddress_Text(t *testing.T) {
+	addr := ExecutionAddress{0xab, 19: 0xcd}
+	enc, err := addr.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(enc) != "0xab000000000000000000000000000000000000cd" {
+		t.Errorf("Unexpected text: %s", enc)
+	}
+	var decoded ExecutionAddress
+	if err := decoded.UnmarshalText(enc); err != nil {
+		t.Fatal(err)
+	}
+	if decoded != addr {
+		t.Errorf("Unexpected address: %v", decoded)
+	}
+}
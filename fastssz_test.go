@@ -0,0 +1,176 @@
+//go:build !nofastssz
+
+package types
+
+import (
+	"testing"
+
+	fssz "github.com/ferranbt/fastssz"
+)
+
+func TestHashTreeRoot_MatchesHasher(t *testing.T) {
+	for _, v := range []uint64{0, 1, 42, 1 << 32, 1<<64 - 1} {
+		got, err := Slot(v).HashTreeRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		want, err := fssz.HashWithDefaultHasher(Slot(v))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("Unexpected root for %d: %#x, want %#x", v, got, want)
+		}
+	}
+
+	root := Root{0x01, 0x02}
+	got, err := root.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := fssz.HashWithDefaultHasher(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("Unexpected root: %#x, want %#x", got, want)
+	}
+}
+
+func TestHashTreeRootBatch(t *testing.T) {
+	for _, n := range []int{0, 1, 3, 4, 5, 8, 17, 128, 1000} {
+		values := make([]uint64, n)
+		slots := make([]Slot, n)
+		for i := range values {
+			values[i] = uint64(i*7 + 1)
+			slots[i] = Slot(values[i])
+		}
+
+		hh := fssz.NewHasher()
+		hh.PutUint64Array(values)
+		want, err := hh.HashRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := HashTreeRootBatch(slots)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("Unexpected root for %d values: %#x, want %#x", n, got, want)
+		}
+	}
+}
+
+func TestCommitteeRoot(t *testing.T) {
+	for _, n := range []int{0, 1, 5, 128, MaxValidatorsPerCommittee} {
+		committee := make([]ValidatorIndex, n)
+		values := make([]uint64, n)
+		for i := range committee {
+			committee[i] = ValidatorIndex(i * 7)
+			values[i] = uint64(i * 7)
+		}
+		hh := fssz.NewHasher()
+		hh.PutUint64Array(values, MaxValidatorsPerCommittee)
+		want, err := hh.HashRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := CommitteeRoot(committee)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("Unexpected root of committee of %d: %#x, want %#x", n, got, want)
+		}
+		list, err := NewList[ValidatorIndex](MaxValidatorsPerCommittee, committee...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		parallel, err := list.HashTreeRootParallel(4)
+		if err != nil || parallel != want {
+			t.Errorf("Unexpected parallel root of committee of %d: %#x, %v", n, parallel, err)
+		}
+	}
+}
+
+func TestProposerLookahead_HashTreeRoot(t *testing.T) {
+	for _, spec := range []*ChainSpec{{SlotsPerEpoch: 32, MinSeedLookahead: 1}, {SlotsPerEpoch: 8, MinSeedLookahead: 1}} {
+		l := testProposerLookahead(t, spec)
+		values := make([]uint64, len(l))
+		for i, v := range l {
+			values[i] = uint64(v)
+		}
+		hh := fssz.NewHasher()
+		hh.PutUint64Array(values)
+		want, err := hh.HashRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := l.HashTreeRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("Unexpected root of %d proposers: %#x, want %#x", len(l), got, want)
+		}
+	}
+}
+
+func TestSlotList_HashTreeRoot(t *testing.T) {
+	for _, n := range []int{0, 1, 4, 5, 33} {
+		slots := make([]Slot, n)
+		values := make([]uint64, n)
+		for i := range slots {
+			slots[i] = Slot(i + 1)
+			values[i] = uint64(i + 1)
+		}
+		for _, limit := range []uint64{64, 1024, 1 << 40} {
+			hh := fssz.NewHasher()
+			hh.PutUint64Array(values, limit)
+			want, err := hh.HashRoot()
+			if err != nil {
+				t.Fatal(err)
+			}
+			list, err := NewList[Slot](limit, slots...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := list.HashTreeRoot()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != want {
+				t.Errorf("Unexpected root for %d values (limit %d): %#x, want %#x", n, limit, got, want)
+			}
+		}
+	}
+}
+
+func TestBytesN_HashTreeRoot(t *testing.T) {
+	var b96 Bytes96
+	for i := range b96 {
+		b96[i] = byte(i + 1)
+	}
+	var b48 Bytes48
+	copy(b48[:], b96[:])
+	var b20 Bytes20
+	copy(b20[:], b96[:])
+	values := []interface {
+		fssz.HashRoot
+		HashTreeRoot() ([32]byte, error)
+	}{Bytes4{0x01}, Bytes8{0x01, 0x02}, b20, Bytes32{0x03}, b48, b96}
+	for _, v := range values {
+		want, err := fssz.HashWithDefaultHasher(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := v.HashTreeRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("Unexpected root of %T: %#x, want %#x", v, got, want)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// ErrInconsistentCheckpoints is returned when finality checkpoints are not properly ordered.
+var ErrInconsistentCheckpoints = errors.New("inconsistent finality checkpoints")
+
+// FinalityCheckpoints holds justification and finalization checkpoints of the state, as returned by
+// the Beacon API finality checkpoints endpoint.
+type FinalityCheckpoints struct {
+	PreviousJustified Checkpoint `json:"previous_justified"`
+	CurrentJustified  Checkpoint `json:"current_justified"`
+	Finalized         Checkpoint `json:"finalized"`
+}
+
+// Validate checks that checkpoints are ordered: finalized epoch cannot exceed current justified one,
+// and previous justified epoch cannot exceed current justified one.
+func (f *FinalityCheckpoints) Validate() error {
+	if f.Finalized.Epoch > f.CurrentJustified.Epoch {
+		return fmt.Errorf("%w: finalized epoch %d is after justified epoch %d", ErrInconsistentCheckpoints, f.Finalized.Epoch, f.CurrentJustified.Epoch)
+	}
+	if f.PreviousJustified.Epoch > f.CurrentJustified.Epoch {
+		return fmt.Errorf("%w: previous justified epoch %d is after current justified epoch %d", ErrInconsistentCheckpoints, f.PreviousJustified.Epoch, f.CurrentJustified.Epoch)
+	}
+	return nil
+}
+
+// IsFinalized returns true if the epoch is at or before the finalized epoch, i.e. blocks of the
+// epoch on the canonical chain can no longer be reverted.
+func (f *FinalityCheckpoints) IsFinalized(epoch Epoch) bool {
+	return epoch <= f.Finalized.Epoch
+}
+
+// IsJustified returns true if the epoch is at or before the current justified epoch.
+func (f *FinalityCheckpoints) IsJustified(epoch Epoch) bool {
+	return epoch <= f.CurrentJustified.Epoch
+}
+
+// IsFinalizedDescendant returns true if the checkpoint may descend from the finalized checkpoint:
+// it is either the finalized checkpoint itself or a checkpoint at a later epoch. Checkpoints at the
+// finalized epoch with a different root conflict with finality.
+func (f *FinalityCheckpoints) IsFinalizedDescendant(c Checkpoint) bool {
+	if c.Epoch == f.Finalized.Epoch {
+		return c.Root == f.Finalized.Root
+	}
+	return c.Epoch > f.Finalized.Epoch
+}
+
+// FinalityDelay returns number of epochs since finalization as seen from the current epoch, see
+// get_finality_delay (which is relative to the previous epoch). Zero if finalized epoch is not behind.
+func (f *FinalityCheckpoints) FinalityDelay(current Epoch) Epoch {
+	return saturatingSubEpoch(current.Prev(), f.Finalized.Epoch)
+}
+
+// HashTreeRoot returns calculated hash root.
+func (f *FinalityCheckpoints) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	err := f.HashTreeRootInto(&root)
+	return root, err
+}
+
+// AppendFieldRoots appends hash roots of the checkpoints to dst.
+func (f *FinalityCheckpoints) AppendFieldRoots(dst [][32]byte) ([][32]byte, error) {
+	var chunks [3][32]byte
+	if err := f.PreviousJustified.HashTreeRootInto(&chunks[0]); err != nil {
+		return dst, err
+	}
+	if err := f.CurrentJustified.HashTreeRootInto(&chunks[1]); err != nil {
+		return dst, err
+	}
+	if err := f.Finalized.HashTreeRootInto(&chunks[2]); err != nil {
+		return dst, err
+	}
+	return append(dst, chunks[:]...), nil
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (f *FinalityCheckpoints) HashTreeRootInto(dst *[32]byte) error {
+	var buf [3][32]byte
+	chunks, err := f.AppendFieldRoots(buf[:0])
+	if err != nil {
+		return err
+	}
+	return merkleizeInto(dst, chunks, 0)
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the finality checkpoints object.
+func (f *FinalityCheckpoints) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), f.SizeSSZ()); err != nil {
+		return err
+	}
+	if err := f.PreviousJustified.UnmarshalSSZ(buf[:40]); err != nil {
+		return err
+	}
+	if err := f.CurrentJustified.UnmarshalSSZ(buf[40:80]); err != nil {
+		return err
+	}
+	return f.Finalized.UnmarshalSSZ(buf[80:120])
+}
+
+// MarshalSSZTo marshals finality checkpoints with the provided byte slice.
+func (f *FinalityCheckpoints) MarshalSSZTo(dst []byte) ([]byte, error) {
+	dst, _ = f.PreviousJustified.MarshalSSZTo(dst)
+	dst, _ = f.CurrentJustified.MarshalSSZTo(dst)
+	return f.Finalized.MarshalSSZTo(dst)
+}
+
+// MarshalSSZ marshals finality checkpoints into a serialized object.
+func (f *FinalityCheckpoints) MarshalSSZ() ([]byte, error) {
+	return f.MarshalSSZTo(make([]byte, 0, f.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (f *FinalityCheckpoints) SizeSSZ() int {
+	return 120
+}
+
+// WriteTo writes SSZ serialized finality checkpoints to w.
+func (f *FinalityCheckpoints) WriteTo(w io.Writer) (int64, error) {
+	return writeSSZTo(w, f)
+}
+
+// ReadFrom reads SSZ serialized finality checkpoints from reader (exactly SizeSSZ bytes are consumed).
+func (f *FinalityCheckpoints) ReadFrom(reader io.Reader) (int64, error) {
+	return readSSZFrom(reader, f)
+}
@@ -0,0 +1,19 @@
+//go:build !nofastssz
+
+package types
+
+import fssz "github.com/ferranbt/fastssz"
+
+var _ fssz.HashRoot = (*FinalityCheckpoints)(nil)
+var _ fssz.Marshaler = (*FinalityCheckpoints)(nil)
+var _ fssz.Unmarshaler = (*FinalityCheckpoints)(nil)
+
+// HashTreeRootWith appends finality checkpoints root to the provided hasher.
+func (f *FinalityCheckpoints) HashTreeRootWith(hh *fssz.Hasher) error {
+	root, err := f.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	hh.PutBytes(root[:])
+	return nil
+}
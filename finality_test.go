@@ -0,0 +1,63 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestFinalityCheckpoints_SSZ(t *testing.T) {
+	f := testFinalityCheckpoints()
+	enc, err := f.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := &FinalityCheckpoints{}
+	if err := decoded.UnmarshalSSZ(enc); err != nil {
+		t.Fatal(err)
+	}
+	if *decoded != *f {
+		t.Errorf("Unexpected checkpoints: %+v", decoded)
+	}
+}
+
+func TestFinalityCheckpoints_JSON(t *testing.T) {
+	f := testFinalityCheckpoints()
+	enc, err := json.Marshal(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded FinalityCheckpoints
+	if err := json.Unmarshal(enc, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded != *f {
+		t.Errorf("Unexpected checkpoints: %+v", decoded)
+	}
+}
+
+func TestFinalityCheckpoints_Predicates(t *testing.T) {
+	f := testFinalityCheckpoints()
+	if err := f.Validate(); err != nil {
+		t.Error(err)
+	}
+	if !f.IsFinalized(98) || f.IsFinalized(99) || !f.IsJustified(100) || f.IsJustified(101) {
+		t.Error("Unexpected finalization status")
+	}
+	if !f.IsFinalizedDescendant(f.Finalized) || !f.IsFinalizedDescendant(Checkpoint{Epoch: 99}) {
+		t.Error("Checkpoint should descend from finalized one")
+	}
+	if f.IsFinalizedDescendant(Checkpoint{Epoch: 98, Root: BlockRoot{Root{0x01}}}) || f.IsFinalizedDescendant(Checkpoint{Epoch: 97}) {
+		t.Error("Checkpoint should conflict with finality")
+	}
+	for current, want := range map[Epoch]Epoch{0: 0, 99: 0, 100: 1, 110: 11} {
+		if got := f.FinalityDelay(current); got != want {
+			t.Errorf("Unexpected finality delay at %d: %d", current, got)
+		}
+	}
+
+	f.Finalized.Epoch = 101
+	if err := f.Validate(); !errors.Is(err, ErrInconsistentCheckpoints) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,116 @@
+package types
+
+// Container fixtures shared by SSZ, JSON and hash tree root tests. Expected roots of these values
+// are hard-coded in TestContainer_HashTreeRoot, changing a fixture requires updating its root.
+
+func testAttestationData() *AttestationData {
+	return &AttestationData{
+		Slot:            100,
+		Index:           3,
+		BeaconBlockRoot: BlockRoot{Root{0x01}},
+		Source:          Checkpoint{Epoch: 2, Root: BlockRoot{Root{0x02}}},
+		Target:          Checkpoint{Epoch: 3, Root: BlockRoot{Root{0x03}}},
+	}
+}
+
+func testBlockHeader() *SignedBeaconBlockHeader {
+	return &SignedBeaconBlockHeader{
+		Message: BeaconBlockHeader{
+			Slot:          3250,
+			ProposerIndex: 42,
+			ParentRoot:    BlockRoot{Root{0x01}},
+			StateRoot:     StateRoot{Root{0x02}},
+			BodyRoot:      BodyRoot{Root{0x03}},
+		},
+		Signature: BLSSignature{0xaa, 0xbb},
+	}
+}
+
+func testBLSToExecutionChange() *SignedBLSToExecutionChange {
+	return &SignedBLSToExecutionChange{
+		Message: BLSToExecutionChange{
+			ValidatorIndex:     42,
+			FromBLSPubkey:      BLSPubkey{0xa1},
+			ToExecutionAddress: ExecutionAddress{0xde, 0xad},
+		},
+		Signature: BLSSignature{0xb2},
+	}
+}
+
+func testDepositData() *DepositData {
+	return &DepositData{
+		Pubkey:                BLSPubkey{0xa1, 0x02},
+		WithdrawalCredentials: Root{0x01},
+		Amount:                32 * GweiPerEth,
+		Signature:             BLSSignature{0xb3},
+	}
+}
+
+func testEth1Data() *Eth1Data {
+	return &Eth1Data{DepositRoot: Root{0xde}, DepositCount: 21000, BlockHash: Root{0xbb}}
+}
+
+func testFinalityCheckpoints() *FinalityCheckpoints {
+	return &FinalityCheckpoints{
+		PreviousJustified: Checkpoint{Epoch: 99, Root: BlockRoot{Root{0x99}}},
+		CurrentJustified:  Checkpoint{Epoch: 100, Root: BlockRoot{Root{0x10}}},
+		Finalized:         Checkpoint{Epoch: 98, Root: BlockRoot{Root{0x98}}},
+	}
+}
+
+func testIndexedAttestation() *IndexedAttestation {
+	return &IndexedAttestation{
+		AttestingIndices: []ValidatorIndex{3, 17, 42},
+		Data:             *testAttestationData(),
+		Signature:        BLSSignature{0xaa},
+	}
+}
+
+func testPendingDeposit() *PendingDeposit {
+	return &PendingDeposit{
+		Pubkey:                BLSPubkey{0x93},
+		WithdrawalCredentials: Root{0x01},
+		Amount:                32 * GweiPerEth,
+		Signature:             BLSSignature{0xaa},
+		Slot:                  12345,
+	}
+}
+
+func testStatus() *Status {
+	return &Status{
+		ForkDigest:     ForkDigest{0xb5, 0x30, 0x3f, 0x2a},
+		FinalizedRoot:  BlockRoot{Root{0xf1}},
+		FinalizedEpoch: 100,
+		HeadRoot:       BlockRoot{Root{0xa1}},
+		HeadSlot:       3250,
+	}
+}
+
+func testSyncAggregate() *SyncAggregate {
+	a := &SyncAggregate{SyncCommitteeSignature: BLSSignature{0xa5}}
+	for _, i := range []uint64{0, 7, 8, 511} {
+		a.SyncCommitteeBits.SetBitAt(i, true)
+	}
+	return a
+}
+
+func testValidatorRegistration() *SignedValidatorRegistration {
+	return &SignedValidatorRegistration{
+		Message: ValidatorRegistration{
+			FeeRecipient: ExecutionAddress{0xfe},
+			GasLimit:     30_000_000,
+			Timestamp:    1_700_000_000,
+			Pubkey:       BLSPubkey{0x93},
+		},
+		Signature: BLSSignature{0x8c},
+	}
+}
+
+func testWithdrawal() *Withdrawal {
+	return &Withdrawal{
+		Index:          5,
+		ValidatorIndex: 10,
+		Address:        ExecutionAddress{0xab},
+		Amount:         15640,
+	}
+}
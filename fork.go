@@ -0,0 +1,79 @@
+package types
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// Fork is the spec Fork container, holding fork versions and the epoch of the latest fork.
+type Fork struct {
+	PreviousVersion ForkVersion `json:"previous_version"`
+	CurrentVersion  ForkVersion `json:"current_version"`
+	Epoch           Epoch       `json:"epoch"`
+}
+
+// HashTreeRoot returns calculated hash root.
+func (f *Fork) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	err := f.HashTreeRootInto(&root)
+	return root, err
+}
+
+// AppendFieldRoots appends hash roots of fork fields to dst.
+func (f *Fork) AppendFieldRoots(dst [][32]byte) ([][32]byte, error) {
+	var chunks [3][32]byte
+	_ = f.PreviousVersion.HashTreeRootInto(&chunks[0])
+	_ = f.CurrentVersion.HashTreeRootInto(&chunks[1])
+	_ = f.Epoch.HashTreeRootInto(&chunks[2])
+	return append(dst, chunks[:]...), nil
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (f *Fork) HashTreeRootInto(dst *[32]byte) error {
+	var buf [3][32]byte
+	chunks, err := f.AppendFieldRoots(buf[:0])
+	if err != nil {
+		return err
+	}
+	return merkleizeInto(dst, chunks, 0)
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the fork object.
+func (f *Fork) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), f.SizeSSZ()); err != nil {
+		return err
+	}
+	copy(f.PreviousVersion[:], buf[:4])
+	copy(f.CurrentVersion[:], buf[4:8])
+	f.Epoch = Epoch(binary.LittleEndian.Uint64(buf[8:16]))
+	return nil
+}
+
+// MarshalSSZTo marshals fork with the provided byte slice.
+func (f *Fork) MarshalSSZTo(dst []byte) ([]byte, error) {
+	dst = append(dst, f.PreviousVersion[:]...)
+	dst = append(dst, f.CurrentVersion[:]...)
+	return f.Epoch.AppendSSZ(dst), nil
+}
+
+// MarshalSSZ marshals fork into a serialized object.
+func (f *Fork) MarshalSSZ() ([]byte, error) {
+	return f.MarshalSSZTo(make([]byte, 0, f.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (f *Fork) SizeSSZ() int {
+	return 16
+}
+
+// WriteTo writes SSZ serialized fork to w.
+func (f *Fork) WriteTo(w io.Writer) (int64, error) {
+	return writeSSZTo(w, f)
+}
+
+// ReadFrom reads SSZ serialized fork from reader (exactly SizeSSZ bytes are consumed).
+func (f *Fork) ReadFrom(reader io.Reader) (int64, error) {
+	return readSSZFrom(reader, f)
+}
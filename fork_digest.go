@@ -0,0 +1,36 @@
+package types
+
+import "encoding/binary"
+
+// ForkDigest represents a 4 byte fork digest, identifying the fork on the networking layer.
+type ForkDigest Bytes4
+
+// ComputeForkDigest returns digest of the fork version and genesis validators root, see
+// compute_fork_digest (pre-Fulu, i.e. without blob parameters mixed in, see ComputeForkDigestWithBlobs).
+func ComputeForkDigest(version ForkVersion, genesisValidatorsRoot Root) (ForkDigest, error) {
+	root, err := ComputeForkDataRoot(version, genesisValidatorsRoot)
+	if err != nil {
+		return ForkDigest{}, err
+	}
+	var digest ForkDigest
+	copy(digest[:], root[:4])
+	return digest, nil
+}
+
+// ComputeForkDigestWithBlobs returns Fulu fork digest: digest of the fork version and genesis
+// validators root, masked with hash of the blob parameters in effect (see compute_fork_digest of
+// Fulu and BlobSchedule.EntryAt).
+func ComputeForkDigestWithBlobs(version ForkVersion, genesisValidatorsRoot Root, blobs BlobScheduleEntry) (ForkDigest, error) {
+	digest, err := ComputeForkDigest(version, genesisValidatorsRoot)
+	if err != nil {
+		return ForkDigest{}, err
+	}
+	var buf [16]byte
+	binary.LittleEndian.PutUint64(buf[:8], uint64(blobs.Epoch))
+	binary.LittleEndian.PutUint64(buf[8:], blobs.MaxBlobsPerBlock)
+	mask := sum256(buf[:])
+	for i := range digest {
+		digest[i] ^= mask[i]
+	}
+	return digest, nil
+}
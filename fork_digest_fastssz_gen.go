@@ -0,0 +1,17 @@
+// Code generated by typegen. DO NOT EDIT.
+
+//go:build !nofastssz
+
+package types
+
+import fssz "github.com/ferranbt/fastssz"
+
+var _ fssz.HashRoot = (ForkDigest{})
+var _ fssz.Marshaler = (*ForkDigest)(nil)
+var _ fssz.Unmarshaler = (*ForkDigest)(nil)
+
+// HashTreeRootWith appends fork digest to the provided hasher.
+func (d ForkDigest) HashTreeRootWith(hh *fssz.Hasher) error {
+	hh.PutBytes(d[:])
+	return nil
+}
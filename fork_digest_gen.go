@@ -0,0 +1,82 @@
+// Code generated by typegen. DO NOT EDIT.
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// NewForkDigest copies b into ForkDigest, returns an error if b is not exactly 4 bytes long.
+func NewForkDigest(b []byte) (ForkDigest, error) {
+	var v ForkDigest
+	if len(b) != len(v) {
+		return v, fmt.Errorf("%w: expected 4 bytes, got %d", ErrInvalidBytesLength, len(b))
+	}
+	copy(v[:], b)
+	return v, nil
+}
+
+// Equal returns true if both values hold the same bytes.
+func (d ForkDigest) Equal(x ForkDigest) bool {
+	return d == x
+}
+
+// IsZero returns true if all bytes of the fork digest are zero.
+func (d ForkDigest) IsZero() bool {
+	return d == ForkDigest{}
+}
+
+// String returns 0x-prefixed hex representation of the fork digest.
+func (d ForkDigest) String() string {
+	return string(appendHex(nil, d[:]))
+}
+
+// MarshalText encodes fork digest as 0x-prefixed hex string.
+func (d ForkDigest) MarshalText() ([]byte, error) {
+	return appendHex(make([]byte, 0, 10), d[:]), nil
+}
+
+// UnmarshalText decodes fork digest from 0x-prefixed hex string.
+func (d *ForkDigest) UnmarshalText(text []byte) error {
+	return decodeHexInto(d[:], text)
+}
+
+// HashTreeRoot returns calculated hash root (fork digest right-padded to 32 bytes).
+func (d ForkDigest) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	copy(root[:], d[:])
+	return root, nil
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (d ForkDigest) HashTreeRootInto(dst *[32]byte) error {
+	*dst = [32]byte{}
+	copy(dst[:], d[:])
+	return nil
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the fork digest object.
+func (d *ForkDigest) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), d.SizeSSZ()); err != nil {
+		return err
+	}
+	copy(d[:], buf)
+	return nil
+}
+
+// MarshalSSZTo marshals fork digest with the provided byte slice.
+func (d *ForkDigest) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return append(dst, d[:]...), nil
+}
+
+// MarshalSSZ marshals fork digest into a serialized object.
+func (d *ForkDigest) MarshalSSZ() ([]byte, error) {
+	return append(make([]byte, 0, 4), d[:]...), nil
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (d *ForkDigest) SizeSSZ() int {
+	return 4
+}
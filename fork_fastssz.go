@@ -0,0 +1,19 @@
+//go:build !nofastssz
+
+package types
+
+import fssz "github.com/ferranbt/fastssz"
+
+var _ fssz.HashRoot = (*Fork)(nil)
+var _ fssz.Marshaler = (*Fork)(nil)
+var _ fssz.Unmarshaler = (*Fork)(nil)
+
+// HashTreeRootWith appends fork root to the provided hasher.
+func (f *Fork) HashTreeRootWith(hh *fssz.Hasher) error {
+	root, err := f.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	hh.PutBytes(root[:])
+	return nil
+}
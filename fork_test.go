@@ -0,0 +1,36 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFork_SSZ(t *testing.T) {
+	f := &Fork{PreviousVersion: ForkVersion{1}, CurrentVersion: ForkVersion{2}, Epoch: 74240}
+	enc, err := f.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := &Fork{}
+	if err := decoded.UnmarshalSSZ(enc); err != nil {
+		t.Fatal(err)
+	}
+	if *decoded != *f {
+		t.Errorf("Unexpected fork: %+v", decoded)
+	}
+}
+
+func TestFork_JSON(t *testing.T) {
+	input := `{"previous_version":"0x01000000","current_version":"0x02000000","epoch":"74240"}`
+	var f Fork
+	if err := json.Unmarshal([]byte(input), &f); err != nil {
+		t.Fatal(err)
+	}
+	enc, err := json.Marshal(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(enc) != input {
+		t.Errorf("Unexpected JSON: %s", enc)
+	}
+}
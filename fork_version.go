@@ -0,0 +1,4 @@
+package types
+
+// ForkVersion represents a 4 byte fork version.
+type ForkVersion Bytes4
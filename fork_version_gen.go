@@ -0,0 +1,82 @@
+// Code generated by typegen. DO NOT EDIT.
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// NewForkVersion copies b into ForkVersion, returns an error if b is not exactly 4 bytes long.
+func NewForkVersion(b []byte) (ForkVersion, error) {
+	var v ForkVersion
+	if len(b) != len(v) {
+		return v, fmt.Errorf("%w: expected 4 bytes, got %d", ErrInvalidBytesLength, len(b))
+	}
+	copy(v[:], b)
+	return v, nil
+}
+
+// Equal returns true if both values hold the same bytes.
+func (v ForkVersion) Equal(x ForkVersion) bool {
+	return v == x
+}
+
+// IsZero returns true if all bytes of the fork version are zero.
+func (v ForkVersion) IsZero() bool {
+	return v == ForkVersion{}
+}
+
+// String returns 0x-prefixed hex representation of the fork version.
+func (v ForkVersion) String() string {
+	return string(appendHex(nil, v[:]))
+}
+
+// MarshalText encodes fork version as 0x-prefixed hex string.
+func (v ForkVersion) MarshalText() ([]byte, error) {
+	return appendHex(make([]byte, 0, 10), v[:]), nil
+}
+
+// UnmarshalText decodes fork version from 0x-prefixed hex string.
+func (v *ForkVersion) UnmarshalText(text []byte) error {
+	return decodeHexInto(v[:], text)
+}
+
+// HashTreeRoot returns calculated hash root (fork version right-padded to 32 bytes).
+func (v ForkVersion) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	copy(root[:], v[:])
+	return root, nil
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (v ForkVersion) HashTreeRootInto(dst *[32]byte) error {
+	*dst = [32]byte{}
+	copy(dst[:], v[:])
+	return nil
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the fork version object.
+func (v *ForkVersion) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), v.SizeSSZ()); err != nil {
+		return err
+	}
+	copy(v[:], buf)
+	return nil
+}
+
+// MarshalSSZTo marshals fork version with the provided byte slice.
+func (v *ForkVersion) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return append(dst, v[:]...), nil
+}
+
+// MarshalSSZ marshals fork version into a serialized object.
+func (v *ForkVersion) MarshalSSZ() ([]byte, error) {
+	return append(make([]byte, 0, 4), v[:]...), nil
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (v *ForkVersion) SizeSSZ() int {
+	return 4
+}
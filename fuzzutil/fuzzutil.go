@@ -0,0 +1,93 @@
+// Package fuzzutil contains helpers for writing native fuzz tests against shared types: deriving
+// typed values from fuzzer byte streams, building seed corpora and asserting encoding round-trips.
+package fuzzutil
+
+import (
+	"encoding/binary"
+	"math"
+	"testing"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+// FromFuzzBytes derives value from the first 8 bytes of data (little-endian, zero-padded if data is shorter).
+func FromFuzzBytes[T types.Uint64Like](data []byte) T {
+	var buf [8]byte
+	copy(buf[:], data)
+	return T(binary.LittleEndian.Uint64(buf[:]))
+}
+
+// Consumer sequentially derives typed values from fuzzer provided bytes.
+// Once data is exhausted, zero values are returned.
+type Consumer struct {
+	data []byte
+}
+
+// NewConsumer returns consumer reading from data.
+func NewConsumer(data []byte) *Consumer {
+	return &Consumer{data: data}
+}
+
+// Len returns number of bytes not yet consumed.
+func (c *Consumer) Len() int {
+	return len(c.data)
+}
+
+// Uint64 consumes next 8 bytes.
+func (c *Consumer) Uint64() uint64 {
+	return FromFuzzBytes[uint64](c.next(8))
+}
+
+// Slot consumes next 8 bytes as slot.
+func (c *Consumer) Slot() types.Slot {
+	return FromFuzzBytes[types.Slot](c.next(8))
+}
+
+// Epoch consumes next 8 bytes as epoch.
+func (c *Consumer) Epoch() types.Epoch {
+	return FromFuzzBytes[types.Epoch](c.next(8))
+}
+
+// ValidatorIndex consumes next 8 bytes as validator index.
+func (c *Consumer) ValidatorIndex() types.ValidatorIndex {
+	return FromFuzzBytes[types.ValidatorIndex](c.next(8))
+}
+
+// Gwei consumes next 8 bytes as gwei amount.
+func (c *Consumer) Gwei() types.Gwei {
+	return FromFuzzBytes[types.Gwei](c.next(8))
+}
+
+// Root consumes next 32 bytes as root.
+func (c *Consumer) Root() types.Root {
+	var root types.Root
+	copy(root[:], c.next(32))
+	return root
+}
+
+// next consumes up to n bytes.
+func (c *Consumer) next(n int) []byte {
+	if n > len(c.data) {
+		n = len(c.data)
+	}
+	res := c.data[:n]
+	c.data = c.data[n:]
+	return res
+}
+
+// BoundaryValues lists values worth seeding every corpus with.
+var BoundaryValues = []uint64{0, 1, 2, 31, 32, 33, math.MaxUint32, math.MaxUint64 - 1, math.MaxUint64}
+
+// AddSeedValues adds 8-byte little-endian encodings of values to the seed corpus of f.
+func AddSeedValues[T types.Uint64Like](f *testing.F, values ...T) {
+	for _, v := range values {
+		var buf [8]byte
+		binary.LittleEndian.PutUint64(buf[:], uint64(v))
+		f.Add(buf[:])
+	}
+}
+
+// AddBoundarySeeds adds encodings of BoundaryValues to the seed corpus of f.
+func AddBoundarySeeds(f *testing.F) {
+	AddSeedValues(f, BoundaryValues...)
+}
@@ -0,0 +1,45 @@
+package fuzzutil
+
+import (
+	"testing"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+func TestConsumer(t *testing.T) {
+	data := []byte{
+		0x01, 0, 0, 0, 0, 0, 0, 0,
+		0x02, 0, 0, 0, 0, 0, 0, 0,
+		0xaa, 0xbb,
+	}
+	c := NewConsumer(data)
+	if s := c.Slot(); s != 1 {
+		t.Errorf("Unexpected slot: %v", s)
+	}
+	if e := c.Epoch(); e != 2 {
+		t.Errorf("Unexpected epoch: %v", e)
+	}
+	if r := c.Root(); r != (types.Root{0xaa, 0xbb}) {
+		t.Errorf("Unexpected root: %#x", r)
+	}
+	if c.Len() != 0 || c.Gwei() != 0 {
+		t.Error("Exhausted consumer should produce zero values")
+	}
+}
+
+func FuzzSlot_RoundTrip(f *testing.F) {
+	AddBoundarySeeds(f)
+	f.Fuzz(func(t *testing.T, data []byte) {
+		s := FromFuzzBytes[types.Slot](data)
+		AssertSSZRoundTrip(t, s)
+		AssertJSONRoundTrip(t, s)
+		AssertTextRoundTrip(t, s)
+	})
+}
+
+func FuzzRoot_RoundTrip(f *testing.F) {
+	f.Add(make([]byte, 32))
+	f.Fuzz(func(t *testing.T, data []byte) {
+		AssertSSZRoundTrip(t, NewConsumer(data).Root())
+	})
+}
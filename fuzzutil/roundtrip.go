@@ -0,0 +1,69 @@
+package fuzzutil
+
+import (
+	"encoding"
+	"encoding/json"
+	"testing"
+)
+
+// sszCodec is implemented by pointers to SSZ serializable types.
+type sszCodec[T any] interface {
+	*T
+	MarshalSSZ() ([]byte, error)
+	UnmarshalSSZ(buf []byte) error
+}
+
+// textCodec is implemented by pointers to text serializable types.
+type textCodec[T any] interface {
+	*T
+	encoding.TextMarshaler
+	encoding.TextUnmarshaler
+}
+
+// AssertSSZRoundTrip checks that v is unchanged after SSZ encoding and decoding.
+func AssertSSZRoundTrip[T comparable, PT sszCodec[T]](t testing.TB, v T) {
+	t.Helper()
+	enc, err := PT(&v).MarshalSSZ()
+	if err != nil {
+		t.Fatalf("could not marshal %v: %v", v, err)
+	}
+	var decoded T
+	if err := PT(&decoded).UnmarshalSSZ(enc); err != nil {
+		t.Fatalf("could not unmarshal %#x: %v", enc, err)
+	}
+	if decoded != v {
+		t.Fatalf("SSZ round-trip mismatch: %v != %v", decoded, v)
+	}
+}
+
+// AssertJSONRoundTrip checks that v is unchanged after JSON encoding and decoding.
+func AssertJSONRoundTrip[T comparable](t testing.TB, v T) {
+	t.Helper()
+	enc, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("could not marshal %v: %v", v, err)
+	}
+	var decoded T
+	if err := json.Unmarshal(enc, &decoded); err != nil {
+		t.Fatalf("could not unmarshal %s: %v", enc, err)
+	}
+	if decoded != v {
+		t.Fatalf("JSON round-trip mismatch: %v != %v", decoded, v)
+	}
+}
+
+// AssertTextRoundTrip checks that v is unchanged after text encoding and decoding.
+func AssertTextRoundTrip[T comparable, PT textCodec[T]](t testing.TB, v T) {
+	t.Helper()
+	enc, err := PT(&v).MarshalText()
+	if err != nil {
+		t.Fatalf("could not marshal %v: %v", v, err)
+	}
+	var decoded T
+	if err := PT(&decoded).UnmarshalText(enc); err != nil {
+		t.Fatalf("could not unmarshal %q: %v", enc, err)
+	}
+	if decoded != v {
+		t.Fatalf("text round-trip mismatch: %v != %v", decoded, v)
+	}
+}
@@ -0,0 +1,113 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Execution layer gas limit constraints.
+const (
+	// MinGasLimit is the minimum gas limit of an execution block.
+	MinGasLimit = GasLimit(5000)
+	// GasLimitBoundDivisor bounds gas limit change between consecutive blocks to parent/1024.
+	GasLimitBoundDivisor = 1024
+)
+
+// ErrInvalidGasLimit is returned when gas limit cannot be parsed or violates block constraints.
+var ErrInvalidGasLimit = errors.New("invalid gas limit")
+
+// GasLimit represents execution block gas limit. It is encoded as a quoted decimal string in JSON,
+// while decoding also accepts quoted 0x-prefixed hex (as used by the execution engine API) and
+// JSON numbers.
+type GasLimit uint64
+
+// ParseGasLimit parses either decimal or 0x-prefixed hex representation of the gas limit.
+func ParseGasLimit(s string) (GasLimit, error) {
+	var v uint64
+	var err error
+	if hex := strings.TrimPrefix(s, "0x"); len(hex) != len(s) {
+		v, err = strconv.ParseUint(hex, 16, 64)
+	} else {
+		v, err = strconv.ParseUint(s, 10, 64)
+	}
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidGasLimit, s)
+	}
+	return GasLimit(v), nil
+}
+
+// ValidateAgainstParent checks that gas limit of a block is allowed given its parent's gas limit:
+// it must not be below MinGasLimit and must differ from the parent's by less than parent/1024.
+func (g GasLimit) ValidateAgainstParent(parent GasLimit) error {
+	if g < MinGasLimit {
+		return fmt.Errorf("%w: %d is below minimum %d", ErrInvalidGasLimit, g, MinGasLimit)
+	}
+	diff := g - parent
+	if g < parent {
+		diff = parent - g
+	}
+	if diff >= parent/GasLimitBoundDivisor {
+		return fmt.Errorf("%w: %d differs from parent %d by more than allowed %d", ErrInvalidGasLimit,
+			g, parent, parent/GasLimitBoundDivisor-1)
+	}
+	return nil
+}
+
+// Toward returns gas limit of a child block moving from g (parent's gas limit) toward the target as
+// far as allowed by ValidateAgainstParent.
+func (g GasLimit) Toward(target GasLimit) GasLimit {
+	delta := g / GasLimitBoundDivisor
+	if delta > 0 {
+		delta--
+	}
+	if target < MinGasLimit {
+		target = MinGasLimit
+	}
+	switch {
+	case target > g:
+		if target-g > delta {
+			return g + delta
+		}
+	case g-target > delta:
+		return g - delta
+	}
+	return target
+}
+
+// String returns decimal representation of the gas limit.
+func (g GasLimit) String() string {
+	return strconv.FormatUint(uint64(g), 10)
+}
+
+// MarshalText encodes gas limit as a decimal string.
+func (g GasLimit) MarshalText() ([]byte, error) {
+	return strconv.AppendUint(nil, uint64(g), 10), nil
+}
+
+// UnmarshalText decodes gas limit from either a decimal or 0x-prefixed hex string.
+func (g *GasLimit) UnmarshalText(data []byte) error {
+	parsed, err := ParseGasLimit(string(data))
+	if err != nil {
+		return err
+	}
+	*g = parsed
+	return nil
+}
+
+// MarshalJSON encodes gas limit as a quoted decimal string.
+func (g GasLimit) MarshalJSON() ([]byte, error) {
+	data := make([]byte, 0, 22)
+	data = append(data, '"')
+	data = strconv.AppendUint(data, uint64(g), 10)
+	return append(data, '"'), nil
+}
+
+// UnmarshalJSON decodes gas limit from a quoted decimal or hex string, or a JSON number.
+func (g *GasLimit) UnmarshalJSON(data []byte) error {
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		data = data[1 : len(data)-1]
+	}
+	return g.UnmarshalText(data)
+}
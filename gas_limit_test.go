@@ -0,0 +1,74 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestGasLimit_JSON(t *testing.T) {
+	for _, input := range []string{`"30000000"`, `"0x1c9c380"`, `30000000`} {
+		var g GasLimit
+		if err := json.Unmarshal([]byte(input), &g); err != nil {
+			t.Fatal(err)
+		}
+		if g != 30_000_000 {
+			t.Errorf("Unexpected gas limit decoded from %s: %d", input, g)
+		}
+	}
+	for _, input := range []string{`""`, `"0x"`, `"-1"`, `"0xzz"`, `"1e6"`} {
+		var g GasLimit
+		if err := json.Unmarshal([]byte(input), &g); !errors.Is(err, ErrInvalidGasLimit) {
+			t.Errorf("Expected error for %s, got %v", input, err)
+		}
+	}
+	enc, err := json.Marshal(GasLimit(30_000_000))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(enc) != `"30000000"` {
+		t.Errorf("Unexpected JSON: %s", enc)
+	}
+}
+
+func TestGasLimit_ValidateAgainstParent(t *testing.T) {
+	parent := GasLimit(30_000_000) // allowed delta is 29295
+	tests := []struct {
+		gasLimit GasLimit
+		valid    bool
+	}{
+		{30_000_000, true},
+		{30_029_295, true},
+		{30_029_296, false},
+		{29_970_705, true},
+		{29_970_704, false},
+		{4999, false},
+	}
+	for _, tt := range tests {
+		if err := tt.gasLimit.ValidateAgainstParent(parent); (err == nil) != tt.valid {
+			t.Errorf("Unexpected validation result for %d: %v", tt.gasLimit, err)
+		}
+	}
+}
+
+func TestGasLimit_Toward(t *testing.T) {
+	parent := GasLimit(30_000_000)
+	tests := []struct {
+		target, want GasLimit
+	}{
+		{30_000_000, 30_000_000},
+		{30_010_000, 30_010_000},
+		{36_000_000, 30_029_295},
+		{20_000_000, 29_970_705},
+		{0, 29_970_705},
+	}
+	for _, tt := range tests {
+		got := parent.Toward(tt.target)
+		if got != tt.want {
+			t.Errorf("Unexpected gas limit toward %d: %d, want %d", tt.target, got, tt.want)
+		}
+		if err := got.ValidateAgainstParent(parent); err != nil {
+			t.Errorf("Unexpected error: %v", err)
+		}
+	}
+}
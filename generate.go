@@ -0,0 +1,25 @@
+package types
+
+//go:generate go run ./cmd/typegen -type Slot -farfuture
+//go:generate go run ./cmd/typegen -type Epoch -farfuture
+//go:generate go run ./cmd/typegen -type ValidatorIndex
+//go:generate go run ./cmd/typegen -type Gwei -nostring
+//go:generate go run ./cmd/typegen -type CommitteeIndex
+//go:generate go run ./cmd/typegen -type SyncCommitteeIndex
+//go:generate go run ./cmd/typegen -type SubnetID
+//go:generate go run ./cmd/typegen -type WithdrawalIndex -recv wi
+//go:generate go run ./cmd/typegen -type Bytes4 -bytes 4 -name "byte vector"
+//go:generate go run ./cmd/typegen -type Bytes8 -bytes 8 -name "byte vector"
+//go:generate go run ./cmd/typegen -type Bytes20 -bytes 20 -name "byte vector"
+//go:generate go run ./cmd/typegen -type Bytes32 -bytes 32 -name "byte vector"
+//go:generate go run ./cmd/typegen -type Bytes48 -bytes 48 -name "byte vector"
+//go:generate go run ./cmd/typegen -type Bytes96 -bytes 96 -name "byte vector"
+//go:generate go run ./cmd/typegen -type Root -bytes 32
+//go:generate go run ./cmd/typegen -type Domain -bytes 32
+//go:generate go run ./cmd/typegen -type NodeID -bytes 32 -recv id
+//go:generate go run ./cmd/typegen -type BLSPubkey -bytes 48 -recv p -name "public key"
+//go:generate go run ./cmd/typegen -type BLSSignature -bytes 96 -recv s -name signature
+//go:generate go run ./cmd/typegen -type ExecutionAddress -bytes 20 -recv a -name address
+//go:generate go run ./cmd/typegen -type ForkVersion -bytes 4 -recv v
+//go:generate go run ./cmd/typegen -type ForkDigest -bytes 4 -recv d
+//go:generate go run ./cmd/typegen -type DomainType -bytes 4 -recv d
@@ -0,0 +1,60 @@
+package types
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+func testGeneratedType[T interface {
+	Uint64Like
+	SafeAdd(x uint64) (T, error)
+	SafeSub(x uint64) (T, error)
+	SafeDiv(x uint64) (T, error)
+	String() string
+}](t *testing.T) {
+	maxValue := uint64(math.MaxUint64)
+	if _, err := T(maxValue - 1).SafeAdd(2); err == nil {
+		t.Error("Expected overflow error")
+	}
+	if _, err := T(1).SafeSub(2); err == nil {
+		t.Error("Expected underflow error")
+	}
+	if _, err := T(1).SafeDiv(0); err == nil {
+		t.Error("Expected division by zero error")
+	}
+	if v, err := T(40).SafeAdd(2); err != nil || v != 42 {
+		t.Errorf("Unexpected result: %v, %v", v, err)
+	}
+	if s := T(42).String(); s != "42" {
+		t.Errorf("Unexpected string: %s", s)
+	}
+
+	enc, err := json.Marshal(T(42))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(enc) != `"42"` {
+		t.Errorf("Unexpected JSON: %s", enc)
+	}
+	for _, input := range []string{`"42"`, `42`} {
+		var v T
+		if err := json.Unmarshal([]byte(input), &v); err != nil {
+			t.Fatal(err)
+		}
+		if v != 42 {
+			t.Errorf("Unexpected value decoded from %s: %v", input, v)
+		}
+	}
+	var v T
+	if err := json.Unmarshal([]byte(`"-1"`), &v); err == nil {
+		t.Error("Expected error on negative value")
+	}
+}
+
+func TestGeneratedTypes(t *testing.T) {
+	t.Run("Slot", testGeneratedType[Slot])
+	t.Run("Epoch", testGeneratedType[Epoch])
+	t.Run("ValidatorIndex", testGeneratedType[ValidatorIndex])
+	t.Run("WithdrawalIndex", testGeneratedType[WithdrawalIndex])
+}
@@ -0,0 +1,80 @@
+package types
+
+// List is SSZ list of uint64-backed values (balances, indices, inactivity scores etc), bounded by length limit.
+type List[T Uint64Like] struct {
+	values []T
+	limit  uint64
+}
+
+// NewList creates list with the given length limit, populated with values.
+func NewList[T Uint64Like](limit uint64, values ...T) (*List[T], error) {
+	if err := validateListLength(len(values), limit); err != nil {
+		return nil, err
+	}
+	return &List[T]{values: values, limit: limit}, nil
+}
+
+// Values returns underlying list elements.
+func (l *List[T]) Values() []T {
+	return l.values
+}
+
+// Limit returns maximum number of elements list can hold.
+func (l *List[T]) Limit() uint64 {
+	return l.limit
+}
+
+// Len returns number of elements in the list.
+func (l *List[T]) Len() int {
+	return len(l.values)
+}
+
+// Append adds values to the end of the list, returns an error if limit is exceeded.
+func (l *List[T]) Append(values ...T) error {
+	if err := validateListLength(len(l.values)+len(values), l.limit); err != nil {
+		return err
+	}
+	l.values = append(l.values, values...)
+	return nil
+}
+
+// HashTreeRoot returns calculated hash root.
+func (l *List[T]) HashTreeRoot() ([32]byte, error) {
+	return hashTreeRootUint64List(l.values, l.limit)
+}
+
+// HashTreeRootParallel is HashTreeRoot splitting hashing across the given number of workers.
+// Worthwhile for lists of hundreds of thousands of elements (balances, inactivity scores) and
+// multiple available CPUs only, otherwise the coordination overhead makes it slower than HashTreeRoot.
+func (l *List[T]) HashTreeRootParallel(workers int) ([32]byte, error) {
+	return hashTreeRootUint64ListParallel(l.values, l.limit, workers)
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the list, limit of the list is enforced
+// (so zero value list, having zero limit, accepts empty buffer only).
+func (l *List[T]) UnmarshalSSZ(buf []byte) error {
+	if err := validateListLength(len(buf)/8, l.limit); err != nil {
+		return err
+	}
+	values, err := unmarshalUint64List[T](buf)
+	if err != nil {
+		return err
+	}
+	l.values = values
+	return nil
+}
+
+// MarshalSSZTo marshals list with the provided byte slice.
+func (l *List[T]) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return marshalUint64List(dst, l.values), nil
+}
+
+// MarshalSSZ marshals list into a serialized object.
+func (l *List[T]) MarshalSSZ() ([]byte, error) {
+	return l.MarshalSSZTo(make([]byte, 0, l.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (l *List[T]) SizeSSZ() int {
+	return len(l.values) * 8
+}
@@ -0,0 +1,19 @@
+//go:build !nofastssz
+
+package types
+
+import fssz "github.com/ferranbt/fastssz"
+
+var _ fssz.HashRoot = (*List[Slot])(nil)
+var _ fssz.Marshaler = (*List[Slot])(nil)
+var _ fssz.Unmarshaler = (*List[Slot])(nil)
+
+// HashTreeRootWith appends list root to the provided hasher.
+func (l *List[T]) HashTreeRootWith(hh *fssz.Hasher) error {
+	root, err := l.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	hh.PutBytes(root[:])
+	return nil
+}
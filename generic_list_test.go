@@ -0,0 +1,62 @@
+package types
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestList(t *testing.T) {
+	if _, err := NewList[ValidatorIndex](2, 1, 2, 3); !errors.Is(err, ErrListTooLong) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	list, err := NewList[ValidatorIndex](4, 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := list.Append(3, 4); err != nil {
+		t.Fatal(err)
+	}
+	if err := list.Append(5); !errors.Is(err, ErrListTooLong) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if list.Len() != 4 || list.Limit() != 4 {
+		t.Errorf("Unexpected list length or limit: %d, %d", list.Len(), list.Limit())
+	}
+
+	enc, err := list.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := NewList[ValidatorIndex](4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := decoded.UnmarshalSSZ(enc); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded.Values(), list.Values()) {
+		t.Errorf("Unexpected values: %v", decoded.Values())
+	}
+
+	short, err := NewList[ValidatorIndex](3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := short.UnmarshalSSZ(enc); !errors.Is(err, ErrListTooLong) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	got, err := list.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := hashTreeRootUint64List([]Slot{1, 2, 3, 4}, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("Unexpected root: %#x, want %#x", got, want)
+	}
+}
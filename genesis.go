@@ -0,0 +1,69 @@
+package types
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// Genesis holds chain genesis parameters, required by the clock, fork digest and signing domain helpers.
+type Genesis struct {
+	GenesisTime           uint64      `json:"genesis_time,string"`
+	GenesisValidatorsRoot Root        `json:"genesis_validators_root"`
+	GenesisForkVersion    ForkVersion `json:"genesis_fork_version"`
+}
+
+// HashTreeRoot returns calculated hash root.
+func (g *Genesis) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	err := g.HashTreeRootInto(&root)
+	return root, err
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (g *Genesis) HashTreeRootInto(dst *[32]byte) error {
+	var chunks [3][32]byte
+	binary.LittleEndian.PutUint64(chunks[0][:8], g.GenesisTime)
+	chunks[1] = g.GenesisValidatorsRoot
+	_ = g.GenesisForkVersion.HashTreeRootInto(&chunks[2])
+	return merkleizeInto(dst, chunks[:], 0)
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the genesis object.
+func (g *Genesis) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), g.SizeSSZ()); err != nil {
+		return err
+	}
+	g.GenesisTime = binary.LittleEndian.Uint64(buf[:8])
+	copy(g.GenesisValidatorsRoot[:], buf[8:40])
+	copy(g.GenesisForkVersion[:], buf[40:44])
+	return nil
+}
+
+// MarshalSSZTo marshals genesis with the provided byte slice.
+func (g *Genesis) MarshalSSZTo(dst []byte) ([]byte, error) {
+	dst = sszutil.AppendUint64(dst, g.GenesisTime)
+	dst = append(dst, g.GenesisValidatorsRoot[:]...)
+	return append(dst, g.GenesisForkVersion[:]...), nil
+}
+
+// MarshalSSZ marshals genesis into a serialized object.
+func (g *Genesis) MarshalSSZ() ([]byte, error) {
+	return g.MarshalSSZTo(make([]byte, 0, g.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (g *Genesis) SizeSSZ() int {
+	return 44
+}
+
+// WriteTo writes SSZ serialized genesis to w.
+func (g *Genesis) WriteTo(w io.Writer) (int64, error) {
+	return writeSSZTo(w, g)
+}
+
+// ReadFrom reads SSZ serialized genesis from reader (exactly SizeSSZ bytes are consumed).
+func (g *Genesis) ReadFrom(reader io.Reader) (int64, error) {
+	return readSSZFrom(reader, g)
+}
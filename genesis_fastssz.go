@@ -0,0 +1,19 @@
+//go:build !nofastssz
+
+package types
+
+import fssz "github.com/ferranbt/fastssz"
+
+var _ fssz.HashRoot = (*Genesis)(nil)
+var _ fssz.Marshaler = (*Genesis)(nil)
+var _ fssz.Unmarshaler = (*Genesis)(nil)
+
+// HashTreeRootWith appends genesis root to the provided hasher.
+func (g *Genesis) HashTreeRootWith(hh *fssz.Hasher) error {
+	root, err := g.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	hh.PutBytes(root[:])
+	return nil
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestGenesis_SSZ(t *testing.T) {
+	g := &Genesis{GenesisTime: 1606824023, GenesisValidatorsRoot: Root{0x4b, 0x36}, GenesisForkVersion: ForkVersion{0, 0, 0, 1}}
+	enc, err := g.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(enc) != g.SizeSSZ() {
+		t.Fatalf("Unexpected size: %d", len(enc))
+	}
+	decoded := &Genesis{}
+	if err := decoded.UnmarshalSSZ(enc); err != nil {
+		t.Fatal(err)
+	}
+	if *decoded != *g {
+		t.Errorf("Unexpected genesis: %+v", decoded)
+	}
+	if err := decoded.UnmarshalSSZ(enc[1:]); err == nil {
+		t.Error("Expected error for short buffer")
+	}
+}
+
+func TestGenesis_JSON(t *testing.T) {
+	input := `{"genesis_time":"1606824023","genesis_validators_root":"0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95","genesis_fork_version":"0x00000000"}`
+	var g Genesis
+	if err := json.Unmarshal([]byte(input), &g); err != nil {
+		t.Fatal(err)
+	}
+	if g.GenesisTime != 1606824023 || g.GenesisValidatorsRoot[0] != 0x4b {
+		t.Errorf("Unexpected genesis: %+v", g)
+	}
+	enc, err := json.Marshal(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(enc) != input {
+		t.Errorf("Unexpected JSON: %s", enc)
+	}
+}
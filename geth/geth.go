@@ -0,0 +1,53 @@
+// Package geth converts between shared types and go-ethereum types (common.Hash, common.Address,
+// hexutil.Uint64).
+//
+// The package is a separate module, so that go-ethereum is only pulled in by consumers that
+// actually talk to the execution layer.
+package geth
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+// HashFromRoot converts root into common.Hash.
+func HashFromRoot(root types.Root) common.Hash {
+	return common.Hash(root)
+}
+
+// RootFromHash converts common.Hash into root.
+func RootFromHash(hash common.Hash) types.Root {
+	return types.Root(hash)
+}
+
+// AddressFromExecutionAddress converts execution address into common.Address.
+func AddressFromExecutionAddress(addr types.ExecutionAddress) common.Address {
+	return common.Address(addr)
+}
+
+// ExecutionAddressFromAddress converts common.Address into execution address.
+func ExecutionAddressFromAddress(addr common.Address) types.ExecutionAddress {
+	return types.ExecutionAddress(addr)
+}
+
+// Uint64FromSlot converts slot into hexutil.Uint64.
+func Uint64FromSlot(slot types.Slot) hexutil.Uint64 {
+	return hexutil.Uint64(slot)
+}
+
+// SlotFromUint64 converts hexutil.Uint64 into slot.
+func SlotFromUint64(v hexutil.Uint64) types.Slot {
+	return types.Slot(v)
+}
+
+// Uint64FromEpoch converts epoch into hexutil.Uint64.
+func Uint64FromEpoch(epoch types.Epoch) hexutil.Uint64 {
+	return hexutil.Uint64(epoch)
+}
+
+// EpochFromUint64 converts hexutil.Uint64 into epoch.
+func EpochFromUint64(v hexutil.Uint64) types.Epoch {
+	return types.Epoch(v)
+}
@@ -0,0 +1,41 @@
+package geth
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+func TestConversions(t *testing.T) {
+	root := types.Root{0x01, 31: 0xff}
+	hash := HashFromRoot(root)
+	if want := common.HexToHash("0x01000000000000000000000000000000000000000000000000000000000000ff"); hash != want {
+		t.Errorf("Unexpected hash: %s, want %s", hash, want)
+	}
+	if RootFromHash(hash) != root {
+		t.Errorf("Unexpected root: %#x", RootFromHash(hash))
+	}
+
+	gethAddr := common.HexToAddress("0xfe00000000000000000000000000000000000001")
+	addr := ExecutionAddressFromAddress(gethAddr)
+	if addr != (types.ExecutionAddress{0xfe, 19: 0x01}) {
+		t.Errorf("Unexpected address: %#x", addr)
+	}
+	if AddressFromExecutionAddress(addr) != gethAddr {
+		t.Errorf("Unexpected address: %s", AddressFromExecutionAddress(addr))
+	}
+
+	if v := Uint64FromSlot(42); v.String() != "0x2a" || SlotFromUint64(v) != 42 {
+		t.Errorf("Unexpected slot: %s", v)
+	}
+	var v hexutil.Uint64
+	if err := v.UnmarshalText([]byte("0x7")); err != nil {
+		t.Fatal(err)
+	}
+	if EpochFromUint64(v) != 7 || Uint64FromEpoch(7) != v {
+		t.Errorf("Unexpected epoch: %d", EpochFromUint64(v))
+	}
+}
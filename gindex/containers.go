@@ -0,0 +1,56 @@
+package gindex
+
+// Layouts of containers defined in the types package.
+var (
+	Checkpoint = NewContainer("Checkpoint", leaf("epoch"), leaf("root"))
+
+	AttestationData = NewContainer("AttestationData",
+		leaf("slot"), leaf("index"), leaf("beacon_block_root"),
+		Field{Name: "source", Container: Checkpoint}, Field{Name: "target", Container: Checkpoint})
+
+	BeaconBlockHeader = NewContainer("BeaconBlockHeader",
+		leaf("slot"), leaf("proposer_index"), leaf("parent_root"), leaf("state_root"), leaf("body_root"))
+
+	SignedBeaconBlockHeader = NewContainer("SignedBeaconBlockHeader",
+		Field{Name: "message", Container: BeaconBlockHeader}, leaf("signature"))
+
+	Eth1Data = NewContainer("Eth1Data", leaf("deposit_root"), leaf("deposit_count"), leaf("block_hash"))
+
+	Fork = NewContainer("Fork", leaf("previous_version"), leaf("current_version"), leaf("epoch"))
+
+	FinalityCheckpoints = NewContainer("FinalityCheckpoints",
+		Field{Name: "previous_justified", Container: Checkpoint},
+		Field{Name: "current_justified", Container: Checkpoint},
+		Field{Name: "finalized", Container: Checkpoint})
+
+	VoluntaryExit = NewContainer("VoluntaryExit", leaf("epoch"), leaf("validator_index"))
+
+	SignedVoluntaryExit = NewContainer("SignedVoluntaryExit",
+		Field{Name: "message", Container: VoluntaryExit}, leaf("signature"))
+
+	DepositMessage = NewContainer("DepositMessage", leaf("pubkey"), leaf("withdrawal_credentials"), leaf("amount"))
+
+	DepositData = NewContainer("DepositData",
+		leaf("pubkey"), leaf("withdrawal_credentials"), leaf("amount"), leaf("signature"))
+
+	Withdrawal = NewContainer("Withdrawal", leaf("index"), leaf("validator_index"), leaf("address"), leaf("amount"))
+
+	BLSToExecutionChange = NewContainer("BLSToExecutionChange",
+		leaf("validator_index"), leaf("from_bls_pubkey"), leaf("to_execution_address"))
+
+	SignedBLSToExecutionChange = NewContainer("SignedBLSToExecutionChange",
+		Field{Name: "message", Container: BLSToExecutionChange}, leaf("signature"))
+
+	SyncAggregate = NewContainer("SyncAggregate", leaf("sync_committee_bits"), leaf("sync_committee_signature"))
+
+	ValidatorRegistration = NewContainer("ValidatorRegistration",
+		leaf("fee_recipient"), leaf("gas_limit"), leaf("timestamp"), leaf("pubkey"))
+
+	Status = NewContainer("Status",
+		leaf("fork_digest"), leaf("finalized_root"), leaf("finalized_epoch"), leaf("head_root"), leaf("head_slot"))
+)
+
+// leaf returns field of a basic (or otherwise opaque) type.
+func leaf(name string) Field {
+	return Field{Name: name}
+}
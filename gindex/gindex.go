@@ -0,0 +1,183 @@
+// Package gindex implements SSZ generalized indices: node positions in the merkle tree of an object,
+// with the root at index 1 and children of node i at indices 2i and 2i+1. Indices of the fields of
+// containers defined in the types package can be computed from their paths, e.g.
+//
+//	g, err := gindex.AttestationData.Lookup("target", "epoch") // 24
+//
+// which, together with branch helpers, is what proof generation and verification tooling needs.
+package gindex
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+	"strconv"
+	"strings"
+)
+
+var (
+	// ErrUnknownField is returned when path refers to a field which doesn't exist.
+	ErrUnknownField = errors.New("unknown container field")
+	// ErrInvalidIndex is returned when generalized index is zero (i.e. doesn't refer to any node), or
+	// when the resulting index doesn't fit into 64 bits.
+	ErrInvalidIndex = errors.New("invalid generalized index")
+)
+
+// GeneralizedIndex is position of a node in the merkle tree (1 is the root).
+type GeneralizedIndex uint64
+
+// Root is generalized index of the tree root.
+const Root = GeneralizedIndex(1)
+
+// Concat returns generalized index of a node given indices along the path to it, each relative to
+// the subtree rooted at the previous one (see spec's concat_generalized_indices).
+func Concat(indices ...GeneralizedIndex) (GeneralizedIndex, error) {
+	g := Root
+	for _, index := range indices {
+		if index == 0 {
+			return 0, fmt.Errorf("%w: zero index", ErrInvalidIndex)
+		}
+		depth := index.Depth()
+		if g.Depth()+depth > 63 {
+			return 0, fmt.Errorf("%w: depth exceeds 63", ErrInvalidIndex)
+		}
+		g = g<<depth | index&(1<<depth-1)
+	}
+	return g, nil
+}
+
+// Depth returns depth of the node, i.e. length of the branch proving it (see spec's
+// get_generalized_index_length).
+func (g GeneralizedIndex) Depth() int {
+	return bits.Len64(uint64(g)) - 1
+}
+
+// Position returns index of the node among nodes at its depth, counting from the left.
+func (g GeneralizedIndex) Position() uint64 {
+	return uint64(g) &^ (1 << g.Depth())
+}
+
+// Bit returns true if the i-th ancestor of the node (0 being the node itself) is a right child
+// (see spec's get_generalized_index_bit).
+func (g GeneralizedIndex) Bit(i int) bool {
+	return g>>i&1 == 1
+}
+
+// Parent returns index of the parent node.
+func (g GeneralizedIndex) Parent() GeneralizedIndex {
+	return g / 2
+}
+
+// Sibling returns index of the sibling node.
+func (g GeneralizedIndex) Sibling() GeneralizedIndex {
+	return g ^ 1
+}
+
+// Left returns index of the left child.
+func (g GeneralizedIndex) Left() GeneralizedIndex {
+	return g * 2
+}
+
+// Right returns index of the right child.
+func (g GeneralizedIndex) Right() GeneralizedIndex {
+	return g*2 + 1
+}
+
+// BranchIndices returns indices of the sibling nodes required to prove the node, from the leaf up
+// (see spec's get_branch_indices).
+func (g GeneralizedIndex) BranchIndices() []GeneralizedIndex {
+	indices := make([]GeneralizedIndex, 0, g.Depth())
+	for ; g > Root; g = g.Parent() {
+		indices = append(indices, g.Sibling())
+	}
+	return indices
+}
+
+// PathIndices returns indices of the nodes on the path from the node up to (excluding) the root
+// (see spec's get_path_indices).
+func (g GeneralizedIndex) PathIndices() []GeneralizedIndex {
+	indices := make([]GeneralizedIndex, 0, g.Depth())
+	for ; g > Root; g = g.Parent() {
+		indices = append(indices, g)
+	}
+	return indices
+}
+
+// String returns decimal representation of the index.
+func (g GeneralizedIndex) String() string {
+	return strconv.FormatUint(uint64(g), 10)
+}
+
+// Field describes container field: its name (as used in JSON) and, for nested containers, their layout.
+type Field struct {
+	Name      string
+	Container *Container
+}
+
+// Container describes merkle tree layout of an SSZ container.
+type Container struct {
+	name   string
+	fields []Field
+}
+
+// NewContainer returns layout of the container with the given fields (in declaration order).
+func NewContainer(name string, fields ...Field) *Container {
+	return &Container{name: name, fields: fields}
+}
+
+// Name returns container name.
+func (c *Container) Name() string {
+	return c.name
+}
+
+// Fields returns container fields.
+func (c *Container) Fields() []Field {
+	return c.fields
+}
+
+// Depth returns depth of the field subtree (number of fields rounded up to a power of two).
+func (c *Container) Depth() int {
+	if len(c.fields) <= 1 {
+		return 0
+	}
+	return bits.Len64(uint64(len(c.fields) - 1))
+}
+
+// FieldIndex returns generalized index of the field, relative to the container root.
+func (c *Container) FieldIndex(name string) (GeneralizedIndex, error) {
+	for i, f := range c.fields {
+		if f.Name == name {
+			return GeneralizedIndex(1)<<c.Depth() | GeneralizedIndex(i), nil
+		}
+	}
+	return 0, fmt.Errorf("%w: %s has no field %q", ErrUnknownField, c.name, name)
+}
+
+// Lookup returns generalized index of the field at the given path, relative to the container root,
+// e.g. Lookup("target", "epoch"). Empty path refers to the container itself.
+func (c *Container) Lookup(path ...string) (GeneralizedIndex, error) {
+	g := Root
+	current := c
+	for i, name := range path {
+		if current == nil {
+			return 0, fmt.Errorf("%w: %q is not a container", ErrUnknownField, strings.Join(path[:i], "."))
+		}
+		index, err := current.FieldIndex(name)
+		if err != nil {
+			return 0, err
+		}
+		if g, err = Concat(g, index); err != nil {
+			return 0, err
+		}
+		current = current.fields[index.Position()].Container
+	}
+	return g, nil
+}
+
+// LookupPath is Lookup for dot-separated path, e.g. "target.epoch".
+func (c *Container) LookupPath(path string) (GeneralizedIndex, error) {
+	if path == "" {
+		return Root, nil
+	}
+	return c.Lookup(strings.Split(path, ".")...)
+}
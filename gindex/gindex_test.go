@@ -0,0 +1,148 @@
+package gindex
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+func TestGeneralizedIndex(t *testing.T) {
+	g := GeneralizedIndex(13)
+	if g.Depth() != 3 || g.Position() != 5 || g.Parent() != 6 || g.Sibling() != 12 {
+		t.Errorf("Unexpected index properties of %v", g)
+	}
+	if g.Left() != 26 || g.Right() != 27 || !g.Bit(0) || g.Bit(1) {
+		t.Errorf("Unexpected children or bits of %v", g)
+	}
+	if got := g.BranchIndices(); !reflect.DeepEqual(got, []GeneralizedIndex{12, 7, 2}) {
+		t.Errorf("Unexpected branch indices: %v", got)
+	}
+	if got := g.PathIndices(); !reflect.DeepEqual(got, []GeneralizedIndex{13, 6, 3}) {
+		t.Errorf("Unexpected path indices: %v", got)
+	}
+	if got, err := Concat(12, 2); err != nil || got != 24 {
+		t.Errorf("Unexpected concatenation: %v (%v)", got, err)
+	}
+	if got, err := Concat(); err != nil || got != Root {
+		t.Errorf("Unexpected empty concatenation: %v (%v)", got, err)
+	}
+	if _, err := Concat(12, 0); !errors.Is(err, ErrInvalidIndex) {
+		t.Errorf("Expected error for zero index, got: %v", err)
+	}
+	if _, err := Concat(1<<40, 1<<30); !errors.Is(err, ErrInvalidIndex) {
+		t.Errorf("Expected error for overflowing index, got: %v", err)
+	}
+}
+
+func TestContainer_Lookup(t *testing.T) {
+	tests := []struct {
+		container *Container
+		path      string
+		want      GeneralizedIndex
+	}{
+		{AttestationData, "", 1},
+		{AttestationData, "slot", 8},
+		{AttestationData, "target", 12},
+		{AttestationData, "target.epoch", 24},
+		{AttestationData, "source.root", 23},
+		{Checkpoint, "root", 3},
+		{SignedBeaconBlockHeader, "message.state_root", 2*8 + 3},
+		{FinalityCheckpoints, "finalized.epoch", 12},
+		{SignedVoluntaryExit, "signature", 3},
+	}
+	for _, tt := range tests {
+		got, err := tt.container.LookupPath(tt.path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("Unexpected gindex of %s.%s: %v, want %v", tt.container.Name(), tt.path, got, tt.want)
+		}
+	}
+	for _, path := range []string{"unknown", "slot.epoch", "target.unknown"} {
+		if _, err := AttestationData.LookupPath(path); !errors.Is(err, ErrUnknownField) {
+			t.Errorf("Expected error for %q, got %v", path, err)
+		}
+	}
+}
+
+// TestContainer_MatchesTypes checks every layout against the type it describes: field names against
+// JSON names of the struct fields, and generalized indices against proofs built from the field
+// roots the type hashes.
+func TestContainer_MatchesTypes(t *testing.T) {
+	tests := []struct {
+		container *Container
+		obj       types.FieldProver
+	}{
+		{Checkpoint, &types.Checkpoint{}},
+		{AttestationData, &types.AttestationData{}},
+		{BeaconBlockHeader, &types.BeaconBlockHeader{}},
+		{SignedBeaconBlockHeader, &types.SignedBeaconBlockHeader{}},
+		{Eth1Data, &types.Eth1Data{}},
+		{Fork, &types.Fork{}},
+		{FinalityCheckpoints, &types.FinalityCheckpoints{}},
+		{VoluntaryExit, &types.VoluntaryExit{}},
+		{SignedVoluntaryExit, &types.SignedVoluntaryExit{}},
+		{DepositMessage, &types.DepositMessage{}},
+		{DepositData, &types.DepositData{}},
+		{Withdrawal, &types.Withdrawal{}},
+		{BLSToExecutionChange, &types.BLSToExecutionChange{}},
+		{SignedBLSToExecutionChange, &types.SignedBLSToExecutionChange{}},
+		{SyncAggregate, &types.SyncAggregate{}},
+		{ValidatorRegistration, &types.ValidatorRegistration{}},
+		{Status, &types.Status{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.container.Name(), func(t *testing.T) {
+			checkLayout(t, tt.container, tt.obj)
+		})
+	}
+}
+
+func checkLayout(t *testing.T, c *Container, obj types.FieldProver) {
+	v := reflect.ValueOf(obj).Elem()
+	if v.Type().Name() != c.Name() {
+		t.Errorf("Layout %s describes %s", c.Name(), v.Type().Name())
+	}
+	chunks, err := obj.AppendFieldRoots(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(chunks) != len(c.Fields()) {
+		t.Fatalf("%s has %d fields, layout has %d", v.Type().Name(), len(chunks), len(c.Fields()))
+	}
+	var exported []reflect.StructField
+	for i := 0; i < v.NumField(); i++ {
+		if f := v.Type().Field(i); f.PkgPath == "" {
+			exported = append(exported, f)
+		}
+	}
+	for i, f := range c.Fields() {
+		if i < len(exported) {
+			if name := strings.Split(exported[i].Tag.Get("json"), ",")[0]; name != f.Name {
+				t.Errorf("Field %d of %s is %q, layout has %q", i, c.Name(), name, f.Name)
+			}
+		}
+		proof, _, err := types.ProveField(obj, i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		g, err := c.Lookup(f.Name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if uint64(g) != proof.GIndex || g.Depth() != len(proof.Branch) {
+			t.Errorf("Unexpected gindex of %s.%s: %v, proof has %d", c.Name(), f.Name, g, proof.GIndex)
+		}
+		if f.Container != nil {
+			nested, ok := v.FieldByName(exported[i].Name).Addr().Interface().(types.FieldProver)
+			if !ok {
+				t.Fatalf("%s.%s is not a container", c.Name(), f.Name)
+			}
+			checkLayout(t, f.Container, nested)
+		}
+	}
+}
@@ -1,5 +1,36 @@
 module github.com/farazdagi/prysm-shared-types
 
-go 1.14
+go 1.18
 
-require github.com/ferranbt/fastssz v0.0.0-20201020132831-68dc48984fd3
+require (
+	github.com/RoaringBitmap/roaring v1.9.4
+	github.com/ferranbt/fastssz v0.0.0-20201020132831-68dc48984fd3
+	github.com/gogo/protobuf v1.3.2
+	github.com/invopop/jsonschema v0.13.0
+	github.com/minio/sha256-simd v1.0.0
+	github.com/prometheus/client_golang v1.13.0
+	github.com/prysmaticlabs/gohashtree v0.0.3-alpha
+	gopkg.in/yaml.v2 v2.4.0
+)
+
+require (
+	github.com/bahlo/generic-list-go v0.2.0 // indirect
+	github.com/beorn7/perks v1.0.1 // indirect
+	github.com/bits-and-blooms/bitset v1.12.0 // indirect
+	github.com/buger/jsonparser v1.1.1 // indirect
+	github.com/cespare/xxhash/v2 v2.1.2 // indirect
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/golang/protobuf v1.5.2 // indirect
+	github.com/klauspost/cpuid/v2 v2.0.9 // indirect
+	github.com/mailru/easyjson v0.7.7 // indirect
+	github.com/matttproud/golang_protobuf_extensions v1.0.1 // indirect
+	github.com/mitchellh/mapstructure v1.3.2 // indirect
+	github.com/mschoch/smat v0.2.0 // indirect
+	github.com/prometheus/client_model v0.2.0 // indirect
+	github.com/prometheus/common v0.37.0 // indirect
+	github.com/prometheus/procfs v0.8.0 // indirect
+	github.com/wk8/go-ordered-map/v2 v2.1.8 // indirect
+	golang.org/x/sys v0.0.0-20220520151302-bc2c85ada10a // indirect
+	google.golang.org/protobuf v1.28.1 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
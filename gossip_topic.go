@@ -0,0 +1,51 @@
+package types
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	"github.com/farazdagi/prysm-shared-types/mathutil"
+)
+
+// GossipTopicPrefix is the common prefix of all gossip topics.
+const GossipTopicPrefix = "/eth2/"
+
+// BlobIndex is an index of the blob (and its sidecar) within the block.
+type BlobIndex uint64
+
+// Subnet returns gossip subnet of the blob's sidecar at the given fork, see
+// compute_subnet_for_blob_sidecar. Subnet count changes with Electra. Returns false from Fulu on, as
+// blob sidecar topics are replaced by data column sidecar ones, or if spec has no blob subnets.
+func (b BlobIndex) Subnet(v Version, spec *ChainSpec) (SubnetID, bool) {
+	if v.AtLeast(Fulu) {
+		return 0, false
+	}
+	count := spec.BlobSidecarSubnetCount
+	if v.AtLeast(Electra) {
+		count = spec.BlobSidecarSubnetCountElectra
+	}
+	subnet, err := mathutil.SafeMod(uint64(b), count)
+	return SubnetID(subnet), err == nil
+}
+
+// GossipTopic returns gossip topic of the message name, e.g. "/eth2/b5303f2a/beacon_block/ssz_snappy".
+func GossipTopic(digest ForkDigest, name string) string {
+	buf := make([]byte, 0, len(GossipTopicPrefix)+8+1+len(name)+1+len(EncodingSSZSnappy))
+	buf = append(buf, GossipTopicPrefix...)
+	buf = append(buf, hex.EncodeToString(digest[:])...)
+	buf = append(buf, '/')
+	buf = append(buf, name...)
+	buf = append(buf, '/')
+	buf = append(buf, EncodingSSZSnappy...)
+	return string(buf)
+}
+
+// BlobSidecarTopic returns gossip topic of the blob sidecar subnet.
+func BlobSidecarTopic(digest ForkDigest, subnet SubnetID) string {
+	return GossipTopic(digest, "blob_sidecar_"+strconv.FormatUint(uint64(subnet), 10))
+}
+
+// DataColumnSidecarTopic returns gossip topic of the data column sidecar subnet.
+func DataColumnSidecarTopic(digest ForkDigest, subnet SubnetID) string {
+	return GossipTopic(digest, "data_column_sidecar_"+strconv.FormatUint(uint64(subnet), 10))
+}
@@ -0,0 +1,42 @@
+package types
+
+import "testing"
+
+func TestBlobIndex_Subnet(t *testing.T) {
+	spec := &ChainSpec{BlobSidecarSubnetCount: 6, BlobSidecarSubnetCountElectra: 9}
+	tests := []struct {
+		index   BlobIndex
+		version Version
+		want    SubnetID
+		ok      bool
+	}{
+		{index: 0, version: Deneb, want: 0, ok: true},
+		{index: 5, version: Deneb, want: 5, ok: true},
+		{index: 7, version: Deneb, want: 1, ok: true},
+		{index: 7, version: Electra, want: 7, ok: true},
+		{index: 10, version: Fulu, ok: false},
+	}
+	for _, tt := range tests {
+		got, ok := tt.index.Subnet(tt.version, spec)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("Unexpected subnet of blob %d at %v: %v, %v, want %v, %v", tt.index, tt.version, got, ok, tt.want, tt.ok)
+		}
+	}
+	if got, ok := BlobIndex(1).Subnet(Deneb, &ChainSpec{}); ok {
+		t.Errorf("Unexpected subnet with zero subnet count: %v", got)
+	}
+}
+
+func TestGossipTopic(t *testing.T) {
+	digest := ForkDigest{0xb5, 0x30, 0x3f, 0x2a}
+	if got := GossipTopic(digest, "beacon_block"); got != "/eth2/b5303f2a/beacon_block/ssz_snappy" {
+		t.Errorf("Unexpected topic: %s", got)
+	}
+	if got := BlobSidecarTopic(digest, 3); got != "/eth2/b5303f2a/blob_sidecar_3/ssz_snappy" {
+		t.Errorf("Unexpected topic: %s", got)
+	}
+	spec := &ChainSpec{DataColumnSidecarSubnetCount: 128}
+	if got := DataColumnSidecarTopic(digest, ColumnIndex(130).Subnet(spec)); got != "/eth2/b5303f2a/data_column_sidecar_2/ssz_snappy" {
+		t.Errorf("Unexpected topic: %s", got)
+	}
+}
@@ -0,0 +1,116 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+)
+
+// GraphQL scalar support: the methods below implement gqlgen's graphql.Marshaler and
+// graphql.Unmarshaler, so that the types can be bound to custom scalars directly. Integer values
+// are written as strings (GraphQL Int is only 32 bits wide), and read from either strings or numbers.
+
+// MarshalGQL writes slot as a quoted decimal string.
+func (s Slot) MarshalGQL(w io.Writer) {
+	writeGQLUint64(w, uint64(s))
+}
+
+// UnmarshalGQL reads slot from a decimal string or a number.
+func (s *Slot) UnmarshalGQL(v interface{}) error {
+	parsed, err := parseGQLUint64(v)
+	if err != nil {
+		return fmt.Errorf("could not parse slot: %w", err)
+	}
+	*s = Slot(parsed)
+	return nil
+}
+
+// MarshalGQL writes epoch as a quoted decimal string.
+func (e Epoch) MarshalGQL(w io.Writer) {
+	writeGQLUint64(w, uint64(e))
+}
+
+// UnmarshalGQL reads epoch from a decimal string or a number.
+func (e *Epoch) UnmarshalGQL(v interface{}) error {
+	parsed, err := parseGQLUint64(v)
+	if err != nil {
+		return fmt.Errorf("could not parse epoch: %w", err)
+	}
+	*e = Epoch(parsed)
+	return nil
+}
+
+// MarshalGQL writes gwei as a quoted decimal string.
+func (g Gwei) MarshalGQL(w io.Writer) {
+	writeGQLUint64(w, uint64(g))
+}
+
+// UnmarshalGQL reads gwei from a decimal string or a number.
+func (g *Gwei) UnmarshalGQL(v interface{}) error {
+	parsed, err := parseGQLUint64(v)
+	if err != nil {
+		return fmt.Errorf("could not parse gwei: %w", err)
+	}
+	*g = Gwei(parsed)
+	return nil
+}
+
+// MarshalGQL writes root as a quoted 0x-prefixed hex string.
+func (r Root) MarshalGQL(w io.Writer) {
+	buf := make([]byte, 0, 68)
+	buf = append(buf, '"')
+	buf = appendHex(buf, r[:])
+	_, _ = w.Write(append(buf, '"'))
+}
+
+// UnmarshalGQL reads root from a 0x-prefixed hex string.
+func (r *Root) UnmarshalGQL(v interface{}) error {
+	s, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("could not parse root: unexpected %T", v)
+	}
+	return r.UnmarshalText([]byte(s))
+}
+
+func writeGQLUint64(w io.Writer, v uint64) {
+	buf := make([]byte, 0, 22)
+	buf = append(buf, '"')
+	buf = strconv.AppendUint(buf, v, 10)
+	_, _ = w.Write(append(buf, '"'))
+}
+
+// parseGQLUint64 converts input value, as decoded by GraphQL server, into uint64.
+func parseGQLUint64(v interface{}) (uint64, error) {
+	switch v := v.(type) {
+	case string:
+		return strconv.ParseUint(v, 10, 64)
+	case json.Number:
+		return strconv.ParseUint(v.String(), 10, 64)
+	case int:
+		if v < 0 {
+			return 0, fmt.Errorf("negative value %d", v)
+		}
+		return uint64(v), nil
+	case int32:
+		if v < 0 {
+			return 0, fmt.Errorf("negative value %d", v)
+		}
+		return uint64(v), nil
+	case int64:
+		if v < 0 {
+			return 0, fmt.Errorf("negative value %d", v)
+		}
+		return uint64(v), nil
+	case uint64:
+		return v, nil
+	case float64:
+		if v < 0 || v != math.Trunc(v) || v >= math.MaxUint64 {
+			return 0, fmt.Errorf("invalid value %v", v)
+		}
+		return uint64(v), nil
+	default:
+		return 0, fmt.Errorf("unexpected %T", v)
+	}
+}
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestGraphQL_Uint64(t *testing.T) {
+	var buf bytes.Buffer
+	Slot(42).MarshalGQL(&buf)
+	Epoch(7).MarshalGQL(&buf)
+	Gwei(32_000_000_000).MarshalGQL(&buf)
+	if got := buf.String(); got != `"42""7""32000000000"` {
+		t.Errorf("Unexpected output: %s", got)
+	}
+
+	for _, input := range []interface{}{"42", 42, int32(42), int64(42), uint64(42), float64(42), json.Number("42")} {
+		var s Slot
+		if err := s.UnmarshalGQL(input); err != nil || s != 42 {
+			t.Errorf("Unexpected slot decoded from %v (%T): %v, %v", input, input, s, err)
+		}
+	}
+	for _, input := range []interface{}{"-1", -1, int64(-1), 1.5, "0x2a", true, nil} {
+		var e Epoch
+		if err := e.UnmarshalGQL(input); err == nil {
+			t.Errorf("Expected error for %v (%T)", input, input)
+		}
+	}
+	var g Gwei
+	if err := g.UnmarshalGQL("32000000000"); err != nil || g != 32_000_000_000 {
+		t.Errorf("Unexpected gwei: %v, %v", g, err)
+	}
+}
+
+func TestGraphQL_Root(t *testing.T) {
+	root := Root{0xab}
+	var buf bytes.Buffer
+	root.MarshalGQL(&buf)
+	if want := `"` + root.String() + `"`; buf.String() != want {
+		t.Errorf("Unexpected output: %s", buf.String())
+	}
+	var decoded Root
+	if err := decoded.UnmarshalGQL(root.String()); err != nil || decoded != root {
+		t.Errorf("Unexpected root: %v, %v", decoded, err)
+	}
+	if err := decoded.UnmarshalGQL(42); err == nil {
+		t.Error("Expected error for non-string input")
+	}
+}
@@ -0,0 +1,142 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Denominations of ether, in gwei.
+const (
+	GweiPerEth  = Gwei(1_000_000_000)
+	WeiPerGwei  = 1_000_000_000
+	gweiDecimal = 9 // number of decimal places of gwei in ether
+)
+
+// BasisPoints is the number of basis points in a whole (100%).
+const BasisPoints = 10_000
+
+// ErrInvalidGwei is returned when amount cannot be represented as gwei.
+var ErrInvalidGwei = errors.New("invalid gwei amount")
+
+// Gwei represents amount of ether denominated in gwei (10^9 wei).
+// Common methods (arithmetic, encoding) are generated, see gwei_gen.go.
+type Gwei uint64
+
+// String returns amount in human readable units: whole and fractional ether amounts are rendered
+// in ETH (e.g. "32 ETH", "1.5 ETH"), amounts below 1 ETH in Gwei (e.g. "1000 Gwei").
+// Use MarshalText for the plain decimal gwei representation.
+func (g Gwei) String() string {
+	if g < GweiPerEth {
+		return strconv.FormatUint(uint64(g), 10) + " Gwei"
+	}
+	whole := strconv.FormatUint(uint64(g/GweiPerEth), 10)
+	frac := uint64(g % GweiPerEth)
+	if frac == 0 {
+		return whole + " ETH"
+	}
+	fracStr := strconv.FormatUint(frac, 10)
+	fracStr = strings.Repeat("0", gweiDecimal-len(fracStr)) + fracStr
+	return whole + "." + strings.TrimRight(fracStr, "0") + " ETH"
+}
+
+// ParseGwei parses amount with optional unit suffix (case-insensitive "eth", "ether", "gwei" or "wei"),
+// e.g. "32eth", "1.5 ETH", "1000000000". Amounts without unit are interpreted as gwei.
+func ParseGwei(s string) (Gwei, error) {
+	amount := strings.ToLower(strings.TrimSpace(s))
+	decimals := 0
+	for _, unit := range []struct {
+		suffix   string
+		decimals int
+	}{{"ether", gweiDecimal}, {"gwei", 0}, {"wei", -gweiDecimal}, {"eth", gweiDecimal}} {
+		if strings.HasSuffix(amount, unit.suffix) {
+			amount = strings.TrimSpace(strings.TrimSuffix(amount, unit.suffix))
+			decimals = unit.decimals
+			break
+		}
+	}
+	whole, frac, _ := strings.Cut(amount, ".")
+	if whole == "" && frac == "" {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidGwei, s)
+	}
+	// Shift decimal point, so that amount is expressed in gwei.
+	digits := whole + frac
+	shift := decimals - len(frac)
+	if shift >= 0 {
+		digits += strings.Repeat("0", shift)
+	} else {
+		cut := len(digits) + shift
+		if cut < 0 {
+			digits, cut = strings.Repeat("0", -cut)+digits, 0
+		}
+		if strings.Trim(digits[cut:], "0") != "" {
+			return 0, fmt.Errorf("%w: %q is not a whole number of gwei", ErrInvalidGwei, s)
+		}
+		digits = digits[:cut]
+	}
+	if digits == "" {
+		return 0, nil
+	}
+	v, err := strconv.ParseUint(digits, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidGwei, s)
+	}
+	return Gwei(v), nil
+}
+
+// ToWei returns amount in wei.
+func (g Gwei) ToWei() *big.Int {
+	wei := new(big.Int).SetUint64(uint64(g))
+	return wei.Mul(wei, big.NewInt(WeiPerGwei))
+}
+
+// GweiFromWei converts wei amount into gwei, an error is returned if amount is negative, not
+// a whole number of gwei or doesn't fit into uint64.
+func GweiFromWei(wei *big.Int) (Gwei, error) {
+	quo, rem := new(big.Int).QuoRem(wei, big.NewInt(WeiPerGwei), new(big.Int))
+	if wei.Sign() < 0 || rem.Sign() != 0 || !quo.IsUint64() {
+		return 0, fmt.Errorf("%w: %s wei", ErrInvalidGwei, wei)
+	}
+	return Gwei(quo.Uint64()), nil
+}
+
+// MulBps returns amount scaled by bps basis points (1/100 of a percent), rounded down.
+// Values above BasisPoints scale amount up, panics if the result doesn't fit into uint64.
+func (g Gwei) MulBps(bps uint64) Gwei {
+	return MulDiv(g, bps, BasisPoints)
+}
+
+// SafeMulBps returns amount scaled by bps basis points, or an error if the result doesn't fit into uint64.
+func (g Gwei) SafeMulBps(bps uint64) (Gwei, error) {
+	return SafeMulDiv(g, bps, BasisPoints)
+}
+
+// Percent returns pct percent of the amount, rounded down. Panics if the result doesn't fit into uint64.
+func (g Gwei) Percent(pct uint64) Gwei {
+	return MulDiv(g, pct, 100)
+}
+
+// SplitBps splits amount into share of bps basis points (rounded down, capped at the whole amount)
+// and the remainder, so that share + rest is always equal to the amount.
+func (g Gwei) SplitBps(bps uint64) (share, rest Gwei) {
+	if bps > BasisPoints {
+		bps = BasisPoints
+	}
+	share = g.MulBps(bps)
+	return share, g - share
+}
+
+// BpsOf returns amount as a share of total in basis points, rounded down (zero if total is zero).
+func (g Gwei) BpsOf(total Gwei) uint64 {
+	if total == 0 {
+		return 0
+	}
+	bps, err := SafeMulDiv(g, BasisPoints, uint64(total))
+	if err != nil {
+		return math.MaxUint64
+	}
+	return uint64(bps)
+}
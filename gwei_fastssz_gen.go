@@ -0,0 +1,17 @@
+// Code generated by typegen. DO NOT EDIT.
+
+//go:build !nofastssz
+
+package types
+
+import fssz "github.com/ferranbt/fastssz"
+
+var _ fssz.HashRoot = (Gwei)(0)
+var _ fssz.Marshaler = (*Gwei)(nil)
+var _ fssz.Unmarshaler = (*Gwei)(nil)
+
+// HashTreeRootWith appends gwei to the provided hasher.
+func (g Gwei) HashTreeRootWith(hh *fssz.Hasher) error {
+	hh.PutUint64(uint64(g))
+	return nil
+}
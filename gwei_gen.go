@@ -0,0 +1,239 @@
+// Code generated by typegen. DO NOT EDIT.
+
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/farazdagi/prysm-shared-types/mathutil"
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// IsZero returns true if gwei has zero value.
+func (g Gwei) IsZero() bool {
+	return g == 0
+}
+
+// Add increases gwei by x, panics on overflow.
+func (g Gwei) Add(x uint64) Gwei {
+	return g.AddGwei(Gwei(x))
+}
+
+// AddGwei increases gwei by another gwei, panics on overflow.
+func (g Gwei) AddGwei(x Gwei) Gwei {
+	return mathutil.Add(g, x)
+}
+
+// SafeAdd increases gwei by x, returns an error on overflow.
+func (g Gwei) SafeAdd(x uint64) (Gwei, error) {
+	return mathutil.SafeAdd(g, Gwei(x))
+}
+
+// Sub subtracts x from the gwei, panics on underflow.
+func (g Gwei) Sub(x uint64) Gwei {
+	return g.SubGwei(Gwei(x))
+}
+
+// SubGwei finds difference between two gwei values, panics on underflow.
+func (g Gwei) SubGwei(x Gwei) Gwei {
+	return mathutil.Sub(g, x)
+}
+
+// SafeSub subtracts x from the gwei, returns an error on underflow.
+func (g Gwei) SafeSub(x uint64) (Gwei, error) {
+	return mathutil.SafeSub(g, Gwei(x))
+}
+
+// AddInt shifts gwei by signed offset x, returns an error if result overflows or goes below zero.
+func (g Gwei) AddInt(x int64) (Gwei, error) {
+	return mathutil.SafeAddInt(g, x)
+}
+
+// Mul multiplies gwei by x, panics on overflow.
+func (g Gwei) Mul(x uint64) Gwei {
+	return g.MulGwei(Gwei(x))
+}
+
+// MulGwei multiplies gwei by another gwei, panics on overflow.
+func (g Gwei) MulGwei(x Gwei) Gwei {
+	return mathutil.Mul(g, x)
+}
+
+// SafeMul multiplies gwei by x, returns an error on overflow.
+func (g Gwei) SafeMul(x uint64) (Gwei, error) {
+	return mathutil.SafeMul(g, Gwei(x))
+}
+
+// Div divides gwei by x, panics if x is zero.
+func (g Gwei) Div(x uint64) Gwei {
+	return g.DivGwei(Gwei(x))
+}
+
+// DivGwei divides gwei by another gwei, panics if x is zero.
+func (g Gwei) DivGwei(x Gwei) Gwei {
+	return mathutil.Div(g, x)
+}
+
+// SafeDiv divides gwei by x, returns an error if x is zero.
+func (g Gwei) SafeDiv(x uint64) (Gwei, error) {
+	return mathutil.SafeDiv(g, Gwei(x))
+}
+
+// Mod returns result of `gwei % x`, panics if x is zero.
+func (g Gwei) Mod(x uint64) Gwei {
+	return g.ModGwei(Gwei(x))
+}
+
+// ModGwei returns result of `gwei % gwei`, panics if x is zero.
+func (g Gwei) ModGwei(x Gwei) Gwei {
+	return mathutil.Mod(g, x)
+}
+
+// SafeMod returns result of `gwei % x`, returns an error if x is zero.
+func (g Gwei) SafeMod(x uint64) (Gwei, error) {
+	return mathutil.SafeMod(g, Gwei(x))
+}
+
+// Compare returns an integer comparing two gwei values (-1, 0 or +1).
+func (g Gwei) Compare(x Gwei) int {
+	switch {
+	case g < x:
+		return -1
+	case g > x:
+		return 1
+	}
+	return 0
+}
+
+// IsAfter returns true if gwei is strictly greater than x.
+func (g Gwei) IsAfter(x Gwei) bool {
+	return g > x
+}
+
+// IsBefore returns true if gwei is strictly less than x.
+func (g Gwei) IsBefore(x Gwei) bool {
+	return g < x
+}
+
+// WithinN returns true if gwei is at most n away from x (in either direction).
+func (g Gwei) WithinN(x Gwei, n uint64) bool {
+	if g > x {
+		return uint64(g-x) <= n
+	}
+	return uint64(x-g) <= n
+}
+
+// PaddedString returns decimal representation of the gwei, left-padded with zeros to width digits,
+// so that values sort lexicographically (e.g. in file names and object keys). Wider values are not truncated.
+func (g Gwei) PaddedString(width int) string {
+	return string(g.AppendPadded(nil, width))
+}
+
+// AppendPadded appends zero-padded decimal representation of the gwei to dst, see PaddedString.
+func (g Gwei) AppendPadded(dst []byte, width int) []byte {
+	var digits [20]byte
+	b := strconv.AppendUint(digits[:0], uint64(g), 10)
+	for i := len(b); i < width; i++ {
+		dst = append(dst, '0')
+	}
+	return append(dst, b...)
+}
+
+// MarshalText encodes gwei as a decimal string.
+func (g Gwei) MarshalText() ([]byte, error) {
+	return strconv.AppendUint(nil, uint64(g), 10), nil
+}
+
+// UnmarshalText decodes gwei from a decimal string.
+func (g *Gwei) UnmarshalText(data []byte) error {
+	parsed, err := strconv.ParseUint(string(data), 10, 64)
+	if err != nil {
+		return fmt.Errorf("could not parse gwei: %w", err)
+	}
+	*g = Gwei(parsed)
+	return nil
+}
+
+// MarshalJSON encodes gwei as a quoted decimal string (as expected by the Beacon API).
+func (g Gwei) MarshalJSON() ([]byte, error) {
+	data := make([]byte, 0, 22)
+	data = append(data, '"')
+	data = strconv.AppendUint(data, uint64(g), 10)
+	return append(data, '"'), nil
+}
+
+// UnmarshalJSON decodes gwei from either a quoted decimal string or a JSON number.
+func (g *Gwei) UnmarshalJSON(data []byte) error {
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		data = data[1 : len(data)-1]
+	}
+	return g.UnmarshalText(data)
+}
+
+// HashTreeRoot returns calculated hash root.
+// Root of a basic uint64 value is its little-endian encoding padded to 32 bytes, so no hashing is involved.
+func (g Gwei) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	binary.LittleEndian.PutUint64(root[:8], uint64(g))
+	return root, nil
+}
+
+// HashTreeRootInto writes hash root into dst, avoiding copying of the returned array.
+func (g Gwei) HashTreeRootInto(dst *[32]byte) error {
+	*dst = [32]byte{}
+	binary.LittleEndian.PutUint64(dst[:8], uint64(g))
+	return nil
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the gwei object.
+func (g *Gwei) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), g.SizeSSZ()); err != nil {
+		return err
+	}
+	*g = Gwei(binary.LittleEndian.Uint64(buf))
+	return nil
+}
+
+// MarshalSSZTo marshals gwei with the provided byte slice.
+func (g *Gwei) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return g.AppendSSZ(dst), nil
+}
+
+// MarshalSSZ marshals gwei into a serialized object.
+func (g *Gwei) MarshalSSZ() ([]byte, error) {
+	return g.AppendSSZ(make([]byte, 0, 8)), nil
+}
+
+// AppendSSZ appends serialized gwei to dst, allocating only if dst has no spare capacity.
+func (g Gwei) AppendSSZ(dst []byte) []byte {
+	return append(dst, byte(g), byte(g>>8), byte(g>>16), byte(g>>24),
+		byte(g>>32), byte(g>>40), byte(g>>48), byte(g>>56))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (g *Gwei) SizeSSZ() int {
+	return 8
+}
+
+// WriteTo writes SSZ serialized gwei to w.
+func (g Gwei) WriteTo(w io.Writer) (int64, error) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], uint64(g))
+	n, err := w.Write(buf[:])
+	return int64(n), err
+}
+
+// ReadFrom reads SSZ serialized gwei from r.
+// Exactly 8 bytes are consumed, so values can be read one after another from the same stream.
+func (g *Gwei) ReadFrom(r io.Reader) (int64, error) {
+	var buf [8]byte
+	n, err := io.ReadFull(r, buf[:])
+	if err != nil {
+		return int64(n), err
+	}
+	*g = Gwei(binary.LittleEndian.Uint64(buf[:]))
+	return int64(n), nil
+}
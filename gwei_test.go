@@ -0,0 +1,111 @@
+package types
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/farazdagi/prysm-shared-types/mathutil"
+)
+
+func TestGwei_String(t *testing.T) {
+	tests := map[Gwei]string{
+		0:               "0 Gwei",
+		1000:            "1000 Gwei",
+		32 * GweiPerEth: "32 ETH",
+		1_500_000_000:   "1.5 ETH",
+		32_000_000_001:  "32.000000001 ETH",
+	}
+	for g, want := range tests {
+		if got := g.String(); got != want {
+			t.Errorf("String(%d) = %q, want %q", uint64(g), got, want)
+		}
+	}
+}
+
+func TestParseGwei(t *testing.T) {
+	tests := map[string]Gwei{
+		"32eth":            32 * GweiPerEth,
+		"1.5 ETH":          1_500_000_000,
+		"0.000000001ether": 1,
+		"1000000000":       GweiPerEth,
+		"1000 Gwei":        1000,
+		"2000000000wei":    2,
+		"0wei":             0,
+		".5eth":            500_000_000,
+	}
+	for input, want := range tests {
+		got, err := ParseGwei(input)
+		if err != nil {
+			t.Errorf("Could not parse %q: %v", input, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("ParseGwei(%q) = %d, want %d", input, uint64(got), uint64(want))
+		}
+	}
+
+	for _, input := range []string{"", "eth", "1.5", "1wei", "0.0000000001eth", "-1", "1.2.3eth", "99999999999eth"} {
+		if _, err := ParseGwei(input); !errors.Is(err, ErrInvalidGwei) {
+			t.Errorf("Expected ErrInvalidGwei for %q, got: %v", input, err)
+		}
+	}
+}
+
+func TestGwei_Wei(t *testing.T) {
+	g := 32*GweiPerEth + 1
+	wei := g.ToWei()
+	if wei.String() != "32000000001000000000" {
+		t.Errorf("Unexpected wei: %s", wei)
+	}
+	back, err := GweiFromWei(wei)
+	if err != nil || back != g {
+		t.Errorf("Unexpected gwei: %d (%v)", uint64(back), err)
+	}
+
+	overflow := new(big.Int).Lsh(big.NewInt(1), 100)
+	for _, wei := range []*big.Int{big.NewInt(-1000000000), big.NewInt(1), overflow} {
+		if _, err := GweiFromWei(wei); !errors.Is(err, ErrInvalidGwei) {
+			t.Errorf("Expected ErrInvalidGwei for %s, got: %v", wei, err)
+		}
+	}
+}
+
+func TestGwei_Bps(t *testing.T) {
+	tests := []struct {
+		amount Gwei
+		bps    uint64
+		want   Gwei
+	}{
+		{amount: 32 * GweiPerEth, bps: 500, want: 1_600_000_000},
+		{amount: 999, bps: 1, want: 0},
+		{amount: 1<<64 - 1, bps: BasisPoints, want: 1<<64 - 1},
+		{amount: 1<<64 - 1, bps: 9999, want: 18_444_899_399_302_180_659},
+		{amount: 100, bps: 15_000, want: 150},
+	}
+	for _, tt := range tests {
+		if got := tt.amount.MulBps(tt.bps); got != tt.want {
+			t.Errorf("MulBps(%d, %d) = %d, want %d", tt.amount, tt.bps, got, tt.want)
+		}
+	}
+	if _, err := Gwei(1<<64 - 1).SafeMulBps(BasisPoints + 1); !errors.Is(err, mathutil.ErrOverflow) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if got := Gwei(1000).Percent(15); got != 150 {
+		t.Errorf("Unexpected percent: %d", got)
+	}
+
+	share, rest := Gwei(1001).SplitBps(2500)
+	if share != 250 || rest != 751 {
+		t.Errorf("Unexpected split: %d, %d", share, rest)
+	}
+	if share, rest := Gwei(10).SplitBps(20_000); share != 10 || rest != 0 {
+		t.Errorf("Unexpected capped split: %d, %d", share, rest)
+	}
+	if got := Gwei(250).BpsOf(1000); got != 2500 {
+		t.Errorf("Unexpected bps: %d", got)
+	}
+	if Gwei(1).BpsOf(0) != 0 {
+		t.Error("Share of zero total must be zero")
+	}
+}
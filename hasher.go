@@ -0,0 +1,63 @@
+package types
+
+import (
+	"errors"
+	"sync/atomic"
+)
+
+// ErrInvalidChunks is returned when hasher receives malformed input.
+var ErrInvalidChunks = errors.New("odd number of chunks or insufficient digests capacity")
+
+// Hasher hashes consecutive pairs of 32-byte chunks.
+// The signature matches gohashtree.Hash, so vectorized implementations can be plugged in directly.
+type Hasher interface {
+	// HashChunks hashes each consecutive pair of chunks into digests (digests[i] = H(chunks[2i] || chunks[2i+1])).
+	HashChunks(digests [][32]byte, chunks [][32]byte) error
+}
+
+// HasherFunc is an adapter allowing use of ordinary functions as Hasher.
+type HasherFunc func(digests [][32]byte, chunks [][32]byte) error
+
+// HashChunks calls f(digests, chunks).
+func (f HasherFunc) HashChunks(digests [][32]byte, chunks [][32]byte) error {
+	return f(digests, chunks)
+}
+
+// HasherFactory creates a hasher to be used for a single hash tree root computation.
+type HasherFactory func() Hasher
+
+var hasherFactory atomic.Value
+
+func init() {
+	SetHasherFactory(nil)
+}
+
+// SetHasherFactory registers factory used by all hash tree root computations in this package.
+// Passing nil restores the default hasher (gohashtree on amd64 and arm64, plain SHA-256 elsewhere).
+func SetHasherFactory(factory HasherFactory) {
+	if factory == nil {
+		factory = func() Hasher {
+			return HasherFunc(defaultHashChunks)
+		}
+	}
+	hasherFactory.Store(factory)
+}
+
+// newHasher returns hasher created by the currently registered factory.
+func newHasher() Hasher {
+	return hasherFactory.Load().(HasherFactory)()
+}
+
+// hashChunksSHA256 hashes chunks pair by pair, it is the default Hasher on platforms gohashtree doesn't support.
+func hashChunksSHA256(digests [][32]byte, chunks [][32]byte) error {
+	if len(chunks)%2 != 0 || len(digests) < len(chunks)/2 {
+		return ErrInvalidChunks
+	}
+	var buf [64]byte
+	for i := 0; i < len(chunks)/2; i++ {
+		copy(buf[:32], chunks[2*i][:])
+		copy(buf[32:], chunks[2*i+1][:])
+		digests[i] = sum256(buf[:])
+	}
+	return nil
+}
@@ -0,0 +1,8 @@
+//go:build !amd64 && !arm64
+
+package types
+
+// defaultHashChunks falls back to pairwise SHA-256, as gohashtree is only available on amd64 and arm64.
+func defaultHashChunks(digests [][32]byte, chunks [][32]byte) error {
+	return hashChunksSHA256(digests, chunks)
+}
@@ -0,0 +1,13 @@
+//go:build amd64 || arm64
+
+package types
+
+import "github.com/prysmaticlabs/gohashtree"
+
+// defaultHashChunks hashes the whole layer with gohashtree.
+func defaultHashChunks(digests [][32]byte, chunks [][32]byte) error {
+	if len(chunks)%2 != 0 || len(digests) < len(chunks)/2 {
+		return ErrInvalidChunks
+	}
+	return gohashtree.Hash(digests, chunks)
+}
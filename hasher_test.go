@@ -0,0 +1,78 @@
+package types
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSetHasherFactory(t *testing.T) {
+	defer SetHasherFactory(nil)
+	values := []Slot{1, 2, 3, 4, 5, 6, 7, 8, 9}
+	want, err := HashTreeRootBatch(values)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	calls := 0
+	SetHasherFactory(func() Hasher {
+		return HasherFunc(func(digests [][32]byte, chunks [][32]byte) error {
+			calls++
+			return hashChunksSHA256(digests, chunks)
+		})
+	})
+	got, err := HashTreeRootBatch(values)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("Unexpected root: %#x, want %#x", got, want)
+	}
+	if calls != 2 {
+		t.Errorf("Unexpected number of hasher calls: %d", calls)
+	}
+
+	errHasher := errors.New("hasher failure")
+	SetHasherFactory(func() Hasher {
+		return HasherFunc(func(digests [][32]byte, chunks [][32]byte) error {
+			return errHasher
+		})
+	})
+	if _, err := HashTreeRootBatch(values); !errors.Is(err, errHasher) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestHashChunksSHA256_InvalidInput(t *testing.T) {
+	if err := hashChunksSHA256(make([][32]byte, 1), make([][32]byte, 3)); !errors.Is(err, ErrInvalidChunks) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if err := hashChunksSHA256(make([][32]byte, 0), make([][32]byte, 2)); !errors.Is(err, ErrInvalidChunks) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestDefaultHashChunks(t *testing.T) {
+	chunks := make([][32]byte, 64)
+	for i := range chunks {
+		chunks[i][0], chunks[i][31] = byte(i), byte(3*i)
+	}
+	want := make([][32]byte, 32)
+	if err := hashChunksSHA256(want, chunks); err != nil {
+		t.Fatal(err)
+	}
+	got := make([][32]byte, 32)
+	if err := defaultHashChunks(got, chunks); err != nil {
+		t.Fatal(err)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Unexpected digest %d: %#x, want %#x", i, got[i], want[i])
+		}
+	}
+	if err := defaultHashChunks(make([][32]byte, 1), make([][32]byte, 3)); !errors.Is(err, ErrInvalidChunks) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if err := defaultHashChunks(make([][32]byte, 0), make([][32]byte, 2)); !errors.Is(err, ErrInvalidChunks) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+)
+
+// appendHex appends 0x-prefixed hex encoding of b to dst.
+func appendHex(dst []byte, b []byte) []byte {
+	dst = append(dst, "0x"...)
+	n := len(dst)
+	dst = append(dst, make([]byte, hex.EncodedLen(len(b)))...)
+	hex.Encode(dst[n:], b)
+	return dst
+}
+
+// decodeHexInto decodes 0x-prefixed hex text into dst, which must be filled exactly.
+func decodeHexInto(dst []byte, text []byte) error {
+	if len(text) < 2 || text[0] != '0' || (text[1] != 'x' && text[1] != 'X') {
+		return fmt.Errorf("hex string %q has no 0x prefix", text)
+	}
+	text = text[2:]
+	if hex.DecodedLen(len(text)) != len(dst) {
+		return fmt.Errorf("expected %d bytes hex string received %d characters", len(dst), len(text))
+	}
+	if _, err := hex.Decode(dst, text); err != nil {
+		return fmt.Errorf("invalid hex string: %w", err)
+	}
+	return nil
+}
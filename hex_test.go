@@ -0,0 +1,47 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestRoot_JSON(t *testing.T) {
+	root := Root{0xab, 0xcd}
+	enc, err := json.Marshal(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `"0xabcd` + strings.Repeat("00", 30) + `"`
+	if string(enc) != want {
+		t.Errorf("Unexpected JSON: %s", enc)
+	}
+	var decoded Root
+	if err := json.Unmarshal(enc, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded != root {
+		t.Errorf("Unexpected root: %v", decoded)
+	}
+
+	for _, input := range []string{`"abcd"`, `"0xabcd"`, `"0x` + strings.Repeat("zz", 32) + `"`} {
+		if err := json.Unmarshal([]byte(input), &decoded); err == nil {
+			t.Errorf("Expected error decoding %s", input)
+		}
+	}
+}
+
+func TestBLSPubkey_Text(t *testing.T) {
+	pubkey := BLSPubkey{0x01}
+	enc, err := pubkey.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(enc) != 98 || pubkey.String() != string(enc) {
+		t.Errorf("Unexpected encoding: %s", enc)
+	}
+	var decoded BLSPubkey
+	if err := decoded.UnmarshalText(enc); err != nil || decoded != pubkey {
+		t.Errorf("Unexpected decoding: %v, %v", decoded, err)
+	}
+}
@@ -0,0 +1,23 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrSlotOutOfRange is returned when historical root of the slot is not available in the state.
+var ErrSlotOutOfRange = errors.New("slot is out of historical roots range")
+
+// HistoricalRootIndex returns index of the slot in block_roots and state_roots vectors.
+func HistoricalRootIndex(slot Slot, spec *ChainSpec) VectorIndex {
+	return VectorIndex(slot.ModSlot(spec.SlotsPerHistoricalRoot))
+}
+
+// BlockRootIndex returns index of the slot's root in block_roots (or state_roots) of the state at
+// stateSlot, checking that slot < stateSlot <= slot + SLOTS_PER_HISTORICAL_ROOT (see get_block_root_at_slot).
+func BlockRootIndex(slot, stateSlot Slot, spec *ChainSpec) (VectorIndex, error) {
+	if slot >= stateSlot || uint64(stateSlot-slot) > uint64(spec.SlotsPerHistoricalRoot) {
+		return 0, fmt.Errorf("%w: slot %d, state slot %d", ErrSlotOutOfRange, slot, stateSlot)
+	}
+	return HistoricalRootIndex(slot, spec), nil
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBlockRootIndex(t *testing.T) {
+	spec := &ChainSpec{SlotsPerHistoricalRoot: 8192}
+	tests := []struct {
+		name            string
+		slot, stateSlot Slot
+		want            VectorIndex
+		err             bool
+	}{
+		{name: "previous slot", slot: 99, stateSlot: 100, want: 99},
+		{name: "oldest available", slot: 1808, stateSlot: 10000, want: 1808},
+		{name: "wrapped", slot: 9000, stateSlot: 9001, want: 808},
+		{name: "same slot", slot: 100, stateSlot: 100, err: true},
+		{name: "future slot", slot: 101, stateSlot: 100, err: true},
+		{name: "too old", slot: 1807, stateSlot: 10000, err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := BlockRootIndex(tt.slot, tt.stateSlot, spec)
+			if tt.err {
+				if !errors.Is(err, ErrSlotOutOfRange) {
+					t.Errorf("Expected ErrSlotOutOfRange, got: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("BlockRootIndex() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package types
+
+import "sort"
+
+// FindCheckpointForEpoch returns the latest checkpoint at or before epoch e (e.g. the finalized
+// checkpoint as of e), false if all checkpoints are later. Checkpoints must be sorted by epoch.
+func FindCheckpointForEpoch(sorted []Checkpoint, e Epoch) (Checkpoint, bool) {
+	i := searchAfter(len(sorted), e, func(i int) Epoch { return sorted[i].Epoch })
+	if i == 0 {
+		return Checkpoint{}, false
+	}
+	return sorted[i-1], true
+}
+
+// SearchCheckpoints returns index of the first checkpoint at or after epoch e, len(sorted) if there
+// is none. Checkpoints must be sorted by epoch.
+func SearchCheckpoints(sorted []Checkpoint, e Epoch) int {
+	return sort.Search(len(sorted), func(i int) bool {
+		return sorted[i].Epoch >= e
+	})
+}
+
+// CheckpointsInRange returns sub-slice of checkpoints within the inclusive [from, to] epoch range.
+// Checkpoints must be sorted by epoch.
+func CheckpointsInRange(sorted []Checkpoint, from, to Epoch) []Checkpoint {
+	if from > to {
+		return nil
+	}
+	start := SearchCheckpoints(sorted, from)
+	end := searchAfter(len(sorted), to, func(i int) Epoch { return sorted[i].Epoch })
+	return sorted[start:end]
+}
+
+// IsCheckpointHistorySorted returns true if checkpoints are sorted by epoch (duplicate epochs
+// allowed), as expected by the search helpers.
+func IsCheckpointHistorySorted(history []Checkpoint) bool {
+	return sort.SliceIsSorted(history, func(i, j int) bool {
+		return history[i].Epoch < history[j].Epoch
+	})
+}
+
+// FindForkForEpoch returns the fork in effect at epoch e, i.e. the latest one scheduled at or before
+// e, false if all forks are later. Forks must be sorted by epoch.
+func FindForkForEpoch(sorted []Fork, e Epoch) (Fork, bool) {
+	i := searchAfter(len(sorted), e, func(i int) Epoch { return sorted[i].Epoch })
+	if i == 0 {
+		return Fork{}, false
+	}
+	return sorted[i-1], true
+}
+
+// searchAfter returns index of the first of n sorted entries with epoch after e, n if there is none.
+func searchAfter(n int, e Epoch, epochAt func(i int) Epoch) int {
+	return sort.Search(n, func(i int) bool {
+		return epochAt(i) > e
+	})
+}
@@ -0,0 +1,64 @@
+package types
+
+import "testing"
+
+func TestFindCheckpointForEpoch(t *testing.T) {
+	history := []Checkpoint{{Epoch: 2, Root: BlockRoot{Root{0x02}}}, {Epoch: 5, Root: BlockRoot{Root{0x05}}}, {Epoch: 9, Root: BlockRoot{Root{0x09}}}}
+	tests := []struct {
+		epoch  Epoch
+		want   Epoch
+		wantOK bool
+	}{
+		{epoch: 0},
+		{epoch: 1},
+		{epoch: 2, want: 2, wantOK: true},
+		{epoch: 4, want: 2, wantOK: true},
+		{epoch: 5, want: 5, wantOK: true},
+		{epoch: 100, want: 9, wantOK: true},
+	}
+	for _, tt := range tests {
+		cp, ok := FindCheckpointForEpoch(history, tt.epoch)
+		if ok != tt.wantOK || cp.Epoch != tt.want {
+			t.Errorf("FindCheckpointForEpoch(%d) = %+v, %v", tt.epoch, cp, ok)
+		}
+	}
+	if _, ok := FindCheckpointForEpoch(nil, 5); ok {
+		t.Error("Unexpected checkpoint in empty history")
+	}
+
+	if i := SearchCheckpoints(history, 3); i != 1 {
+		t.Errorf("Unexpected index: %d", i)
+	}
+	if i := SearchCheckpoints(history, 10); i != 3 {
+		t.Errorf("Unexpected index: %d", i)
+	}
+	if got := CheckpointsInRange(history, 2, 8); len(got) != 2 || got[0].Epoch != 2 || got[1].Epoch != 5 {
+		t.Errorf("Unexpected checkpoints in range: %+v", got)
+	}
+	if got := CheckpointsInRange(history, 6, 8); len(got) != 0 {
+		t.Errorf("Unexpected checkpoints in range: %+v", got)
+	}
+	if got := CheckpointsInRange(history, 8, 6); got != nil {
+		t.Errorf("Unexpected checkpoints in inverted range: %+v", got)
+	}
+
+	if !IsCheckpointHistorySorted(history) || IsCheckpointHistorySorted([]Checkpoint{{Epoch: 2}, {Epoch: 1}}) {
+		t.Error("Unexpected sort check result")
+	}
+}
+
+func TestFindForkForEpoch(t *testing.T) {
+	forks := []Fork{
+		{CurrentVersion: ForkVersion{0x01}, Epoch: 0},
+		{PreviousVersion: ForkVersion{0x01}, CurrentVersion: ForkVersion{0x02}, Epoch: 10},
+	}
+	if fork, ok := FindForkForEpoch(forks, 9); !ok || fork.CurrentVersion != (ForkVersion{0x01}) {
+		t.Errorf("Unexpected fork: %+v", fork)
+	}
+	if fork, ok := FindForkForEpoch(forks, 10); !ok || fork.CurrentVersion != (ForkVersion{0x02}) {
+		t.Errorf("Unexpected fork: %+v", fork)
+	}
+	if _, ok := FindForkForEpoch(forks[1:], 9); ok {
+		t.Error("Unexpected fork before the first one")
+	}
+}
@@ -0,0 +1,61 @@
+package types
+
+import "fmt"
+
+// ParticipationFlag is an index of the Altair participation flag.
+type ParticipationFlag uint8
+
+// Participation flag indices, as defined by the spec.
+const (
+	TimelySourceFlag ParticipationFlag = iota
+	TimelyTargetFlag
+	TimelyHeadFlag
+)
+
+// String returns spec name of the flag.
+func (f ParticipationFlag) String() string {
+	switch f {
+	case TimelySourceFlag:
+		return "timely_source"
+	case TimelyTargetFlag:
+		return "timely_target"
+	case TimelyHeadFlag:
+		return "timely_head"
+	default:
+		return fmt.Sprintf("ParticipationFlag(%d)", uint8(f))
+	}
+}
+
+// InclusionWindow returns the earliest and the latest slots at which attestation made at the slot
+// can be included: [slot + MIN_ATTESTATION_INCLUSION_DELAY, slot + SLOTS_PER_EPOCH]. Both saturate at
+// FarFutureSlot.
+func InclusionWindow(slot Slot, spec *ChainSpec) (earliest, latest Slot) {
+	return saturatingAddSlot(slot, spec.MinAttestationInclusionDelay), saturatingAddSlot(slot, spec.SlotsPerEpoch)
+}
+
+// IsInclusionTimely returns true if attestation with the given inclusion delay (inclusion slot minus
+// attestation slot) satisfies Altair timeliness requirement of the flag: source must be included within
+// integer_squareroot(SLOTS_PER_EPOCH) slots, target within SLOTS_PER_EPOCH slots, head with the minimum delay.
+func IsInclusionTimely(flag ParticipationFlag, delay Slot, spec *ChainSpec) bool {
+	if delay < spec.MinAttestationInclusionDelay {
+		return false
+	}
+	switch flag {
+	case TimelySourceFlag:
+		return delay <= IntegerSquareRoot(spec.SlotsPerEpoch)
+	case TimelyTargetFlag:
+		return delay <= spec.SlotsPerEpoch
+	case TimelyHeadFlag:
+		return delay == spec.MinAttestationInclusionDelay
+	default:
+		return false
+	}
+}
+
+// saturatingAddSlot returns s + x, capped at FarFutureSlot.
+func saturatingAddSlot(s, x Slot) Slot {
+	if sum, err := s.SafeAdd(uint64(x)); err == nil {
+		return sum
+	}
+	return FarFutureSlot
+}
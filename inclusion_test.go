@@ -0,0 +1,38 @@
+package types
+
+import "testing"
+
+func TestInclusionWindow(t *testing.T) {
+	spec := &ChainSpec{SlotsPerEpoch: 32, MinAttestationInclusionDelay: 1}
+	if earliest, latest := InclusionWindow(100, spec); earliest != 101 || latest != 132 {
+		t.Errorf("Unexpected window: [%d, %d]", earliest, latest)
+	}
+	if earliest, latest := InclusionWindow(FarFutureSlot-10, spec); earliest != FarFutureSlot-9 || latest != FarFutureSlot {
+		t.Errorf("Unexpected window: [%d, %d]", earliest, latest)
+	}
+}
+
+func TestIsInclusionTimely(t *testing.T) {
+	spec := &ChainSpec{SlotsPerEpoch: 32, MinAttestationInclusionDelay: 1}
+	tests := []struct {
+		delay                Slot
+		source, target, head bool
+	}{
+		{delay: 0, source: false, target: false, head: false},
+		{delay: 1, source: true, target: true, head: true},
+		{delay: 5, source: true, target: true, head: false},
+		{delay: 6, source: false, target: true, head: false},
+		{delay: 32, source: false, target: true, head: false},
+		{delay: 33, source: false, target: false, head: false},
+	}
+	for _, tt := range tests {
+		for flag, want := range map[ParticipationFlag]bool{TimelySourceFlag: tt.source, TimelyTargetFlag: tt.target, TimelyHeadFlag: tt.head} {
+			if got := IsInclusionTimely(flag, tt.delay, spec); got != want {
+				t.Errorf("IsInclusionTimely(%v, %d) = %v, want %v", flag, tt.delay, got, want)
+			}
+		}
+	}
+	if IsInclusionTimely(ParticipationFlag(7), 1, spec) {
+		t.Error("Unknown flag should never be timely")
+	}
+}
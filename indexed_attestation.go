@@ -0,0 +1,198 @@
+package types
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+const (
+	// MaxValidatorsPerCommittee is the limit of attesting indices of IndexedAttestation.
+	MaxValidatorsPerCommittee = 2048
+	// MaxAttestingIndicesElectra is the limit of attesting indices of IndexedAttestationElectra
+	// (MAX_VALIDATORS_PER_COMMITTEE * MAX_COMMITTEES_PER_SLOT).
+	MaxAttestingIndicesElectra = 2048 * 64
+)
+
+// indexedAttestationFixedSize is the size of the fixed part: indices offset, data and signature.
+const indexedAttestationFixedSize = 4 + 128 + 96
+
+// ErrInvalidIndexedAttestation is returned when attesting indices are empty or not sorted and unique.
+var ErrInvalidIndexedAttestation = errors.New("invalid indexed attestation")
+
+// IndexedAttestation is the spec (pre-Electra) IndexedAttestation container.
+type IndexedAttestation struct {
+	AttestingIndices []ValidatorIndex `json:"attesting_indices"`
+	Data             AttestationData  `json:"data"`
+	Signature        BLSSignature     `json:"signature"`
+}
+
+// IndexedAttestationElectra is the Electra IndexedAttestation container, which only differs by the
+// limit of attesting indices (attestations may span all committees of the slot).
+type IndexedAttestationElectra struct {
+	AttestingIndices []ValidatorIndex `json:"attesting_indices"`
+	Data             AttestationData  `json:"data"`
+	Signature        BLSSignature     `json:"signature"`
+}
+
+// IsSlashable returns true if two attestations (of the same validator) are slashable, i.e. they are
+// either a double vote or a surround vote, see is_slashable_attestation_data.
+func IsSlashable(a, b *AttestationData) bool {
+	return IsDoubleVote(a, b) || IsSurroundVote(a, b)
+}
+
+// IsDoubleVote returns true if attestations are different votes for the same target epoch.
+func IsDoubleVote(a, b *AttestationData) bool {
+	return *a != *b && a.Target.Epoch == b.Target.Epoch
+}
+
+// IsSurroundVote returns true if the first attestation surrounds the second one.
+func IsSurroundVote(a, b *AttestationData) bool {
+	return a.Source.Epoch < b.Source.Epoch && b.Target.Epoch < a.Target.Epoch
+}
+
+// Validate checks that attesting indices are non-empty, sorted and unique, and within the limit.
+func (a *IndexedAttestation) Validate() error {
+	return validateAttestingIndices(a.AttestingIndices, MaxValidatorsPerCommittee)
+}
+
+// HashTreeRoot returns calculated hash root.
+func (a *IndexedAttestation) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	err := a.HashTreeRootInto(&root)
+	return root, err
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (a *IndexedAttestation) HashTreeRootInto(dst *[32]byte) error {
+	return hashTreeRootIndexedAttestationInto(dst, a.AttestingIndices, &a.Data, &a.Signature, MaxValidatorsPerCommittee)
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the indexed attestation object.
+func (a *IndexedAttestation) UnmarshalSSZ(buf []byte) error {
+	indices, err := unmarshalIndexedAttestation(buf, &a.Data, &a.Signature, MaxValidatorsPerCommittee)
+	if err != nil {
+		return err
+	}
+	a.AttestingIndices = indices
+	return nil
+}
+
+// MarshalSSZTo marshals indexed attestation with the provided byte slice.
+func (a *IndexedAttestation) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return marshalIndexedAttestation(dst, a.AttestingIndices, &a.Data, &a.Signature, MaxValidatorsPerCommittee)
+}
+
+// MarshalSSZ marshals indexed attestation into a serialized object.
+func (a *IndexedAttestation) MarshalSSZ() ([]byte, error) {
+	return a.MarshalSSZTo(make([]byte, 0, a.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (a *IndexedAttestation) SizeSSZ() int {
+	return indexedAttestationFixedSize + len(a.AttestingIndices)*8
+}
+
+// Validate checks that attesting indices are non-empty, sorted and unique, and within the limit.
+func (a *IndexedAttestationElectra) Validate() error {
+	return validateAttestingIndices(a.AttestingIndices, MaxAttestingIndicesElectra)
+}
+
+// HashTreeRoot returns calculated hash root.
+func (a *IndexedAttestationElectra) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	err := a.HashTreeRootInto(&root)
+	return root, err
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (a *IndexedAttestationElectra) HashTreeRootInto(dst *[32]byte) error {
+	return hashTreeRootIndexedAttestationInto(dst, a.AttestingIndices, &a.Data, &a.Signature, MaxAttestingIndicesElectra)
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the indexed attestation object.
+func (a *IndexedAttestationElectra) UnmarshalSSZ(buf []byte) error {
+	indices, err := unmarshalIndexedAttestation(buf, &a.Data, &a.Signature, MaxAttestingIndicesElectra)
+	if err != nil {
+		return err
+	}
+	a.AttestingIndices = indices
+	return nil
+}
+
+// MarshalSSZTo marshals indexed attestation with the provided byte slice.
+func (a *IndexedAttestationElectra) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return marshalIndexedAttestation(dst, a.AttestingIndices, &a.Data, &a.Signature, MaxAttestingIndicesElectra)
+}
+
+// MarshalSSZ marshals indexed attestation into a serialized object.
+func (a *IndexedAttestationElectra) MarshalSSZ() ([]byte, error) {
+	return a.MarshalSSZTo(make([]byte, 0, a.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (a *IndexedAttestationElectra) SizeSSZ() int {
+	return indexedAttestationFixedSize + len(a.AttestingIndices)*8
+}
+
+func validateAttestingIndices(indices []ValidatorIndex, limit uint64) error {
+	if len(indices) == 0 {
+		return fmt.Errorf("%w: no attesting indices", ErrInvalidIndexedAttestation)
+	}
+	if err := validateListLength(len(indices), limit); err != nil {
+		return err
+	}
+	for i := 1; i < len(indices); i++ {
+		if indices[i] <= indices[i-1] {
+			return fmt.Errorf("%w: attesting indices are not sorted and unique", ErrInvalidIndexedAttestation)
+		}
+	}
+	return nil
+}
+
+func hashTreeRootIndexedAttestationInto(dst *[32]byte, indices []ValidatorIndex, data *AttestationData, sig *BLSSignature, limit uint64) error {
+	var chunks [3][32]byte
+	var err error
+	if chunks[0], err = hashTreeRootUint64List(indices, limit); err != nil {
+		return err
+	}
+	if err := data.HashTreeRootInto(&chunks[1]); err != nil {
+		return err
+	}
+	_ = sig.HashTreeRootInto(&chunks[2])
+	return merkleizeInto(dst, chunks[:], 0)
+}
+
+func unmarshalIndexedAttestation(buf []byte, data *AttestationData, sig *BLSSignature, limit uint64) ([]ValidatorIndex, error) {
+	if err := sszutil.CheckMinLength(len(buf), indexedAttestationFixedSize); err != nil {
+		return nil, err
+	}
+	if err := sszutil.CheckOffset(binary.LittleEndian.Uint32(buf[:4]), indexedAttestationFixedSize); err != nil {
+		return nil, err
+	}
+	tail := buf[indexedAttestationFixedSize:]
+	if err := validateListLength(len(tail)/8, limit); err != nil {
+		return nil, err
+	}
+	indices, err := unmarshalUint64List[ValidatorIndex](tail)
+	if err != nil {
+		return nil, err
+	}
+	if err := data.UnmarshalSSZ(buf[4:132]); err != nil {
+		return nil, err
+	}
+	copy(sig[:], buf[132:228])
+	return indices, nil
+}
+
+func marshalIndexedAttestation(dst []byte, indices []ValidatorIndex, data *AttestationData, sig *BLSSignature, limit uint64) ([]byte, error) {
+	if err := validateListLength(len(indices), limit); err != nil {
+		return dst, err
+	}
+	dst = append(dst, indexedAttestationFixedSize, 0, 0, 0)
+	dst, _ = data.MarshalSSZTo(dst)
+	dst = append(dst, sig[:]...)
+	return marshalUint64List(dst, indices), nil
+}
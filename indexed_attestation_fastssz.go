@@ -0,0 +1,32 @@
+//go:build !nofastssz
+
+package types
+
+import fssz "github.com/ferranbt/fastssz"
+
+var _ fssz.HashRoot = (*IndexedAttestation)(nil)
+var _ fssz.Marshaler = (*IndexedAttestation)(nil)
+var _ fssz.Unmarshaler = (*IndexedAttestation)(nil)
+var _ fssz.HashRoot = (*IndexedAttestationElectra)(nil)
+var _ fssz.Marshaler = (*IndexedAttestationElectra)(nil)
+var _ fssz.Unmarshaler = (*IndexedAttestationElectra)(nil)
+
+// HashTreeRootWith appends indexed attestation root to the provided hasher.
+func (a *IndexedAttestation) HashTreeRootWith(hh *fssz.Hasher) error {
+	root, err := a.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	hh.PutBytes(root[:])
+	return nil
+}
+
+// HashTreeRootWith appends indexed attestation root to the provided hasher.
+func (a *IndexedAttestationElectra) HashTreeRootWith(hh *fssz.Hasher) error {
+	root, err := a.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	hh.PutBytes(root[:])
+	return nil
+}
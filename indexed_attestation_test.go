@@ -0,0 +1,106 @@
+package types
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestIndexedAttestation_SSZ(t *testing.T) {
+	a := testIndexedAttestation()
+	enc, err := a.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(enc) != a.SizeSSZ() || len(enc) != 228+24 {
+		t.Errorf("Unexpected length: %d", len(enc))
+	}
+	decoded := &IndexedAttestation{}
+	if err := decoded.UnmarshalSSZ(enc); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, a) {
+		t.Errorf("Unexpected attestation: %+v", decoded)
+	}
+
+	if err := decoded.UnmarshalSSZ(enc[:227]); !errors.Is(err, ErrInvalidSSZLength) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if err := decoded.UnmarshalSSZ(enc[:len(enc)-1]); !errors.Is(err, ErrInvalidSSZLength) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	enc[0]++
+	if err := decoded.UnmarshalSSZ(enc); err == nil {
+		t.Error("Expected error for invalid offset")
+	}
+
+	// Electra limit allows more than a single committee worth of indices.
+	indices := make([]ValidatorIndex, MaxValidatorsPerCommittee+1)
+	for i := range indices {
+		indices[i] = ValidatorIndex(i)
+	}
+	a.AttestingIndices = indices
+	if _, err := a.MarshalSSZ(); !errors.Is(err, ErrListTooLong) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	electra := &IndexedAttestationElectra{AttestingIndices: indices, Data: a.Data}
+	enc, err = electra.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := decoded.UnmarshalSSZ(enc); !errors.Is(err, ErrListTooLong) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	decodedElectra := &IndexedAttestationElectra{}
+	if err := decodedElectra.UnmarshalSSZ(enc); err != nil || len(decodedElectra.AttestingIndices) != len(indices) {
+		t.Errorf("Unexpected attestation: %v", err)
+	}
+	if err := decodedElectra.Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestIndexedAttestation_Validate(t *testing.T) {
+	a := testIndexedAttestation()
+	if err := a.Validate(); err != nil {
+		t.Error(err)
+	}
+	for _, indices := range [][]ValidatorIndex{nil, {1, 1}, {2, 1}} {
+		a.AttestingIndices = indices
+		if err := a.Validate(); !errors.Is(err, ErrInvalidIndexedAttestation) {
+			t.Errorf("Unexpected error for %v: %v", indices, err)
+		}
+	}
+}
+
+func TestIsSlashable(t *testing.T) {
+	vote := func(source, target Epoch, root byte) *AttestationData {
+		return &AttestationData{
+			BeaconBlockRoot: BlockRoot{Root{root}},
+			Source:          Checkpoint{Epoch: source},
+			Target:          Checkpoint{Epoch: target},
+		}
+	}
+	tests := []struct {
+		name     string
+		a, b     *AttestationData
+		double   bool
+		surround bool
+	}{
+		{name: "same vote", a: vote(1, 2, 1), b: vote(1, 2, 1)},
+		{name: "double vote", a: vote(1, 2, 1), b: vote(1, 2, 2), double: true},
+		{name: "surround vote", a: vote(1, 5, 1), b: vote(2, 4, 1), surround: true},
+		{name: "surrounded vote", a: vote(2, 4, 1), b: vote(1, 5, 1)},
+		{name: "consecutive votes", a: vote(1, 2, 1), b: vote(2, 3, 1)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if IsDoubleVote(tt.a, tt.b) != tt.double || IsSurroundVote(tt.a, tt.b) != tt.surround {
+				t.Error("Unexpected vote classification")
+			}
+			if IsSlashable(tt.a, tt.b) != (tt.double || tt.surround) {
+				t.Error("Unexpected slashability")
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+// Package interchange implements the slashing protection interchange format (EIP-3076), used to
+// move slashing protection history between validator clients.
+package interchange
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+// FormatVersion is the supported version of the interchange format.
+const FormatVersion = "5"
+
+var (
+	// ErrUnsupportedVersion is returned when interchange file has unexpected format version.
+	ErrUnsupportedVersion = errors.New("unsupported interchange format version")
+	// ErrGenesisValidatorsRootMismatch is returned when interchange file belongs to another network.
+	ErrGenesisValidatorsRootMismatch = errors.New("genesis validators root mismatch")
+	// ErrSourceAfterTarget is returned for attestations with source epoch greater than target epoch.
+	ErrSourceAfterTarget = errors.New("attestation source epoch is greater than target epoch")
+)
+
+// Interchange is the top level object of the interchange file.
+type Interchange struct {
+	Metadata Metadata        `json:"metadata"`
+	Data     []ValidatorData `json:"data"`
+}
+
+// Metadata describes the interchange file.
+type Metadata struct {
+	InterchangeFormatVersion string     `json:"interchange_format_version"`
+	GenesisValidatorsRoot    types.Root `json:"genesis_validators_root"`
+}
+
+// ValidatorData holds signing history of a single validator.
+type ValidatorData struct {
+	Pubkey             types.BLSPubkey     `json:"pubkey"`
+	SignedBlocks       []SignedBlock       `json:"signed_blocks"`
+	SignedAttestations []SignedAttestation `json:"signed_attestations"`
+}
+
+// SignedBlock is a record of a signed block proposal.
+type SignedBlock struct {
+	Slot        types.Slot  `json:"slot"`
+	SigningRoot *types.Root `json:"signing_root,omitempty"`
+}
+
+// SignedAttestation is a record of a signed attestation.
+type SignedAttestation struct {
+	SourceEpoch types.Epoch `json:"source_epoch"`
+	TargetEpoch types.Epoch `json:"target_epoch"`
+	SigningRoot *types.Root `json:"signing_root,omitempty"`
+}
+
+// New returns empty interchange for the network with the given genesis validators root.
+func New(genesisValidatorsRoot types.Root) *Interchange {
+	return &Interchange{
+		Metadata: Metadata{
+			InterchangeFormatVersion: FormatVersion,
+			GenesisValidatorsRoot:    genesisValidatorsRoot,
+		},
+		Data: []ValidatorData{},
+	}
+}
+
+// Validate checks format version and consistency of the signing history.
+func (i *Interchange) Validate() error {
+	if i.Metadata.InterchangeFormatVersion != FormatVersion {
+		return fmt.Errorf("%w: %q", ErrUnsupportedVersion, i.Metadata.InterchangeFormatVersion)
+	}
+	for _, data := range i.Data {
+		for _, att := range data.SignedAttestations {
+			if att.SourceEpoch > att.TargetEpoch {
+				return fmt.Errorf("%w: validator %s, source %d, target %d",
+					ErrSourceAfterTarget, data.Pubkey, att.SourceEpoch, att.TargetEpoch)
+			}
+		}
+	}
+	return nil
+}
+
+// Import reads and validates interchange file, checking that it belongs to the network with
+// the given genesis validators root.
+func Import(r io.Reader, genesisValidatorsRoot types.Root) (*Interchange, error) {
+	var i Interchange
+	if err := json.NewDecoder(r).Decode(&i); err != nil {
+		return nil, fmt.Errorf("could not decode interchange: %w", err)
+	}
+	if err := i.Validate(); err != nil {
+		return nil, err
+	}
+	if i.Metadata.GenesisValidatorsRoot != genesisValidatorsRoot {
+		return nil, fmt.Errorf("%w: %s != %s",
+			ErrGenesisValidatorsRootMismatch, i.Metadata.GenesisValidatorsRoot, genesisValidatorsRoot)
+	}
+	return &i, nil
+}
+
+// Export validates interchange and writes it to w.
+func Export(w io.Writer, i *Interchange) error {
+	if err := i.Validate(); err != nil {
+		return err
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(i)
+}
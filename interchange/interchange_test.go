@@ -0,0 +1,117 @@
+package interchange
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+// eipExample is the complete example from EIP-3076.
+const eipExample = `{
+  "metadata": {
+    "interchange_format_version": "5",
+    "genesis_validators_root": "0x04700007fabc8282644aed6d1c7c9e21d38a03a0c4ba193f3afe428824b3a673"
+  },
+  "data": [
+    {
+      "pubkey": "0xb845089a1457f811bfc000588fbb4e713669be8ce060ea6be3c6ece09afc3794106c91ca73acda5e5457122d58723bed",
+      "signed_blocks": [
+        {
+          "slot": "81952",
+          "signing_root": "0x4ff6f743a43f3b4f95350831aeaf0a122a1a392922c45d804280284a69eb850b"
+        },
+        {
+          "slot": "81951"
+        }
+      ],
+      "signed_attestations": [
+        {
+          "source_epoch": "2290",
+          "target_epoch": "3007",
+          "signing_root": "0x587d6a4f59a58fe24f406e0502413e77fe1babddee641fda30034ed37ecc884d"
+        },
+        {
+          "source_epoch": "2290",
+          "target_epoch": "3008"
+        }
+      ]
+    }
+  ]
+}`
+
+func genesisValidatorsRoot(t *testing.T) types.Root {
+	var root types.Root
+	if err := root.UnmarshalText([]byte("0x04700007fabc8282644aed6d1c7c9e21d38a03a0c4ba193f3afe428824b3a673")); err != nil {
+		t.Fatal(err)
+	}
+	return root
+}
+
+func TestImportExport(t *testing.T) {
+	i, err := Import(strings.NewReader(eipExample), genesisValidatorsRoot(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(i.Data) != 1 || len(i.Data[0].SignedBlocks) != 2 || len(i.Data[0].SignedAttestations) != 2 {
+		t.Fatalf("Unexpected data: %+v", i.Data)
+	}
+	if b := i.Data[0].SignedBlocks[1]; b.Slot != 81951 || b.SigningRoot != nil {
+		t.Errorf("Unexpected block: %+v", b)
+	}
+	if a := i.Data[0].SignedAttestations[0]; a.SourceEpoch != 2290 || a.TargetEpoch != 3007 || a.SigningRoot == nil {
+		t.Errorf("Unexpected attestation: %+v", a)
+	}
+
+	var buf bytes.Buffer
+	if err := Export(&buf, i); err != nil {
+		t.Fatal(err)
+	}
+	reimported, err := Import(&buf, genesisValidatorsRoot(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(reimported, i) {
+		t.Error("Interchange changed after export and import")
+	}
+}
+
+func TestImport_Invalid(t *testing.T) {
+	if _, err := Import(strings.NewReader(eipExample), types.Root{}); !errors.Is(err, ErrGenesisValidatorsRootMismatch) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	oldVersion := strings.Replace(eipExample, `"interchange_format_version": "5"`, `"interchange_format_version": "4"`, 1)
+	if _, err := Import(strings.NewReader(oldVersion), genesisValidatorsRoot(t)); !errors.Is(err, ErrUnsupportedVersion) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	surround := strings.Replace(eipExample, `"source_epoch": "2290",
+          "target_epoch": "3008"`, `"source_epoch": "3009",
+          "target_epoch": "3008"`, 1)
+	if _, err := Import(strings.NewReader(surround), genesisValidatorsRoot(t)); !errors.Is(err, ErrSourceAfterTarget) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	badSlot := strings.Replace(eipExample, `"slot": "81951"`, `"slot": "-1"`, 1)
+	if _, err := Import(strings.NewReader(badSlot), genesisValidatorsRoot(t)); err == nil {
+		t.Error("Expected error on invalid slot")
+	}
+}
+
+func TestNew(t *testing.T) {
+	i := New(types.Root{0x01})
+	if err := i.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := Export(&buf, i); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(buf.String(), `"data": []`) {
+		t.Errorf("Data should be encoded as empty list: %s", buf.String())
+	}
+}
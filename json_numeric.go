@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Numeric wraps uint64-backed value, so that it is encoded as a raw JSON number instead of the
+// quoted string used by the Beacon API (which remains the default encoding of the types themselves).
+// It is intended for internal APIs preferring numbers, e.g.:
+//
+//	type status struct {
+//		HeadSlot types.Numeric[types.Slot] `json:"head_slot"`
+//	}
+//
+// Decoding accepts both numbers and quoted strings.
+type Numeric[T Uint64Like] struct {
+	Value T
+}
+
+// AsNumeric wraps value for numeric JSON encoding.
+func AsNumeric[T Uint64Like](v T) Numeric[T] {
+	return Numeric[T]{Value: v}
+}
+
+// AsNumericSlice wraps each of the values for numeric JSON encoding.
+func AsNumericSlice[T Uint64Like](values []T) []Numeric[T] {
+	wrapped := make([]Numeric[T], len(values))
+	for i, v := range values {
+		wrapped[i] = Numeric[T]{Value: v}
+	}
+	return wrapped
+}
+
+// MarshalJSON encodes value as a JSON number.
+func (n Numeric[T]) MarshalJSON() ([]byte, error) {
+	return strconv.AppendUint(make([]byte, 0, 20), uint64(n.Value), 10), nil
+}
+
+// UnmarshalJSON decodes value from either a JSON number or a quoted decimal string.
+func (n *Numeric[T]) UnmarshalJSON(data []byte) error {
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		data = data[1 : len(data)-1]
+	}
+	v, err := strconv.ParseUint(string(data), 10, 64)
+	if err != nil {
+		return fmt.Errorf("could not parse numeric value: %w", err)
+	}
+	n.Value = T(v)
+	return nil
+}
+
+// String returns decimal representation of the value.
+func (n Numeric[T]) String() string {
+	return strconv.FormatUint(uint64(n.Value), 10)
+}
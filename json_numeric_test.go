@@ -0,0 +1,34 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNumeric_JSON(t *testing.T) {
+	type status struct {
+		HeadSlot  Numeric[Slot]   `json:"head_slot"`
+		Finalized Epoch           `json:"finalized"`
+		Indices   []Numeric[Gwei] `json:"indices"`
+	}
+	s := status{HeadSlot: AsNumeric(Slot(100)), Finalized: 3, Indices: AsNumericSlice([]Gwei{1, 2})}
+	enc, err := json.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"head_slot":100,"finalized":"3","indices":[1,2]}`
+	if string(enc) != want {
+		t.Errorf("Unexpected JSON: %s", enc)
+	}
+
+	var decoded status
+	if err := json.Unmarshal([]byte(`{"head_slot":"100","finalized":"3","indices":[1,"2"]}`), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.HeadSlot.Value != 100 || len(decoded.Indices) != 2 || decoded.Indices[1].Value != 2 {
+		t.Errorf("Unexpected status: %+v", decoded)
+	}
+	if err := json.Unmarshal([]byte(`{"head_slot":-1}`), &decoded); err == nil {
+		t.Error("Expected error for negative value")
+	}
+}
@@ -0,0 +1,214 @@
+//go:build jsonschema
+
+package types
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/invopop/jsonschema"
+)
+
+// JSON Schema support: the methods below implement the custom schema interface of
+// github.com/invopop/jsonschema (JSONSchema() *jsonschema.Schema), so that schemas and OpenAPI
+// definitions reflected from structs embedding the types describe their actual JSON encoding:
+// decimal strings for integers and 0x-prefixed hex strings for byte vectors. The file is only built
+// with `jsonschema` tag, keeping the dependency out of the default build.
+
+// JSONSchema returns schema of the slot JSON encoding.
+func (Slot) JSONSchema() *jsonschema.Schema {
+	return uint64Schema("Slot", "Slot number.")
+}
+
+// JSONSchema returns schema of the epoch JSON encoding.
+func (Epoch) JSONSchema() *jsonschema.Schema {
+	return uint64Schema("Epoch", "Epoch number.")
+}
+
+// JSONSchema returns schema of the validator index JSON encoding.
+func (ValidatorIndex) JSONSchema() *jsonschema.Schema {
+	return uint64Schema("ValidatorIndex", "Index of a validator in the registry.")
+}
+
+// JSONSchema returns schema of the gwei JSON encoding.
+func (Gwei) JSONSchema() *jsonschema.Schema {
+	return uint64Schema("Gwei", "Amount in gwei.")
+}
+
+// JSONSchema returns schema of the committee index JSON encoding.
+func (CommitteeIndex) JSONSchema() *jsonschema.Schema {
+	return uint64Schema("CommitteeIndex", "Index of a committee within a slot.")
+}
+
+// JSONSchema returns schema of the sync committee index JSON encoding.
+func (SyncCommitteeIndex) JSONSchema() *jsonschema.Schema {
+	return uint64Schema("SyncCommitteeIndex", "Index of a validator in the sync committee.")
+}
+
+// JSONSchema returns schema of the subnet ID JSON encoding.
+func (SubnetID) JSONSchema() *jsonschema.Schema {
+	return uint64Schema("SubnetID", "Gossip subnet identifier.")
+}
+
+// JSONSchema returns schema of the withdrawal index JSON encoding.
+func (WithdrawalIndex) JSONSchema() *jsonschema.Schema {
+	return uint64Schema("WithdrawalIndex", "Global withdrawal index.")
+}
+
+// JSONSchema returns schema of the gas limit JSON encoding.
+func (GasLimit) JSONSchema() *jsonschema.Schema {
+	return uint64Schema("GasLimit", "Execution block gas limit.")
+}
+
+// JSONSchema returns schema of the uint256 JSON encoding.
+func (Uint256) JSONSchema() *jsonschema.Schema {
+	return uint256Schema("Uint256", "Unsigned 256-bit integer.")
+}
+
+// JSONSchema returns schema of the bid value JSON encoding.
+func (BidValue) JSONSchema() *jsonschema.Schema {
+	return uint256Schema("BidValue", "Builder bid value in wei.")
+}
+
+// JSONSchema returns schema of the root JSON encoding.
+func (Root) JSONSchema() *jsonschema.Schema {
+	return hexSchema("Root", 32, "32 byte hash tree root.")
+}
+
+// JSONSchema returns schema of the typed root JSON encoding.
+func (TypedRoot[K]) JSONSchema() *jsonschema.Schema {
+	switch interface{}(*new(K)).(type) {
+	case blockRootKind:
+		return hexSchema("BlockRoot", 32, "Hash tree root of a beacon block.")
+	case stateRootKind:
+		return hexSchema("StateRoot", 32, "Hash tree root of a beacon state.")
+	default:
+		return hexSchema("BodyRoot", 32, "Hash tree root of a beacon block body.")
+	}
+}
+
+// JSONSchema returns schema of the domain JSON encoding.
+func (Domain) JSONSchema() *jsonschema.Schema {
+	return hexSchema("Domain", 32, "Signing domain.")
+}
+
+// JSONSchema returns schema of the node ID JSON encoding.
+func (NodeID) JSONSchema() *jsonschema.Schema {
+	return hexSchema("NodeID", 32, "Node identifier.")
+}
+
+// JSONSchema returns schema of the public key JSON encoding.
+func (BLSPubkey) JSONSchema() *jsonschema.Schema {
+	return hexSchema("BLSPubkey", 48, "Compressed BLS public key.")
+}
+
+// JSONSchema returns schema of the signature JSON encoding.
+func (BLSSignature) JSONSchema() *jsonschema.Schema {
+	return hexSchema("BLSSignature", 96, "Compressed BLS signature.")
+}
+
+// JSONSchema returns schema of the execution address JSON encoding.
+func (ExecutionAddress) JSONSchema() *jsonschema.Schema {
+	return hexSchema("ExecutionAddress", 20, "Execution layer address.")
+}
+
+// JSONSchema returns schema of the fork version JSON encoding.
+func (ForkVersion) JSONSchema() *jsonschema.Schema {
+	return hexSchema("ForkVersion", 4, "Fork version.")
+}
+
+// JSONSchema returns schema of the fork digest JSON encoding.
+func (ForkDigest) JSONSchema() *jsonschema.Schema {
+	return hexSchema("ForkDigest", 4, "Fork digest.")
+}
+
+// JSONSchema returns schema of the domain type JSON encoding.
+func (DomainType) JSONSchema() *jsonschema.Schema {
+	return hexSchema("DomainType", 4, "Signing domain type.")
+}
+
+// JSONSchema returns schema of the bitvector JSON encoding.
+func (Bitvector4) JSONSchema() *jsonschema.Schema {
+	return hexSchema("Bitvector4", 1, "SSZ Bitvector[4].")
+}
+
+// JSONSchema returns schema of the bitvector JSON encoding.
+func (Bitvector64) JSONSchema() *jsonschema.Schema {
+	return hexSchema("Bitvector64", 8, "SSZ Bitvector[64].")
+}
+
+// JSONSchema returns schema of the bitvector JSON encoding.
+func (Bitvector512) JSONSchema() *jsonschema.Schema {
+	return hexSchema("Bitvector512", 64, "SSZ Bitvector[512].")
+}
+
+// JSONSchema returns schema of the version JSON encoding.
+func (Version) JSONSchema() *jsonschema.Schema {
+	return enumSchema("Version", "Consensus fork name.", versionNames[:])
+}
+
+// JSONSchema returns schema of the validator status JSON encoding.
+func (ValidatorStatus) JSONSchema() *jsonschema.Schema {
+	return enumSchema("ValidatorStatus", "Validator status.", validatorStatusNames[:])
+}
+
+// JSONSchemaDefinitions returns schemas of all types with custom JSON encoding keyed by type name,
+// e.g. to be merged into components/schemas of an OpenAPI document.
+func JSONSchemaDefinitions() map[string]*jsonschema.Schema {
+	values := []interface{ JSONSchema() *jsonschema.Schema }{
+		Slot(0), Epoch(0), ValidatorIndex(0), Gwei(0), CommitteeIndex(0), SyncCommitteeIndex(0), SubnetID(0),
+		WithdrawalIndex(0), GasLimit(0), Uint256{}, BidValue{}, Root{}, BlockRoot{}, StateRoot{}, BodyRoot{},
+		Domain{}, NodeID{}, BLSPubkey{}, BLSSignature{}, ExecutionAddress{}, ForkVersion{}, ForkDigest{},
+		DomainType{}, Bitvector4{}, Bitvector64{}, Bitvector512{}, Version(0), ValidatorStatus(0),
+	}
+	defs := make(map[string]*jsonschema.Schema, len(values))
+	for _, v := range values {
+		schema := v.JSONSchema()
+		defs[schema.Title] = schema
+	}
+	return defs
+}
+
+// JSONSchemaNamer names definitions of typed roots by their aliases (e.g. BlockRoot) rather than by
+// instantiations of TypedRoot, to be set as jsonschema.Reflector.Namer. Other types are left to the
+// reflector's default naming.
+func JSONSchemaNamer(t reflect.Type) string {
+	if t.Kind() == reflect.Ptr || t.Kind() == reflect.Interface {
+		return ""
+	}
+	if v, ok := reflect.Zero(t).Interface().(interface{ JSONSchema() *jsonschema.Schema }); ok {
+		return v.JSONSchema().Title
+	}
+	return ""
+}
+
+func uint64Schema(title, description string) *jsonschema.Schema {
+	return &jsonschema.Schema{
+		Type: "string", Title: title, Pattern: "^[0-9]{1,20}$",
+		Description: description + " Unsigned 64-bit integer.", Examples: []interface{}{"1"},
+	}
+}
+
+func uint256Schema(title, description string) *jsonschema.Schema {
+	return &jsonschema.Schema{
+		Type: "string", Title: title, Pattern: "^[0-9]{1,78}$", Description: description, Examples: []interface{}{"1"},
+	}
+}
+
+func hexSchema(title string, size int, description string) *jsonschema.Schema {
+	return &jsonschema.Schema{
+		Type:        "string",
+		Title:       title,
+		Pattern:     fmt.Sprintf("^0x[a-fA-F0-9]{%d}$", size*2),
+		Description: description + " 0x-prefixed hex encoded bytes.",
+		Examples:    []interface{}{string(appendHex(nil, make([]byte, size)))},
+	}
+}
+
+func enumSchema(title, description string, names []string) *jsonschema.Schema {
+	enum := make([]interface{}, len(names))
+	for i, name := range names {
+		enum[i] = name
+	}
+	return &jsonschema.Schema{Type: "string", Title: title, Enum: enum, Description: description, Examples: enum[:1]}
+}
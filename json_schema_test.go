@@ -0,0 +1,84 @@
+//go:build jsonschema
+
+package types
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/invopop/jsonschema"
+)
+
+func TestJSONSchema(t *testing.T) {
+	values := []interface{ JSONSchema() *jsonschema.Schema }{
+		Slot(123), Epoch(1), ValidatorIndex(9), Gwei(32_000_000_000), CommitteeIndex(3), SyncCommitteeIndex(4),
+		SubnetID(5), WithdrawalIndex(6), GasLimit(30_000_000), NewUint256(7), BidValue{Wei: NewUint256(8)},
+		Root{0xab}, BlockRoot{Root{1}}, StateRoot{Root{2}}, BodyRoot{Root{3}}, Domain{4}, NodeID{5},
+		BLSPubkey{6}, BLSSignature{7}, ExecutionAddress{8}, ForkVersion{9}, ForkDigest{10}, DomainType{11},
+		Bitvector4{1}, Bitvector64{2}, Bitvector512{3}, Deneb, ValidatorStatus(0),
+	}
+	for _, v := range values {
+		schema := v.JSONSchema()
+		enc, err := json.Marshal(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var s string
+		if err := json.Unmarshal(enc, &s); err != nil {
+			t.Errorf("%T is not encoded as a string: %s", v, enc)
+			continue
+		}
+		if schema.Pattern != "" && !regexp.MustCompile(schema.Pattern).MatchString(s) {
+			t.Errorf("Encoding %q of %T doesn't match pattern %s", s, v, schema.Pattern)
+		}
+		if schema.Enum != nil && !contains(schema.Enum, s) {
+			t.Errorf("Encoding %q of %T is not in enum", s, v)
+		}
+	}
+	if len(JSONSchemaDefinitions()) != len(values) {
+		t.Errorf("Unexpected number of definitions: %d", len(JSONSchemaDefinitions()))
+	}
+}
+
+func TestJSONSchema_Reflect(t *testing.T) {
+	r := &jsonschema.Reflector{DoNotReference: true}
+	schema := r.Reflect(&Checkpoint{})
+	epoch, ok := schema.Properties.Get("epoch")
+	if !ok || epoch.Title != "Epoch" || epoch.Pattern != "^[0-9]{1,20}$" {
+		t.Errorf("Unexpected epoch schema: %+v", epoch)
+	}
+	root, ok := schema.Properties.Get("root")
+	if !ok || root.Title != "BlockRoot" || root.Pattern != "^0x[a-fA-F0-9]{64}$" {
+		t.Errorf("Unexpected root schema: %+v", root)
+	}
+
+	r = &jsonschema.Reflector{Namer: JSONSchemaNamer}
+	schema = r.Reflect(&AttestationData{})
+	if _, ok := schema.Definitions["BlockRoot"]; !ok {
+		t.Errorf("Unexpected definitions: %v", schema.Definitions)
+	}
+	if name := JSONSchemaNamer(reflect.TypeOf(new(Slot))); name != "" {
+		t.Errorf("Unexpected name of pointer type: %s", name)
+	}
+
+	enc, err := json.Marshal(JSONSchemaDefinitions()["ForkVersion"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"type":"string","pattern":"^0x[a-fA-F0-9]{8}$","title":"ForkVersion",` +
+		`"description":"Fork version. 0x-prefixed hex encoded bytes.","examples":["0x00000000"]}`
+	if string(enc) != want {
+		t.Errorf("Unexpected JSON: %s", enc)
+	}
+}
+
+func contains(values []interface{}, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"bytes"
+	"strconv"
+)
+
+// maxQuotedUint64Len is the length of the longest uint64 JSON string including quotes and separator.
+const maxQuotedUint64Len = 20 + 3
+
+// AppendJSONSlice appends values to dst as a JSON array of quoted decimal strings (as expected by
+// the Beacon API), e.g. ["1","2"]. Unlike encoding/json, neither reflection nor per-element
+// interface boxing is involved. Nil slice is encoded as empty array.
+func AppendJSONSlice[T Uint64Like](dst []byte, values []T) []byte {
+	dst = append(dst, '[')
+	for i, v := range values {
+		if i > 0 {
+			dst = append(dst, ',')
+		}
+		dst = append(dst, '"')
+		dst = strconv.AppendUint(dst, uint64(v), 10)
+		dst = append(dst, '"')
+	}
+	return append(dst, ']')
+}
+
+// WriteJSONSlice writes values to buf as a JSON array of quoted decimal strings, see AppendJSONSlice.
+// Buffer is grown upfront, so large listings are written without intermediate reallocations.
+func WriteJSONSlice[T Uint64Like](buf *bytes.Buffer, values []T) {
+	buf.Grow(2 + len(values)*maxQuotedUint64Len)
+	var scratch [maxQuotedUint64Len]byte
+	buf.WriteByte('[')
+	for i, v := range values {
+		item := scratch[:0]
+		if i > 0 {
+			item = append(item, ',')
+		}
+		item = append(item, '"')
+		item = strconv.AppendUint(item, uint64(v), 10)
+		item = append(item, '"')
+		buf.Write(item)
+	}
+	buf.WriteByte(']')
+}
+
+// WriteValidatorIndicesJSON writes validator indices to buf as a JSON array of quoted decimals.
+func WriteValidatorIndicesJSON(buf *bytes.Buffer, indices []ValidatorIndex) {
+	WriteJSONSlice(buf, indices)
+}
+
+// WriteSlotsJSON writes slots to buf as a JSON array of quoted decimals.
+func WriteSlotsJSON(buf *bytes.Buffer, slots []Slot) {
+	WriteJSONSlice(buf, slots)
+}
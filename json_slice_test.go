@@ -0,0 +1,62 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestWriteJSONSlice(t *testing.T) {
+	tests := []struct {
+		name    string
+		indices []ValidatorIndex
+	}{
+		{name: "nil", indices: nil},
+		{name: "empty", indices: []ValidatorIndex{}},
+		{name: "single", indices: []ValidatorIndex{0}},
+		{name: "many", indices: []ValidatorIndex{1, 42, 1 << 40, 1<<64 - 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := []byte("[]")
+			if len(tt.indices) > 0 {
+				var err error
+				if want, err = json.Marshal(tt.indices); err != nil {
+					t.Fatal(err)
+				}
+			}
+			var buf bytes.Buffer
+			WriteValidatorIndicesJSON(&buf, tt.indices)
+			if !bytes.Equal(buf.Bytes(), want) {
+				t.Errorf("Unexpected encoding: %s, expected %s", buf.Bytes(), want)
+			}
+			if got := AppendJSONSlice([]byte("x"), tt.indices); !bytes.Equal(got, append([]byte("x"), want...)) {
+				t.Errorf("Unexpected appended encoding: %s", got)
+			}
+		})
+	}
+
+	var buf bytes.Buffer
+	WriteSlotsJSON(&buf, []Slot{7, 8})
+	var decoded []Slot
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded) != 2 || decoded[0] != 7 || decoded[1] != 8 {
+		t.Errorf("Unexpected round trip: %v", decoded)
+	}
+}
+
+func BenchmarkWriteValidatorIndicesJSON(b *testing.B) {
+	indices := make([]ValidatorIndex, 500_000)
+	for i := range indices {
+		indices[i] = ValidatorIndex(i)
+	}
+	var buf bytes.Buffer
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		buf.Reset()
+		WriteValidatorIndicesJSON(&buf, indices)
+	}
+}
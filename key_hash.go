@@ -0,0 +1,80 @@
+package types
+
+import (
+	"encoding/binary"
+	"hash/maphash"
+	"math/bits"
+)
+
+// HashSlot returns cheap non-cryptographic 64-bit hash of the slot (splitmix64 finalizer), suitable
+// for picking shards of concurrent maps. Consecutive slots are spread evenly across the hash space.
+func HashSlot(s Slot) uint64 {
+	return mix64(uint64(s))
+}
+
+// HashEpoch returns cheap non-cryptographic 64-bit hash of the epoch, see HashSlot.
+func HashEpoch(e Epoch) uint64 {
+	return mix64(uint64(e))
+}
+
+// HashRoot returns cheap non-cryptographic 64-bit hash of the root. Roots are outputs of SHA-256, so
+// folding their bytes is enough, unless keys are chosen by an adversary (use KeyHasher then).
+func HashRoot(r Root) uint64 {
+	return binary.LittleEndian.Uint64(r[:8]) ^ binary.LittleEndian.Uint64(r[8:16]) ^
+		binary.LittleEndian.Uint64(r[16:24]) ^ binary.LittleEndian.Uint64(r[24:32])
+}
+
+// KeyHasher hashes keys using randomly seeded maphash, so that hash values can't be predicted (and
+// shards flooded) by peers supplying the keys. Zero value is not usable, see NewKeyHasher.
+type KeyHasher struct {
+	seed maphash.Seed
+}
+
+// NewKeyHasher returns hasher with a random seed.
+func NewKeyHasher() KeyHasher {
+	return KeyHasher{seed: maphash.MakeSeed()}
+}
+
+// Slot returns seeded hash of the slot.
+func (h KeyHasher) Slot(s Slot) uint64 {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], uint64(s))
+	return h.sum(buf[:])
+}
+
+// Epoch returns seeded hash of the epoch.
+func (h KeyHasher) Epoch(e Epoch) uint64 {
+	return h.Slot(Slot(e))
+}
+
+// Root returns seeded hash of the root.
+func (h KeyHasher) Root(r Root) uint64 {
+	return h.sum(r[:])
+}
+
+func (h KeyHasher) sum(data []byte) uint64 {
+	var mh maphash.Hash
+	mh.SetSeed(h.seed)
+	mh.Write(data) // never fails
+	return mh.Sum64()
+}
+
+// ShardOf maps hash to a shard in [0, shards) range, using multiplication instead of the (slower)
+// modulo. Panics if shards is not positive.
+func ShardOf(hash uint64, shards int) int {
+	if shards <= 0 {
+		panic("non-positive shard count")
+	}
+	hi, _ := bits.Mul64(hash, uint64(shards))
+	return int(hi)
+}
+
+// mix64 is the splitmix64 finalizer, a bijection of uint64 with good avalanche properties.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
@@ -0,0 +1,40 @@
+package types
+
+import "testing"
+
+func TestKeyHash(t *testing.T) {
+	const shards = 16
+	counts := make([]int, shards)
+	for s := Slot(0); s < 16000; s++ {
+		counts[ShardOf(HashSlot(s), shards)]++
+	}
+	for shard, n := range counts {
+		if n < 800 || n > 1200 {
+			t.Errorf("Consecutive slots are unevenly distributed, shard %d holds %d slots", shard, n)
+		}
+	}
+	if HashSlot(1) == HashSlot(2) || HashEpoch(1) != HashSlot(1) {
+		t.Error("Unexpected slot hash")
+	}
+	if HashRoot(Root{0x01}) == HashRoot(Root{0x02}) {
+		t.Error("Unexpected root hash")
+	}
+
+	h := NewKeyHasher()
+	if h.Slot(1) != h.Slot(1) || h.Slot(1) == h.Slot(2) || h.Epoch(1) != h.Slot(1) {
+		t.Error("Seeded slot hash must be deterministic and distinguish slots")
+	}
+	if h.Root(Root{0x01}) != h.Root(Root{0x01}) || h.Root(Root{0x01}) == NewKeyHasher().Root(Root{0x01}) {
+		t.Error("Seeded root hash must depend on the seed")
+	}
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = h.Root(Root{0x01}) + h.Slot(5) + HashRoot(Root{0x02})
+	})
+	if allocs != 0 {
+		t.Errorf("Unexpected allocations: %v", allocs)
+	}
+
+	if ShardOf(0, 4) != 0 || ShardOf(1<<64-1, 4) != 3 {
+		t.Error("Unexpected shard")
+	}
+}
@@ -0,0 +1,82 @@
+// Package keymanager defines value types of the validator keymanager API (fee recipient, gas limit
+// and graffiti settings), so that keymanager servers and external tooling agree on JSON encoding.
+package keymanager
+
+import (
+	"errors"
+	"fmt"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+// MaxGraffitiLength is the maximum length of graffiti, in bytes.
+const MaxGraffitiLength = 32
+
+// ErrGraffitiTooLong is returned when graffiti doesn't fit into the block graffiti field.
+var ErrGraffitiTooLong = errors.New("graffiti exceeds 32 bytes")
+
+// Response wraps payload of successful keymanager API responses, e.g. Response[FeeRecipient].
+type Response[T any] struct {
+	Data T `json:"data"`
+}
+
+// ErrorResponse is the body of failed keymanager API responses.
+type ErrorResponse struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Error implements error interface.
+func (e *ErrorResponse) Error() string {
+	return fmt.Sprintf("keymanager API error %d: %s", e.Code, e.Message)
+}
+
+// FeeRecipient is the fee recipient configured for a validator (GET /eth/v1/validator/{pubkey}/feerecipient).
+type FeeRecipient struct {
+	Pubkey     types.BLSPubkey        `json:"pubkey"`
+	EthAddress types.ExecutionAddress `json:"ethaddress"`
+}
+
+// SetFeeRecipientRequest is the body of POST /eth/v1/validator/{pubkey}/feerecipient.
+type SetFeeRecipientRequest struct {
+	EthAddress types.ExecutionAddress `json:"ethaddress"`
+}
+
+// GasLimit is the gas limit configured for a validator (GET /eth/v1/validator/{pubkey}/gas_limit).
+type GasLimit struct {
+	Pubkey   types.BLSPubkey `json:"pubkey"`
+	GasLimit types.GasLimit  `json:"gas_limit"`
+}
+
+// SetGasLimitRequest is the body of POST /eth/v1/validator/{pubkey}/gas_limit.
+type SetGasLimitRequest struct {
+	GasLimit types.GasLimit `json:"gas_limit"`
+}
+
+// Graffiti is the graffiti configured for a validator (GET /eth/v1/validator/{pubkey}/graffiti).
+// Unlike blocks, where graffiti is hex encoded bytes, the keymanager API uses plain (UTF-8) text.
+type Graffiti struct {
+	Pubkey   types.BLSPubkey `json:"pubkey"`
+	Graffiti string          `json:"graffiti"`
+}
+
+// SetGraffitiRequest is the body of POST /eth/v1/validator/{pubkey}/graffiti.
+type SetGraffitiRequest struct {
+	Graffiti string `json:"graffiti"`
+}
+
+// Validate checks that graffiti fits into the block graffiti field.
+func (r *SetGraffitiRequest) Validate() error {
+	_, err := GraffitiBytes(r.Graffiti)
+	return err
+}
+
+// GraffitiBytes returns graffiti text as the zero-padded 32 byte block graffiti field.
+func GraffitiBytes(graffiti string) ([32]byte, error) {
+	var b [32]byte
+	if len(graffiti) > MaxGraffitiLength {
+		return b, fmt.Errorf("%w: %q has %d bytes", ErrGraffitiTooLong, graffiti, len(graffiti))
+	}
+	copy(b[:], graffiti)
+	return b, nil
+}
@@ -0,0 +1,82 @@
+package keymanager
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+func TestJSON(t *testing.T) {
+	pubkey := types.BLSPubkey{0x93}
+	tests := []struct {
+		name  string
+		value interface{}
+		want  string
+	}{
+		{
+			name:  "fee recipient",
+			value: Response[FeeRecipient]{Data: FeeRecipient{Pubkey: pubkey, EthAddress: types.ExecutionAddress{0xab}}},
+			want:  `{"data":{"pubkey":"` + pubkey.String() + `","ethaddress":"0xab00000000000000000000000000000000000000"}}`,
+		},
+		{
+			name:  "gas limit",
+			value: Response[GasLimit]{Data: GasLimit{Pubkey: pubkey, GasLimit: 30_000_000}},
+			want:  `{"data":{"pubkey":"` + pubkey.String() + `","gas_limit":"30000000"}}`,
+		},
+		{
+			name:  "graffiti",
+			value: Response[Graffiti]{Data: Graffiti{Pubkey: pubkey, Graffiti: "hello"}},
+			want:  `{"data":{"pubkey":"` + pubkey.String() + `","graffiti":"hello"}}`,
+		},
+		{
+			name:  "set gas limit",
+			value: SetGasLimitRequest{GasLimit: 36_000_000},
+			want:  `{"gas_limit":"36000000"}`,
+		},
+		{
+			name:  "error",
+			value: ErrorResponse{Code: 404, Message: "not found"},
+			want:  `{"code":404,"message":"not found"}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enc, err := json.Marshal(tt.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(enc) != tt.want {
+				t.Errorf("Unexpected JSON: %s", enc)
+			}
+		})
+	}
+
+	var resp Response[FeeRecipient]
+	input := `{"data":{"pubkey":"` + pubkey.String() + `","ethaddress":"0xab00000000000000000000000000000000000000"}}`
+	if err := json.Unmarshal([]byte(input), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Data.Pubkey != pubkey || resp.Data.EthAddress != (types.ExecutionAddress{0xab}) {
+		t.Errorf("Unexpected fee recipient: %+v", resp.Data)
+	}
+}
+
+func TestGraffitiBytes(t *testing.T) {
+	b, err := GraffitiBytes("hello")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b[:5]) != "hello" || b[5] != 0 {
+		t.Errorf("Unexpected graffiti: %x", b)
+	}
+	if _, err := GraffitiBytes(strings.Repeat("a", 32)); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	req := &SetGraffitiRequest{Graffiti: strings.Repeat("é", 17)}
+	if err := req.Validate(); !errors.Is(err, ErrGraffitiTooLong) {
+		t.Errorf("Expected error on long graffiti, got %v", err)
+	}
+}
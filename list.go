@@ -0,0 +1,103 @@
+package types
+
+import (
+	"encoding/binary"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// ErrListTooLong is returned when SSZ list exceeds its length limit.
+var ErrListTooLong = sszutil.ErrListTooLong
+
+// ErrInvalidSSZLength is returned when SSZ buffer has unexpected length. Expected and actual lengths
+// of fixed-size objects are available via errors.As with *SSZLengthError.
+var ErrInvalidSSZLength = sszutil.ErrInvalidLength
+
+// SSZLengthError reports expected and actual length of SSZ buffer.
+type SSZLengthError = sszutil.LengthError
+
+// SlotList is SSZ list of slots, bounded by the length limit it is created with (see NewList).
+type SlotList = List[Slot]
+
+// EpochList is SSZ list of epochs, bounded by the length limit it is created with (see NewList).
+type EpochList = List[Epoch]
+
+// ValidatorIndexList is SSZ list of validator indices (e.g. committee or attesting indices), bounded
+// by the length limit it is created with (see NewList).
+type ValidatorIndexList = List[ValidatorIndex]
+
+// CommitteeRoot returns hash tree root of the committee, as List[ValidatorIndex, MAX_VALIDATORS_PER_COMMITTEE].
+func CommitteeRoot(committee []ValidatorIndex) ([32]byte, error) {
+	return hashTreeRootUint64List(committee, MaxValidatorsPerCommittee)
+}
+
+// validateListLength checks list length against its limit.
+func validateListLength(length int, limit uint64) error {
+	if uint64(length) > limit {
+		return ErrListTooLong
+	}
+	return nil
+}
+
+// marshalUint64List appends little-endian encoded values to dst.
+func marshalUint64List[T Uint64Like](dst []byte, values []T) []byte {
+	for _, v := range values {
+		dst = sszutil.AppendUint64(dst, uint64(v))
+	}
+	return dst
+}
+
+// unmarshalUint64List decodes buffer of little-endian encoded values.
+func unmarshalUint64List[T Uint64Like](buf []byte) ([]T, error) {
+	if len(buf)%8 != 0 {
+		return nil, sszutil.ErrListElementSize
+	}
+	values := make([]T, len(buf)/8)
+	for i := range values {
+		values[i] = T(binary.LittleEndian.Uint64(buf[i*8:]))
+	}
+	return values, nil
+}
+
+// hashTreeRootUint64List returns hash tree root of SSZ list of uint64 values: packed values are
+// merkleized up to the limit, and list length is mixed in.
+func hashTreeRootUint64List[T Uint64Like](values []T, limit uint64) ([32]byte, error) {
+	if err := validateListLength(len(values), limit); err != nil {
+		return [32]byte{}, err
+	}
+	root, err := merkleize(packUint64s(values), uint64ListChunks(limit))
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return mixInLength(root, uint64(len(values)))
+}
+
+// hashTreeRootUint64ListParallel is hashTreeRootUint64List using multiple workers for merkleization.
+func hashTreeRootUint64ListParallel[T Uint64Like](values []T, limit uint64, workers int) ([32]byte, error) {
+	if err := validateListLength(len(values), limit); err != nil {
+		return [32]byte{}, err
+	}
+	root, err := merkleizeParallel(packUint64s(values), uint64ListChunks(limit), workers)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return mixInLength(root, uint64(len(values)))
+}
+
+// uint64ListChunks returns number of chunks packed list of up to limit uint64 values occupies, i.e.
+// `(limit + 3) / 4` computed without overflowing for limits close to math.MaxUint64.
+func uint64ListChunks(limit uint64) uint64 {
+	return limit/4 + (limit%4+3)/4
+}
+
+// mixInLength returns `hash(root + length)`, as required by SSZ list hashing.
+func mixInLength(root [32]byte, length uint64) ([32]byte, error) {
+	var chunks [2][32]byte
+	chunks[0] = root
+	binary.LittleEndian.PutUint64(chunks[1][:8], length)
+	var digest [1][32]byte
+	if err := newHasher().HashChunks(digest[:], chunks[:]); err != nil {
+		return [32]byte{}, err
+	}
+	return digest[0], nil
+}
@@ -0,0 +1,118 @@
+package types
+
+import (
+	"crypto/sha256"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestSlotList_SSZ(t *testing.T) {
+	list, err := NewList[Slot](4, 1, 2, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc, err := list.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(enc) != list.SizeSSZ() || len(enc) != 24 {
+		t.Errorf("Unexpected encoding length: %d", len(enc))
+	}
+	decoded, err := NewList[Slot](4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := decoded.UnmarshalSSZ(enc); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded.Values(), list.Values()) {
+		t.Errorf("Unexpected list: %v", decoded.Values())
+	}
+	if err := decoded.UnmarshalSSZ(enc[:5]); !errors.Is(err, ErrInvalidSSZLength) {
+		t.Errorf("Unexpected error on malformed buffer: %v", err)
+	}
+
+	bounded := &SlotList{}
+	if err := bounded.UnmarshalSSZ(enc); !errors.Is(err, ErrListTooLong) {
+		t.Errorf("Limit must be enforced on decoding: %v", err)
+	}
+}
+
+func TestEpochList_Limit(t *testing.T) {
+	if _, err := NewList[Epoch](2, 1, 2, 3); !errors.Is(err, ErrListTooLong) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	list, err := NewList[Epoch](3, 1, 2, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var _ *EpochList = list
+	if err := list.Append(4); !errors.Is(err, ErrListTooLong) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	empty, _ := NewList[Epoch](8)
+	nonEmpty, _ := NewList[Epoch](8, 0)
+	emptyRoot, err := empty.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	nonEmptyRoot, err := nonEmpty.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if emptyRoot == nonEmptyRoot {
+		t.Error("Length should be mixed into the root")
+	}
+}
+
+func TestValidatorIndexList_SSZ(t *testing.T) {
+	list, err := NewList[ValidatorIndex](MaxValidatorsPerCommittee, 5, 1, 1<<40)
+	if err != nil {
+		t.Fatal(err)
+	}
+	enc, err := list.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded *ValidatorIndexList
+	if decoded, err = NewList[ValidatorIndex](MaxValidatorsPerCommittee); err != nil {
+		t.Fatal(err)
+	}
+	if err := decoded.UnmarshalSSZ(enc); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded.Values(), list.Values()) || decoded.SizeSSZ() != 24 {
+		t.Errorf("Unexpected list: %v", decoded.Values())
+	}
+	if _, err := CommitteeRoot(make([]ValidatorIndex, MaxValidatorsPerCommittee+1)); !errors.Is(err, ErrListTooLong) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestHashTreeRootUint64List_LargeLimit(t *testing.T) {
+	tests := []struct {
+		limit uint64
+		depth int
+	}{
+		{limit: 1<<61 + 1, depth: 60}, // limit*8 overflows uint64
+		{limit: 1<<64 - 1, depth: 62},
+	}
+	for _, tt := range tests {
+		got, err := hashTreeRootUint64List([]Slot{5}, tt.limit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var node [32]byte
+		node[0] = 5
+		for i := 0; i < tt.depth; i++ {
+			node = sha256.Sum256(append(node[:], zeroHashes[i][:]...))
+		}
+		var length [32]byte
+		length[0] = 1
+		if want := sha256.Sum256(append(node[:], length[:]...)); got != want {
+			t.Errorf("Unexpected root with limit %d: %#x, want %#x", tt.limit, got, want)
+		}
+	}
+}
@@ -1,33 +1,109 @@
 package types
 
-// MaxSlot returns the larger of the two slots.
-func MaxSlot(a, b Slot) Slot {
+import (
+	"math"
+	"math/bits"
+
+	"github.com/farazdagi/prysm-shared-types/mathutil"
+)
+
+// Uint64Like is the type constraint satisfied by all uint64-backed types (Slot, Epoch etc).
+type Uint64Like = mathutil.Uint64Like
+
+// Max returns the largest of the provided values.
+func Max[T Uint64Like](first T, rest ...T) T {
+	return mathutil.Max(first, rest...)
+}
+
+// Min returns the smallest of the provided values.
+func Min[T Uint64Like](first T, rest ...T) T {
+	return mathutil.Min(first, rest...)
+}
+
+// Clamp limits v to the inclusive [lo, hi] range. Panics if lo > hi (in panic-free mode, see
+// mathutil.SetErrorHook, reports mathutil.ErrInvalidRange and returns zero).
+func Clamp[T Uint64Like](v, lo, hi T) T {
+	if lo > hi {
+		return mathutil.Must[T](0, mathutil.ErrInvalidRange)
+	}
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
+
+// Distance returns the absolute difference between a and b.
+func Distance[T Uint64Like](a, b T) T {
 	if a > b {
-		return a
+		return a - b
 	}
-	return b
+	return b - a
+}
+
+// MulDiv returns `a * b / c`, using 128-bit intermediate product so that multiplication never overflows.
+// Panics if c is zero or if the result doesn't fit into uint64 (unless in panic-free mode, see
+// mathutil.SetErrorHook).
+func MulDiv[T Uint64Like](a T, b, c uint64) T {
+	return mathutil.Must(SafeMulDiv(a, b, c))
 }
 
-// MinSlot returns the smaller of the two slots.
-func MinSlot(a, b Slot) Slot {
-	if a < b {
-		return a
+// SafeMulDiv returns `a * b / c` (see MulDiv), or an error if c is zero or the result doesn't fit into uint64.
+func SafeMulDiv[T Uint64Like](a T, b, c uint64) (T, error) {
+	if c == 0 {
+		return 0, mathutil.ErrDivByZero
+	}
+	hi, lo := bits.Mul64(uint64(a), b)
+	if hi >= c {
+		return 0, mathutil.ErrOverflow
 	}
-	return b
+	quo, _ := bits.Div64(hi, lo, c)
+	return T(quo), nil
 }
 
-// MaxEpoch returns the larger of the two epochs.
-func MaxEpoch(a, b Epoch) Epoch {
-	if a > b {
-		return a
+// CeilDiv returns `a / b` rounded up to the nearest integer.
+func CeilDiv[T Uint64Like](a T, b uint64) T {
+	if b == 0 {
+		return mathutil.Must[T](0, mathutil.ErrDivByZero)
+	}
+	quo := uint64(a) / b
+	if uint64(a)%b != 0 {
+		quo++
 	}
-	return b
+	return T(quo)
 }
 
-// MinEpoch returns the smaller of the two epochs.
-func MinEpoch(a, b Epoch) Epoch {
-	if a < b {
-		return a
+// IntegerSquareRoot returns the largest integer x such that `x * x <= n` (see spec's integer_squareroot).
+func IntegerSquareRoot[T Uint64Like](n T) T {
+	x := uint64(math.Sqrt(float64(n)))
+	// Correct any rounding error introduced by the float conversion.
+	for x > 0 && (x > math.MaxUint32 || x*x > uint64(n)) {
+		x--
 	}
-	return b
+	for x < math.MaxUint32 && (x+1)*(x+1) <= uint64(n) {
+		x++
+	}
+	return T(x)
+}
+
+// MaxSlot returns the largest of the provided slots.
+func MaxSlot(first Slot, rest ...Slot) Slot {
+	return Max(first, rest...)
+}
+
+// MinSlot returns the smallest of the provided slots.
+func MinSlot(first Slot, rest ...Slot) Slot {
+	return Min(first, rest...)
+}
+
+// MaxEpoch returns the largest of the provided epochs.
+func MaxEpoch(first Epoch, rest ...Epoch) Epoch {
+	return Max(first, rest...)
+}
+
+// MinEpoch returns the smallest of the provided epochs.
+func MinEpoch(first Epoch, rest ...Epoch) Epoch {
+	return Min(first, rest...)
 }
@@ -0,0 +1,166 @@
+package types
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/farazdagi/prysm-shared-types/mathutil"
+)
+
+func TestMinMax(t *testing.T) {
+	t.Run("single value", func(t *testing.T) {
+		if MaxSlot(5) != 5 || MinSlot(5) != 5 {
+			t.Error("Single value should be returned as is")
+		}
+	})
+
+	t.Run("variadic", func(t *testing.T) {
+		if v := MaxSlot(3, 10, 1, 7); v != 10 {
+			t.Errorf("Unexpected max: %v", v)
+		}
+		if v := MinSlot(3, 10, 1, 7); v != 1 {
+			t.Errorf("Unexpected min: %v", v)
+		}
+		if v := MaxEpoch(3, 10, 1, 7); v != 10 {
+			t.Errorf("Unexpected max: %v", v)
+		}
+		if v := MinEpoch(3, 10, 1, 7); v != 1 {
+			t.Errorf("Unexpected min: %v", v)
+		}
+	})
+}
+
+func TestClamp(t *testing.T) {
+	tests := []struct {
+		v, lo, hi, want Slot
+	}{
+		{v: 5, lo: 1, hi: 10, want: 5},
+		{v: 0, lo: 1, hi: 10, want: 1},
+		{v: 11, lo: 1, hi: 10, want: 10},
+		{v: 7, lo: 7, hi: 7, want: 7},
+	}
+	for _, tt := range tests {
+		if got := Clamp(tt.v, tt.lo, tt.hi); got != tt.want {
+			t.Errorf("Clamp(%d, %d, %d) = %d, want %d", tt.v, tt.lo, tt.hi, got, tt.want)
+		}
+	}
+
+	t.Run("invalid range", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected panic")
+			}
+		}()
+		Clamp(Epoch(5), 10, 1)
+	})
+
+	t.Run("invalid range in panic-free mode", func(t *testing.T) {
+		var reported error
+		mathutil.SetErrorHook(func(err error) { reported = err })
+		defer mathutil.SetErrorHook(nil)
+		if got := Clamp(Epoch(5), 10, 1); got != 0 || !errors.Is(reported, mathutil.ErrInvalidRange) {
+			t.Errorf("Unexpected result: %v, %v", got, reported)
+		}
+	})
+}
+
+func TestDistance(t *testing.T) {
+	if d := Distance(Slot(3), Slot(10)); d != 7 {
+		t.Errorf("Unexpected distance: %v", d)
+	}
+	if d := Distance(Epoch(10), Epoch(3)); d != 7 {
+		t.Errorf("Unexpected distance: %v", d)
+	}
+	if d := Distance(Slot(0), Slot(1<<64-1)); d != 1<<64-1 {
+		t.Errorf("Unexpected distance: %v", d)
+	}
+}
+
+func TestRelational(t *testing.T) {
+	if !Slot(5).IsAfter(4) || Slot(5).IsAfter(5) {
+		t.Error("Unexpected IsAfter result")
+	}
+	if !Epoch(4).IsBefore(5) || Epoch(5).IsBefore(5) {
+		t.Error("Unexpected IsBefore result")
+	}
+	if !Slot(10).WithinN(7, 3) || Slot(10).WithinN(6, 3) || !Slot(7).WithinN(10, 3) {
+		t.Error("Unexpected WithinN result")
+	}
+	if !Epoch(0).WithinN(1<<64-1, 1<<64-1) {
+		t.Error("Unexpected WithinN result")
+	}
+}
+
+func TestMulDiv(t *testing.T) {
+	tests := []struct {
+		a    Slot
+		b, c uint64
+		want Slot
+	}{
+		{a: 10, b: 3, c: 2, want: 15},
+		{a: 0, b: 3, c: 2, want: 0},
+		{a: 1 << 63, b: 4, c: 8, want: 1 << 62},
+		{a: 1<<64 - 1, b: 1<<64 - 1, c: 1<<64 - 1, want: 1<<64 - 1},
+	}
+	for _, tt := range tests {
+		if got := MulDiv(tt.a, tt.b, tt.c); got != tt.want {
+			t.Errorf("MulDiv(%d, %d, %d) = %d, want %d", tt.a, tt.b, tt.c, got, tt.want)
+		}
+	}
+
+	t.Run("divbyzero", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected panic")
+			}
+		}()
+		MulDiv(Epoch(1), 1, 0)
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected panic")
+			}
+		}()
+		MulDiv(Epoch(1<<63), 4, 2)
+	})
+}
+
+func TestCeilDiv(t *testing.T) {
+	tests := []struct {
+		a    Epoch
+		b    uint64
+		want Epoch
+	}{
+		{a: 0, b: 3, want: 0},
+		{a: 9, b: 3, want: 3},
+		{a: 10, b: 3, want: 4},
+		{a: 1<<64 - 1, b: 2, want: 1 << 63},
+	}
+	for _, tt := range tests {
+		if got := CeilDiv(tt.a, tt.b); got != tt.want {
+			t.Errorf("CeilDiv(%d, %d) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestIntegerSquareRoot(t *testing.T) {
+	tests := []struct {
+		n, want uint64
+	}{
+		{n: 0, want: 0},
+		{n: 1, want: 1},
+		{n: 3, want: 1},
+		{n: 4, want: 2},
+		{n: 1 << 62, want: 1 << 31},
+		{n: 1<<64 - 1, want: 1<<32 - 1},
+		{n: (1<<32 - 1) * (1<<32 - 1), want: 1<<32 - 1},
+		{n: (1<<32-1)*(1<<32-1) - 1, want: 1<<32 - 2},
+	}
+	for _, tt := range tests {
+		if got := IntegerSquareRoot(Slot(tt.n)); uint64(got) != tt.want {
+			t.Errorf("IntegerSquareRoot(%d) = %d, want %d", tt.n, got, tt.want)
+		}
+	}
+}
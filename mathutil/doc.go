@@ -0,0 +1,9 @@
+// Package mathutil contains generic arithmetic shared by all uint64-backed types (Slot, Epoch etc).
+//
+// Every operation comes in two flavours: the panicking one (Add, Sub etc.) and the one
+// returning an error (SafeAdd, SafeSub etc.).
+//
+// Long-running services, which must never crash on adversarial inputs reaching arithmetic code, can
+// switch the panicking flavour into a saturating mode with SetErrorHook, or scope such mode to a
+// single caller (e.g. a network, see types.Spec.WithErrorHook) with MustWith and Fail.
+package mathutil
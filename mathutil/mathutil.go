@@ -0,0 +1,134 @@
+package mathutil
+
+import (
+	"errors"
+	"math/bits"
+)
+
+var (
+	// ErrOverflow is returned when result exceeds math.MaxUint64.
+	ErrOverflow = errors.New("overflow")
+	// ErrUnderflow is returned when result goes below zero.
+	ErrUnderflow = errors.New("underflow")
+	// ErrDivByZero is returned on division (or modulo) by zero.
+	ErrDivByZero = errors.New("divbyzero")
+	// ErrInvalidRange is returned when range bounds are reversed or value is out of the allowed range.
+	ErrInvalidRange = errors.New("invalid range")
+)
+
+// Uint64Like is the type constraint satisfied by all uint64-backed types.
+type Uint64Like interface {
+	~uint64
+}
+
+// SafeAdd returns `a + b`, or an error on overflow.
+func SafeAdd[T Uint64Like](a, b T) (T, error) {
+	res, carry := bits.Add64(uint64(a), uint64(b), 0)
+	if carry != 0 {
+		return 0, ErrOverflow
+	}
+	return T(res), nil
+}
+
+// Add returns `a + b`, panics on overflow.
+func Add[T Uint64Like](a, b T) T {
+	return must(SafeAdd(a, b))
+}
+
+// SafeSub returns `a - b`, or an error on underflow.
+func SafeSub[T Uint64Like](a, b T) (T, error) {
+	res, borrow := bits.Sub64(uint64(a), uint64(b), 0)
+	if borrow != 0 {
+		return 0, ErrUnderflow
+	}
+	return T(res), nil
+}
+
+// Sub returns `a - b`, panics on underflow.
+func Sub[T Uint64Like](a, b T) T {
+	return must(SafeSub(a, b))
+}
+
+// SafeAddInt returns `a + x` for signed x, or an error on overflow or underflow.
+func SafeAddInt[T Uint64Like](a T, x int64) (T, error) {
+	if x < 0 {
+		return SafeSub(a, T(AbsInt64(x)))
+	}
+	return SafeAdd(a, T(x))
+}
+
+// AbsInt64 returns absolute value of x as uint64 (which, unlike int64, holds -math.MinInt64).
+func AbsInt64(x int64) uint64 {
+	if x < 0 {
+		return uint64(-(x + 1)) + 1
+	}
+	return uint64(x)
+}
+
+// SafeMul returns `a * b`, or an error on overflow.
+func SafeMul[T Uint64Like](a, b T) (T, error) {
+	hi, lo := bits.Mul64(uint64(a), uint64(b))
+	if hi != 0 {
+		return 0, ErrOverflow
+	}
+	return T(lo), nil
+}
+
+// Mul returns `a * b`, panics on overflow.
+func Mul[T Uint64Like](a, b T) T {
+	return must(SafeMul(a, b))
+}
+
+// SafeDiv returns `a / b`, or an error if b is zero.
+func SafeDiv[T Uint64Like](a, b T) (T, error) {
+	if b == 0 {
+		return 0, ErrDivByZero
+	}
+	return a / b, nil
+}
+
+// Div returns `a / b`, panics if b is zero.
+func Div[T Uint64Like](a, b T) T {
+	return must(SafeDiv(a, b))
+}
+
+// SafeMod returns `a % b`, or an error if b is zero.
+func SafeMod[T Uint64Like](a, b T) (T, error) {
+	if b == 0 {
+		return 0, ErrDivByZero
+	}
+	return a % b, nil
+}
+
+// Mod returns `a % b`, panics if b is zero.
+func Mod[T Uint64Like](a, b T) T {
+	return must(SafeMod(a, b))
+}
+
+// Max returns the largest of the provided values.
+func Max[T Uint64Like](first T, rest ...T) T {
+	res := first
+	for _, v := range rest {
+		if v > res {
+			res = v
+		}
+	}
+	return res
+}
+
+// Min returns the smallest of the provided values.
+func Min[T Uint64Like](first T, rest ...T) T {
+	res := first
+	for _, v := range rest {
+		if v < res {
+			res = v
+		}
+	}
+	return res
+}
+
+// must returns v if err is nil, otherwise handles err according to the process-wide mode: panics,
+// or reports err to the hook and returns saturated value (see SetErrorHook).
+func must[T Uint64Like](v T, err error) T {
+	return MustWith(nil, v, err)
+}
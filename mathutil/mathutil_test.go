@@ -0,0 +1,179 @@
+package mathutil
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+type custom uint64
+
+func TestSafeArithmetic(t *testing.T) {
+	tests := []struct {
+		name    string
+		fn      func(a, b custom) (custom, error)
+		a, b    custom
+		want    custom
+		wantErr error
+	}{
+		{name: "add", fn: SafeAdd[custom], a: 2, b: 3, want: 5},
+		{name: "add overflow", fn: SafeAdd[custom], a: math.MaxUint64, b: 1, wantErr: ErrOverflow},
+		{name: "sub", fn: SafeSub[custom], a: 3, b: 2, want: 1},
+		{name: "sub underflow", fn: SafeSub[custom], a: 2, b: 3, wantErr: ErrUnderflow},
+		{name: "mul", fn: SafeMul[custom], a: 3, b: 2, want: 6},
+		{name: "mul overflow", fn: SafeMul[custom], a: math.MaxUint64, b: 2, wantErr: ErrOverflow},
+		{name: "div", fn: SafeDiv[custom], a: 7, b: 2, want: 3},
+		{name: "div by zero", fn: SafeDiv[custom], a: 7, b: 0, wantErr: ErrDivByZero},
+		{name: "mod", fn: SafeMod[custom], a: 7, b: 2, want: 1},
+		{name: "mod by zero", fn: SafeMod[custom], a: 7, b: 0, wantErr: ErrDivByZero},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.fn(tt.a, tt.b)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Unexpected error: %v, want %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Unexpected result: %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPanickingArithmetic(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func()
+		msg  string
+	}{
+		{name: "add", fn: func() { Add[custom](math.MaxUint64, 1) }, msg: "overflow"},
+		{name: "sub", fn: func() { Sub[custom](0, 1) }, msg: "underflow"},
+		{name: "mul", fn: func() { Mul[custom](math.MaxUint64, 2) }, msg: "overflow"},
+		{name: "div", fn: func() { Div[custom](1, 0) }, msg: "divbyzero"},
+		{name: "mod", fn: func() { Mod[custom](1, 0) }, msg: "divbyzero"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); r != tt.msg {
+					t.Errorf("Unexpected panic: %v, want %v", r, tt.msg)
+				}
+			}()
+			tt.fn()
+		})
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	if v := Max[custom](3, 10, 1); v != 10 {
+		t.Errorf("Unexpected max: %v", v)
+	}
+	if v := Min[custom](3, 10, 1); v != 1 {
+		t.Errorf("Unexpected min: %v", v)
+	}
+}
+
+func TestSafeAddInt(t *testing.T) {
+	tests := []struct {
+		a       custom
+		x       int64
+		want    custom
+		wantErr error
+	}{
+		{a: 10, x: 5, want: 15},
+		{a: 10, x: -10, want: 0},
+		{a: 10, x: -11, wantErr: ErrUnderflow},
+		{a: math.MaxUint64 - 1, x: 2, wantErr: ErrOverflow},
+		{a: math.MaxUint64, x: math.MinInt64, want: math.MaxUint64 - 1<<63},
+		{a: 1 << 62, x: math.MinInt64, wantErr: ErrUnderflow},
+	}
+	for _, tt := range tests {
+		got, err := SafeAddInt(tt.a, tt.x)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("Unexpected error for %d%+d: %v, want %v", tt.a, tt.x, err, tt.wantErr)
+		}
+		if got != tt.want {
+			t.Errorf("Unexpected result for %d%+d: %v, want %v", tt.a, tt.x, got, tt.want)
+		}
+	}
+	if AbsInt64(math.MinInt64) != 1<<63 || AbsInt64(-3) != 3 || AbsInt64(3) != 3 {
+		t.Error("Unexpected absolute value")
+	}
+}
+
+func TestSetErrorHook(t *testing.T) {
+	var reported []error
+	SetErrorHook(func(err error) {
+		reported = append(reported, err)
+	})
+	defer SetErrorHook(nil)
+	if !IsPanicFree() {
+		t.Fatal("Expected panic-free mode")
+	}
+
+	tests := []struct {
+		name    string
+		got     custom
+		want    custom
+		wantErr error
+	}{
+		{name: "add", got: Add[custom](math.MaxUint64, 1), want: math.MaxUint64, wantErr: ErrOverflow},
+		{name: "sub", got: Sub[custom](0, 1), want: 0, wantErr: ErrUnderflow},
+		{name: "mul", got: Mul[custom](math.MaxUint64, 2), want: math.MaxUint64, wantErr: ErrOverflow},
+		{name: "div", got: Div[custom](1, 0), want: 0, wantErr: ErrDivByZero},
+		{name: "mod", got: Mod[custom](1, 0), want: 0, wantErr: ErrDivByZero},
+		{name: "must", got: Must[custom](5, nil), want: 5},
+	}
+	for i, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s: unexpected result: %v, want %v", tt.name, tt.got, tt.want)
+		}
+		if tt.wantErr != nil && (i >= len(reported) || !errors.Is(reported[i], tt.wantErr)) {
+			t.Errorf("%s: unexpected reported errors: %v", tt.name, reported)
+		}
+	}
+	if len(reported) != 5 {
+		t.Errorf("Unexpected number of reported errors: %d", len(reported))
+	}
+
+	SetErrorHook(nil)
+	if IsPanicFree() {
+		t.Error("Expected panicking mode")
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic")
+		}
+	}()
+	Add[custom](math.MaxUint64, 1)
+}
+
+func TestMustWith(t *testing.T) {
+	var reported []error
+	hook := func(err error) {
+		reported = append(reported, err)
+	}
+	if got := MustWith[custom](hook, 5, nil); got != 5 {
+		t.Errorf("Unexpected result: %v", got)
+	}
+	if got := MustWith[custom](hook, 0, ErrOverflow); got != math.MaxUint64 {
+		t.Errorf("Unexpected saturated result: %v", got)
+	}
+	if got := MustWith[custom](hook, 0, ErrDivByZero); got != 0 {
+		t.Errorf("Unexpected saturated result: %v", got)
+	}
+	Fail(hook, ErrInvalidRange)
+	if len(reported) != 3 || !errors.Is(reported[2], ErrInvalidRange) {
+		t.Errorf("Unexpected reported errors: %v", reported)
+	}
+	if IsPanicFree() {
+		t.Error("Expected process-wide panicking mode")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic")
+		}
+	}()
+	MustWith[custom](nil, 0, ErrOverflow)
+}
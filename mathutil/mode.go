@@ -0,0 +1,67 @@
+package mathutil
+
+import (
+	"errors"
+	"math"
+	"sync/atomic"
+)
+
+// errorHook holds the hook installed with SetErrorHook, nil function means panicking mode.
+var errorHook atomic.Value // hookHolder
+
+type hookHolder struct {
+	fn func(err error)
+}
+
+// SetErrorHook switches the panicking helpers (Add, Sub etc., and everything built on top of them,
+// such as Slot.Add) into panic-free mode: instead of panicking they pass the error to hook and
+// return saturated value, i.e. math.MaxUint64 on overflow and zero on underflow or division by zero.
+// Passing nil restores the default panicking mode. The mode is process-wide, it is the default for
+// hooks passed to MustWith and Fail (e.g. a per-network hook, see types.Spec.WithErrorHook).
+func SetErrorHook(hook func(err error)) {
+	errorHook.Store(hookHolder{fn: hook})
+}
+
+// IsPanicFree returns true if panic-free mode is enabled, see SetErrorHook.
+func IsPanicFree() bool {
+	h, _ := errorHook.Load().(hookHolder)
+	return h.fn != nil
+}
+
+// Must returns v if err is nil. Otherwise, it either panics or (in panic-free mode, see SetErrorHook)
+// reports err to the hook and returns saturated value.
+func Must[T Uint64Like](v T, err error) T {
+	return must(v, err)
+}
+
+// MustWith is like Must, but reports err to the given hook. Nil hook falls back to the process-wide
+// mode, see SetErrorHook.
+func MustWith[T Uint64Like](hook func(err error), v T, err error) T {
+	if err != nil {
+		return onError[T](hook, err)
+	}
+	return v
+}
+
+// Fail reports err to the given hook, or handles it according to the process-wide mode if hook is
+// nil: panics unless panic-free mode is enabled. It is meant for failed preconditions, which have no
+// saturated value, so the caller picks the fallback.
+func Fail(hook func(err error), err error) {
+	if hook == nil {
+		h, _ := errorHook.Load().(hookHolder)
+		hook = h.fn
+	}
+	if hook == nil {
+		panic(err.Error())
+	}
+	hook(err)
+}
+
+// onError handles arithmetic error with the hook (or the process-wide mode) and returns saturated value.
+func onError[T Uint64Like](hook func(err error), err error) T {
+	Fail(hook, err)
+	if errors.Is(err, ErrOverflow) {
+		return math.MaxUint64
+	}
+	return 0
+}
@@ -0,0 +1,130 @@
+package types
+
+import (
+	"encoding/binary"
+	"math/bits"
+	"sync"
+	"unsafe"
+)
+
+// zeroHashes[i] holds root of the perfect merkle tree of depth i with all leaves zeroed.
+var zeroHashes [65][32]byte
+
+func init() {
+	var buf [64]byte
+	for i := 0; i < len(zeroHashes)-1; i++ {
+		copy(buf[:32], zeroHashes[i][:])
+		copy(buf[32:], zeroHashes[i][:])
+		zeroHashes[i+1] = sum256(buf[:])
+	}
+}
+
+// HashTreeRootBatch returns hash tree root of values treated as SSZ vector of uint64 (i.e. values are
+// packed into 32-byte leaves before merkleization). Hashing is done layer by layer, each layer being
+// passed to the Hasher in a single call. By default layers are hashed with gohashtree, which uses
+// vectorized SHA-256 (AVX2, AVX-512, SHA-NI or NEON) when CPU supports it.
+func HashTreeRootBatch[T Uint64Like](values []T) ([32]byte, error) {
+	return merkleize(packUint64s(values), 0)
+}
+
+// packUint64s packs values into 32-byte chunks (4 values per chunk), zero-padding the last chunk.
+func packUint64s[T Uint64Like](values []T) [][32]byte {
+	chunks := make([][32]byte, (len(values)+3)/4)
+	for i, v := range values {
+		binary.LittleEndian.PutUint64(chunks[i/4][(i%4)*8:], uint64(v))
+	}
+	return chunks
+}
+
+// merkleize returns merkle root of chunks, padded with zero chunks up to the next power of two
+// of limit (or of the number of chunks, if limit is zero). Chunks are overwritten in the process.
+func merkleize(chunks [][32]byte, limit uint64) ([32]byte, error) {
+	var root [32]byte
+	err := merkleizeInto(&root, chunks, limit)
+	return root, err
+}
+
+// merkleizeInto is merkleize writing the root into dst.
+func merkleizeInto(dst *[32]byte, chunks [][32]byte, limit uint64) error {
+	count := uint64(len(chunks))
+	if limit < count {
+		limit = count
+	}
+	depth := merkleDepth(limit)
+	if count == 0 {
+		*dst = zeroHashes[depth]
+		return nil
+	}
+	return hashLayers(dst, chunks, 0, depth)
+}
+
+// hashLayers hashes layer of nodes at the given level (leaves being level 0) up to the root of the
+// tree of the given depth, padding with zero hashes of the corresponding levels. The root is written
+// into dst, layer is overwritten.
+func hashLayers(dst *[32]byte, layer [][32]byte, level, depth int) error {
+	hasher := newHasher()
+	digests := make([][32]byte, (len(layer)+1)/2)
+	for i := level; i < depth; i++ {
+		if len(layer)%2 == 1 {
+			layer = append(layer, zeroHashes[i])
+		}
+		next := digests[:len(layer)/2]
+		if i == depth-1 {
+			// The last layer is hashed right into the destination.
+			next = unsafe.Slice(dst, 1)
+		}
+		if err := hasher.HashChunks(next, layer); err != nil {
+			return err
+		}
+		// Reuse the consumed layer as the output buffer of the next iteration.
+		layer, digests = next, layer
+	}
+	if level >= depth {
+		*dst = layer[0]
+	}
+	return nil
+}
+
+// merkleizeParallel is merkleize splitting chunks into equally sized subtrees, which are hashed
+// concurrently by the given number of workers, and then merged into the root.
+func merkleizeParallel(chunks [][32]byte, limit uint64, workers int) ([32]byte, error) {
+	count := uint64(len(chunks))
+	if workers <= 1 || count < 2*uint64(workers) {
+		return merkleize(chunks, limit)
+	}
+	if limit < count {
+		limit = count
+	}
+	depth := merkleDepth(limit)
+	subDepth := merkleDepth((count + uint64(workers) - 1) / uint64(workers))
+	subSize := uint64(1) << subDepth
+
+	roots := make([][32]byte, (count+subSize-1)/subSize)
+	errs := make([]error, len(roots))
+	var wg sync.WaitGroup
+	for i := range roots {
+		lo, hi := uint64(i)*subSize, Min(uint64(i+1)*subSize, count)
+		wg.Add(1)
+		go func(i int, subtree [][32]byte) {
+			defer wg.Done()
+			errs[i] = hashLayers(&roots[i], subtree, 0, subDepth)
+		}(i, chunks[lo:hi:hi]) // capped, so that padding doesn't overwrite the neighbouring subtree
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return [32]byte{}, err
+		}
+	}
+	var root [32]byte
+	err := hashLayers(&root, roots, subDepth, depth)
+	return root, err
+}
+
+// merkleDepth returns depth of the tree required to hold n leaves.
+func merkleDepth(n uint64) int {
+	if n <= 1 {
+		return 0
+	}
+	return bits.Len64(n - 1)
+}
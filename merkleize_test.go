@@ -0,0 +1,116 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestMerkleizeParallel(t *testing.T) {
+	for _, count := range []int{0, 1, 5, 16, 17, 100, 1000} {
+		for _, limit := range []uint64{0, 1 << 12} {
+			for _, workers := range []int{1, 2, 3, 8} {
+				chunks := make([][32]byte, count)
+				for i := range chunks {
+					chunks[i][0], chunks[i][1] = byte(i), byte(i>>8)
+				}
+				want, err := merkleize(append([][32]byte(nil), chunks...), limit)
+				if err != nil {
+					t.Fatal(err)
+				}
+				got, err := merkleizeParallel(chunks, limit, workers)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got != want {
+					t.Errorf("Unexpected root for %d chunks (limit %d, %d workers)", count, limit, workers)
+				}
+			}
+		}
+	}
+}
+
+func TestList_HashTreeRootParallel(t *testing.T) {
+	balances := make([]Gwei, 10_000)
+	for i := range balances {
+		balances[i] = Gwei(i) * GweiPerEth
+	}
+	l, err := NewList(1<<40, balances...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := l.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := l.HashTreeRootParallel(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Errorf("Unexpected root: %#x, want %#x", got, want)
+	}
+
+	slots, err := NewList[Slot](8, 1, 2, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, _ = slots.HashTreeRoot()
+	if got, _ := slots.HashTreeRootParallel(2); got != want {
+		t.Errorf("Unexpected root: %#x, want %#x", got, want)
+	}
+}
+
+func BenchmarkHashTreeRootBatch(b *testing.B) {
+	indices := make([]ValidatorIndex, 1<<16)
+	for i := range indices {
+		indices[i] = ValidatorIndex(i)
+	}
+	defer SetHasherFactory(nil)
+	hashers := []struct {
+		name    string
+		factory HasherFactory
+	}{
+		{name: "gohashtree", factory: nil},
+		{name: "sha256", factory: func() Hasher { return HasherFunc(hashChunksSHA256) }},
+	}
+	for _, h := range hashers {
+		b.Run(h.name, func(b *testing.B) {
+			SetHasherFactory(h.factory)
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := HashTreeRootBatch(indices); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkList_HashTreeRootParallel(b *testing.B) {
+	balances := make([]Gwei, 1<<20)
+	for i := range balances {
+		balances[i] = Gwei(i)
+	}
+	l, err := NewList(1<<40, balances...)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.Run("sequential", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			if _, err := l.HashTreeRoot(); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	for _, workers := range []int{1, 2, 4, 8} {
+		b.Run(fmt.Sprintf("workers=%d", workers), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := l.HashTreeRootParallel(workers); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
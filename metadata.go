@@ -0,0 +1,135 @@
+package types
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// MetaDataV0 is the phase0 req/resp MetaData message.
+type MetaDataV0 struct {
+	SeqNumber uint64      `json:"seq_number,string"`
+	Attnets   Bitvector64 `json:"attnets"`
+}
+
+// MetaDataV1 is the Altair req/resp MetaData message, which adds sync committee subnets.
+type MetaDataV1 struct {
+	SeqNumber uint64      `json:"seq_number,string"`
+	Attnets   Bitvector64 `json:"attnets"`
+	Syncnets  Bitvector4  `json:"syncnets"`
+}
+
+// ToV1 upgrades metadata to the Altair version (with no sync committee subnets).
+func (m *MetaDataV0) ToV1() *MetaDataV1 {
+	return &MetaDataV1{SeqNumber: m.SeqNumber, Attnets: m.Attnets}
+}
+
+// HashTreeRoot returns calculated hash root.
+func (m *MetaDataV0) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	err := m.HashTreeRootInto(&root)
+	return root, err
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (m *MetaDataV0) HashTreeRootInto(dst *[32]byte) error {
+	var chunks [2][32]byte
+	binary.LittleEndian.PutUint64(chunks[0][:8], m.SeqNumber)
+	copy(chunks[1][:], m.Attnets[:])
+	return merkleizeInto(dst, chunks[:], 0)
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the metadata object.
+func (m *MetaDataV0) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), m.SizeSSZ()); err != nil {
+		return err
+	}
+	m.SeqNumber = binary.LittleEndian.Uint64(buf[:8])
+	copy(m.Attnets[:], buf[8:16])
+	return nil
+}
+
+// MarshalSSZTo marshals metadata with the provided byte slice.
+func (m *MetaDataV0) MarshalSSZTo(dst []byte) ([]byte, error) {
+	dst = sszutil.AppendUint64(dst, m.SeqNumber)
+	return append(dst, m.Attnets[:]...), nil
+}
+
+// MarshalSSZ marshals metadata into a serialized object.
+func (m *MetaDataV0) MarshalSSZ() ([]byte, error) {
+	return m.MarshalSSZTo(make([]byte, 0, m.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (m *MetaDataV0) SizeSSZ() int {
+	return 16
+}
+
+// WriteTo writes SSZ serialized metadata to w.
+func (m *MetaDataV0) WriteTo(w io.Writer) (int64, error) {
+	return writeSSZTo(w, m)
+}
+
+// ReadFrom reads SSZ serialized metadata from reader (exactly SizeSSZ bytes are consumed).
+func (m *MetaDataV0) ReadFrom(reader io.Reader) (int64, error) {
+	return readSSZFrom(reader, m)
+}
+
+// HashTreeRoot returns calculated hash root.
+func (m *MetaDataV1) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	err := m.HashTreeRootInto(&root)
+	return root, err
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (m *MetaDataV1) HashTreeRootInto(dst *[32]byte) error {
+	var chunks [3][32]byte
+	binary.LittleEndian.PutUint64(chunks[0][:8], m.SeqNumber)
+	copy(chunks[1][:], m.Attnets[:])
+	copy(chunks[2][:], m.Syncnets[:])
+	return merkleizeInto(dst, chunks[:], 0)
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the metadata object.
+func (m *MetaDataV1) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), m.SizeSSZ()); err != nil {
+		return err
+	}
+	syncnets := Bitvector4{buf[16]}
+	if err := syncnets.validate(); err != nil {
+		return err
+	}
+	m.SeqNumber = binary.LittleEndian.Uint64(buf[:8])
+	copy(m.Attnets[:], buf[8:16])
+	m.Syncnets = syncnets
+	return nil
+}
+
+// MarshalSSZTo marshals metadata with the provided byte slice.
+func (m *MetaDataV1) MarshalSSZTo(dst []byte) ([]byte, error) {
+	dst = sszutil.AppendUint64(dst, m.SeqNumber)
+	dst = append(dst, m.Attnets[:]...)
+	return append(dst, m.Syncnets[:]...), nil
+}
+
+// MarshalSSZ marshals metadata into a serialized object.
+func (m *MetaDataV1) MarshalSSZ() ([]byte, error) {
+	return m.MarshalSSZTo(make([]byte, 0, m.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (m *MetaDataV1) SizeSSZ() int {
+	return 17
+}
+
+// WriteTo writes SSZ serialized metadata to w.
+func (m *MetaDataV1) WriteTo(w io.Writer) (int64, error) {
+	return writeSSZTo(w, m)
+}
+
+// ReadFrom reads SSZ serialized metadata from reader (exactly SizeSSZ bytes are consumed).
+func (m *MetaDataV1) ReadFrom(reader io.Reader) (int64, error) {
+	return readSSZFrom(reader, m)
+}
@@ -0,0 +1,32 @@
+//go:build !nofastssz
+
+package types
+
+import fssz "github.com/ferranbt/fastssz"
+
+var _ fssz.HashRoot = (*MetaDataV0)(nil)
+var _ fssz.Marshaler = (*MetaDataV0)(nil)
+var _ fssz.Unmarshaler = (*MetaDataV0)(nil)
+var _ fssz.HashRoot = (*MetaDataV1)(nil)
+var _ fssz.Marshaler = (*MetaDataV1)(nil)
+var _ fssz.Unmarshaler = (*MetaDataV1)(nil)
+
+// HashTreeRootWith appends metadata root to the provided hasher.
+func (m *MetaDataV0) HashTreeRootWith(hh *fssz.Hasher) error {
+	root, err := m.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	hh.PutBytes(root[:])
+	return nil
+}
+
+// HashTreeRootWith appends metadata root to the provided hasher.
+func (m *MetaDataV1) HashTreeRootWith(hh *fssz.Hasher) error {
+	root, err := m.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	hh.PutBytes(root[:])
+	return nil
+}
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestBitvector(t *testing.T) {
+	var attnets Bitvector64
+	attnets.SetBitAt(0, true)
+	attnets.SetBitAt(9, true)
+	attnets.SetBitAt(63, true)
+	attnets.SetBitAt(64, true)
+	if !attnets.BitAt(9) || attnets.BitAt(8) || attnets.BitAt(64) || attnets.Count() != 3 {
+		t.Errorf("Unexpected bits: %v", attnets)
+	}
+	if attnets.String() != "0x0102000000000080" {
+		t.Errorf("Unexpected bitvector: %v", attnets)
+	}
+	attnets.SetBitAt(0, false)
+	if attnets.BitAt(0) || attnets.Count() != 2 {
+		t.Errorf("Unexpected bits: %v", attnets)
+	}
+
+	var syncnets Bitvector4
+	syncnets.SetBitAt(3, true)
+	syncnets.SetBitAt(4, true)
+	if syncnets != (Bitvector4{0x08}) || !syncnets.BitAt(3) || syncnets.BitAt(4) {
+		t.Errorf("Unexpected bits: %v", syncnets)
+	}
+	if err := syncnets.UnmarshalText([]byte("0x10")); err == nil {
+		t.Error("Expected error for bits beyond vector length")
+	}
+}
+
+func TestMetaData_SSZ(t *testing.T) {
+	v0 := &MetaDataV0{SeqNumber: 42, Attnets: Bitvector64{0xff, 0x01}}
+	enc, err := v0.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded0 := &MetaDataV0{}
+	if err := decoded0.UnmarshalSSZ(enc); err != nil || *decoded0 != *v0 {
+		t.Errorf("Unexpected metadata: %+v, %v", decoded0, err)
+	}
+
+	v1 := v0.ToV1()
+	v1.Syncnets = Bitvector4{0x05}
+	enc, err = v1.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(enc) != 17 {
+		t.Errorf("Unexpected length: %d", len(enc))
+	}
+	decoded1 := &MetaDataV1{}
+	if err := decoded1.UnmarshalSSZ(enc); err != nil || *decoded1 != *v1 {
+		t.Errorf("Unexpected metadata: %+v, %v", decoded1, err)
+	}
+	enc[16] = 0x10
+	if err := decoded1.UnmarshalSSZ(enc); err == nil {
+		t.Error("Expected error for invalid syncnets")
+	}
+}
+
+func TestMetaData_JSON(t *testing.T) {
+	input := `{"seq_number":"42","attnets":"0xff01000000000000","syncnets":"0x05"}`
+	var m MetaDataV1
+	if err := json.Unmarshal([]byte(input), &m); err != nil {
+		t.Fatal(err)
+	}
+	enc, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(enc) != input {
+		t.Errorf("Unexpected JSON: %s", enc)
+	}
+}
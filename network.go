@@ -0,0 +1,84 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// Spec is a per-network handle bundling chain configuration, genesis parameters and fork schedule.
+// Spec dependent helpers keep no package level state, so a single process can serve several
+// networks at once by passing a separate handle to each of them.
+type Spec struct {
+	name    string
+	chain   *ChainSpec
+	genesis Genesis
+	forks   *ForkSchedule
+}
+
+// NewSpec creates network handle, the chain spec and fork schedule must not be nil.
+func NewSpec(name string, chain *ChainSpec, genesis Genesis, forks *ForkSchedule) (*Spec, error) {
+	if chain == nil || forks == nil {
+		return nil, fmt.Errorf("%w: %q has no chain spec or fork schedule", ErrInvalidSpec, name)
+	}
+	if err := chain.Validate(); err != nil {
+		return nil, fmt.Errorf("%q: %w", name, err)
+	}
+	return &Spec{name: name, chain: chain, genesis: genesis, forks: forks}, nil
+}
+
+// WithErrorHook returns copy of the handle, whose spec dependent helpers (Slot.ToEpoch, SyncSubnet,
+// Clock etc.) report arithmetic errors (e.g. division by zero) to hook and return saturated values
+// instead of panicking, regardless of the process-wide mode set with mathutil.SetErrorHook. Nil hook
+// restores the process-wide mode. Arithmetic not involving the spec (e.g. Slot.Add) is unaffected.
+func (s *Spec) WithErrorHook(hook func(err error)) *Spec {
+	chain := *s.chain
+	chain.errorHook = nil
+	if hook != nil {
+		chain.errorHook = &hook
+	}
+	return &Spec{name: s.name, chain: &chain, genesis: s.genesis, forks: s.forks}
+}
+
+// Name returns network name.
+func (s *Spec) Name() string {
+	return s.name
+}
+
+// Chain returns chain configuration of the network.
+func (s *Spec) Chain() *ChainSpec {
+	return s.chain
+}
+
+// Genesis returns genesis parameters of the network.
+func (s *Spec) Genesis() Genesis {
+	return s.genesis
+}
+
+// Forks returns fork schedule of the network.
+func (s *Spec) Forks() *ForkSchedule {
+	return s.forks
+}
+
+// Clock returns wall clock of the network.
+func (s *Spec) Clock() *Clock {
+	return NewClock(time.Unix(int64(s.genesis.GenesisTime), 0), s.chain)
+}
+
+// ForkDigest returns fork digest of the fork active at the epoch.
+func (s *Spec) ForkDigest(epoch Epoch) (ForkDigest, error) {
+	return ComputeForkDigest(s.forks.AtEpoch(epoch).ForkVersion, s.genesis.GenesisValidatorsRoot)
+}
+
+// Domain returns signing domain of the given type, for the fork active at the epoch.
+func (s *Spec) Domain(domainType DomainType, epoch Epoch) (Domain, error) {
+	return ComputeDomain(domainType, s.forks.AtEpoch(epoch).ForkVersion, s.genesis.GenesisValidatorsRoot)
+}
+
+// SigningRoot returns signing root of the object, using domain of the given type at the epoch.
+func (s *Spec) SigningRoot(obj HashRooter, domainType DomainType, epoch Epoch) (Root, error) {
+	domain, err := s.Domain(domainType, epoch)
+	if err != nil {
+		return Root{}, err
+	}
+	return ComputeSigningRoot(obj, domain)
+}
@@ -0,0 +1,176 @@
+package types
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/farazdagi/prysm-shared-types/mathutil"
+)
+
+func testSpecs(t *testing.T) (mainnet, testnet *Spec) {
+	forks, err := NewForkSchedule(
+		ForkScheduleEntry{Version: Phase0, ForkVersion: ForkVersion{0x00}, Epoch: 0},
+		ForkScheduleEntry{Version: Altair, ForkVersion: ForkVersion{0x01}, Epoch: 74240},
+		ForkScheduleEntry{Version: Bellatrix, ForkVersion: ForkVersion{0x02}, Epoch: 144896},
+		ForkScheduleEntry{Version: Capella, ForkVersion: ForkVersion{0x03}, Epoch: 194048},
+		ForkScheduleEntry{Version: Deneb, ForkVersion: ForkVersion{0x04}, Epoch: 269568},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var gvr Root
+	if err := gvr.UnmarshalText([]byte("0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95")); err != nil {
+		t.Fatal(err)
+	}
+	mainnet, err = NewSpec("mainnet", MainnetChainSpec(),
+		Genesis{GenesisTime: 1606824023, GenesisValidatorsRoot: gvr}, forks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testForks, err := NewForkSchedule(ForkScheduleEntry{Version: Phase0, ForkVersion: ForkVersion{0x10}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	testnet, err = NewSpec("testnet", MinimalChainSpec(),
+		Genesis{GenesisTime: 1700000000, GenesisValidatorsRoot: Root{0x01}}, testForks)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return mainnet, testnet
+}
+
+func TestSpec(t *testing.T) {
+	mainnet, testnet := testSpecs(t)
+	if mainnet.Name() != "mainnet" || testnet.Chain().SlotsPerEpoch != 8 {
+		t.Error("Unexpected spec values")
+	}
+
+	tests := []struct {
+		epoch Epoch
+		want  string
+	}{
+		{0, "0xb5303f2a"},
+		{269568, "0x6a95a1a9"},
+	}
+	for _, tt := range tests {
+		digest, err := mainnet.ForkDigest(tt.epoch)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if digest.String() != tt.want {
+			t.Errorf("Unexpected digest at epoch %d: %v, want: %v", tt.epoch, digest, tt.want)
+		}
+	}
+	digest, err := testnet.ForkDigest(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := ComputeForkDigest(ForkVersion{0x10}, Root{0x01})
+	if err != nil || digest != want {
+		t.Errorf("Unexpected testnet digest: %v, want: %v", digest, want)
+	}
+
+	if got := mainnet.Clock().SlotStart(1); !got.Equal(time.Unix(1606824023+12, 0)) {
+		t.Errorf("Unexpected mainnet slot start: %v", got)
+	}
+	if got := testnet.Clock().SlotStart(1); !got.Equal(time.Unix(1700000000+6, 0)) {
+		t.Errorf("Unexpected testnet slot start: %v", got)
+	}
+
+	exit := &VoluntaryExit{Epoch: 1, ValidatorIndex: 2}
+	mainnetRoot, err := mainnet.SigningRoot(exit, DomainVoluntaryExit, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testnetRoot, err := testnet.SigningRoot(exit, DomainVoluntaryExit, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mainnetRoot == testnetRoot {
+		t.Error("Signing roots of different networks must differ")
+	}
+}
+
+func TestNewSpec_Invalid(t *testing.T) {
+	forks, err := NewForkSchedule(ForkScheduleEntry{Version: Phase0})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewSpec("x", nil, Genesis{}, forks); !errors.Is(err, ErrInvalidSpec) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if _, err := NewSpec("x", &ChainSpec{SlotsPerEpoch: 32}, Genesis{}, forks); !errors.Is(err, ErrInvalidSpec) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if _, err := NewSpec("x", &ChainSpec{SlotsPerEpoch: 32, SecondsPerSlot: 12}, Genesis{}, forks); !errors.Is(err, ErrInvalidSpec) {
+		t.Errorf("Incomplete spec must be rejected: %v", err)
+	}
+	if _, err := NewSpec("x", MainnetChainSpec(), Genesis{}, nil); !errors.Is(err, ErrInvalidSpec) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestSpec_WithErrorHook(t *testing.T) {
+	mainnet, _ := testSpecs(t)
+	var reported []error
+	spec := mainnet.WithErrorHook(func(err error) {
+		reported = append(reported, err)
+	})
+	if mainnet.Chain() == spec.Chain() || mainnet.Chain().errorHook != nil {
+		t.Fatal("Expected hook to be set on a copy of the chain spec")
+	}
+	// Zero divisors would make every helper below panic without the hook.
+	chain := spec.Chain()
+	chain.SlotsPerEpoch, chain.SecondsPerSlot, chain.ChurnLimitQuotient = 0, 0, 0
+	chain.SyncCommitteeSubnetCount, chain.NumberOfCustodyGroups, chain.DataColumnSidecarSubnetCount = 0, 0, 0
+	chain.EffectiveBalanceIncrement = 0
+
+	if got := Slot(100).ToEpoch(chain); got != 0 {
+		t.Errorf("Unexpected epoch: %v", got)
+	}
+	if got := Slot(100).SinceEpochStart(chain); got != 0 {
+		t.Errorf("Unexpected slot offset: %v", got)
+	}
+	if got := ValidatorChurnLimit(1<<20, chain); got != chain.MinPerEpochChurnLimit {
+		t.Errorf("Unexpected churn limit: %v", got)
+	}
+	// SyncSubnet divides by the (saturated) subnet size too, reporting both divisions.
+	if got := SyncSubnet(100, chain); got != 0 {
+		t.Errorf("Unexpected sync subnet: %v", got)
+	}
+	if got := ColumnIndex(100).Subnet(chain); got != 0 {
+		t.Errorf("Unexpected column subnet: %v", got)
+	}
+	if got := CustodyGroup(1).Columns(chain); len(got) != 0 {
+		t.Errorf("Unexpected custody columns: %v", got)
+	}
+	if got := Gwei(100).RoundToIncrement(chain); got != 100 {
+		t.Errorf("Unexpected rounded balance: %v", got)
+	}
+	clock := spec.Clock().WithNow(func() time.Time { return time.Unix(1606824023+120, 0) })
+	if got := clock.CurrentSlot(); got != 0 {
+		t.Errorf("Unexpected current slot: %v", got)
+	}
+	if got := clock.IntoSlot(0, 2); !got.Equal(clock.SlotStart(0)) {
+		t.Errorf("Unexpected time into slot: %v", got)
+	}
+	if len(reported) != 10 {
+		t.Errorf("Unexpected number of reported errors: %d (%v)", len(reported), reported)
+	}
+	for _, err := range reported[:9] {
+		if !errors.Is(err, mathutil.ErrDivByZero) {
+			t.Errorf("Unexpected reported error: %v", err)
+		}
+	}
+	if !errors.Is(reported[len(reported)-1], mathutil.ErrInvalidRange) {
+		t.Errorf("Unexpected reported error: %v", reported[len(reported)-1])
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic without hook")
+		}
+	}()
+	Slot(100).ToEpoch(spec.WithErrorHook(nil).Chain())
+}
@@ -0,0 +1,16 @@
+package types
+
+// NodeID represents a 32 byte discv5 node identity, interpreted as big-endian uint256 by custody
+// computations.
+type NodeID Bytes32
+
+// next returns node id incremented by one, wrapping to zero after the maximum uint256 value.
+func (id NodeID) next() NodeID {
+	for i := len(id) - 1; i >= 0; i-- {
+		id[i]++
+		if id[i] != 0 {
+			break
+		}
+	}
+	return id
+}
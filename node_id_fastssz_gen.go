@@ -0,0 +1,17 @@
+// Code generated by typegen. DO NOT EDIT.
+
+//go:build !nofastssz
+
+package types
+
+import fssz "github.com/ferranbt/fastssz"
+
+var _ fssz.HashRoot = (NodeID{})
+var _ fssz.Marshaler = (*NodeID)(nil)
+var _ fssz.Unmarshaler = (*NodeID)(nil)
+
+// HashTreeRootWith appends node id to the provided hasher.
+func (id NodeID) HashTreeRootWith(hh *fssz.Hasher) error {
+	hh.PutBytes(id[:])
+	return nil
+}
@@ -0,0 +1,79 @@
+// Code generated by typegen. DO NOT EDIT.
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// NewNodeID copies b into NodeID, returns an error if b is not exactly 32 bytes long.
+func NewNodeID(b []byte) (NodeID, error) {
+	var v NodeID
+	if len(b) != len(v) {
+		return v, fmt.Errorf("%w: expected 32 bytes, got %d", ErrInvalidBytesLength, len(b))
+	}
+	copy(v[:], b)
+	return v, nil
+}
+
+// Equal returns true if both values hold the same bytes.
+func (id NodeID) Equal(x NodeID) bool {
+	return id == x
+}
+
+// IsZero returns true if all bytes of the node id are zero.
+func (id NodeID) IsZero() bool {
+	return id == NodeID{}
+}
+
+// String returns 0x-prefixed hex representation of the node id.
+func (id NodeID) String() string {
+	return string(appendHex(nil, id[:]))
+}
+
+// MarshalText encodes node id as 0x-prefixed hex string.
+func (id NodeID) MarshalText() ([]byte, error) {
+	return appendHex(make([]byte, 0, 66), id[:]), nil
+}
+
+// UnmarshalText decodes node id from 0x-prefixed hex string.
+func (id *NodeID) UnmarshalText(text []byte) error {
+	return decodeHexInto(id[:], text)
+}
+
+// HashTreeRoot returns calculated hash root (which is the node id itself).
+func (id NodeID) HashTreeRoot() ([32]byte, error) {
+	return id, nil
+}
+
+// HashTreeRootInto writes hash root (the node id itself) into dst.
+func (id NodeID) HashTreeRootInto(dst *[32]byte) error {
+	*dst = id
+	return nil
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the node id object.
+func (id *NodeID) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), id.SizeSSZ()); err != nil {
+		return err
+	}
+	copy(id[:], buf)
+	return nil
+}
+
+// MarshalSSZTo marshals node id with the provided byte slice.
+func (id *NodeID) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return append(dst, id[:]...), nil
+}
+
+// MarshalSSZ marshals node id into a serialized object.
+func (id *NodeID) MarshalSSZ() ([]byte, error) {
+	return append(make([]byte, 0, 32), id[:]...), nil
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (id *NodeID) SizeSSZ() int {
+	return 32
+}
@@ -0,0 +1,123 @@
+package types
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Optional holds uint64-backed value which may be absent, distinguishing "not set" from zero
+// (which is a valid slot or epoch). Absent values are encoded as JSON null and SQL NULL.
+// The zero value is absent.
+type Optional[T Uint64Like] struct {
+	value T
+	set   bool
+}
+
+// OptionalSlot is a slot which may be absent.
+type OptionalSlot = Optional[Slot]
+
+// OptionalEpoch is an epoch which may be absent.
+type OptionalEpoch = Optional[Epoch]
+
+// Some returns optional holding v.
+func Some[T Uint64Like](v T) Optional[T] {
+	return Optional[T]{value: v, set: true}
+}
+
+// None returns absent optional.
+func None[T Uint64Like]() Optional[T] {
+	return Optional[T]{}
+}
+
+// Get returns held value, false is returned if value is absent.
+func (o Optional[T]) Get() (T, bool) {
+	return o.value, o.set
+}
+
+// IsSet returns true if value is present.
+func (o Optional[T]) IsSet() bool {
+	return o.set
+}
+
+// OrElse returns held value, or fallback if value is absent.
+func (o Optional[T]) OrElse(fallback T) T {
+	if o.set {
+		return o.value
+	}
+	return fallback
+}
+
+// String returns decimal representation of the value, or "none" if absent.
+func (o Optional[T]) String() string {
+	if !o.set {
+		return "none"
+	}
+	return strconv.FormatUint(uint64(o.value), 10)
+}
+
+// MarshalJSON encodes value using its own JSON encoding, absent value is encoded as null.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	if !o.set {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON decodes value, null results in absent value.
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*o = Optional[T]{}
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*o = Some(v)
+	return nil
+}
+
+// Value implements driver.Valuer, values above math.MaxInt64 can't be stored as SQL integers and
+// result in an error.
+func (o Optional[T]) Value() (driver.Value, error) {
+	if !o.set {
+		return nil, nil
+	}
+	if uint64(o.value) > math.MaxInt64 {
+		return nil, fmt.Errorf("value %d exceeds SQL integer range", uint64(o.value))
+	}
+	return int64(o.value), nil
+}
+
+// Scan implements sql.Scanner, accepting integers, decimal strings and NULL.
+func (o *Optional[T]) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*o = Optional[T]{}
+	case int64:
+		if v < 0 {
+			return fmt.Errorf("cannot scan negative value %d", v)
+		}
+		*o = Some(T(v))
+	case []byte:
+		return o.scanString(string(v))
+	case string:
+		return o.scanString(v)
+	default:
+		return fmt.Errorf("cannot scan %T", src)
+	}
+	return nil
+}
+
+func (o *Optional[T]) scanString(s string) error {
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("cannot scan %q: %w", s, err)
+	}
+	*o = Some(T(v))
+	return nil
+}
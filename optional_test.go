@@ -0,0 +1,72 @@
+package types
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+	"math"
+	"testing"
+)
+
+var (
+	_ sql.Scanner   = (*OptionalSlot)(nil)
+	_ driver.Valuer = OptionalEpoch{}
+)
+
+func TestOptional_JSON(t *testing.T) {
+	type response struct {
+		Slot  OptionalSlot  `json:"slot"`
+		Epoch OptionalEpoch `json:"epoch"`
+	}
+	enc, err := json.Marshal(response{Slot: Some(Slot(0))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(enc) != `{"slot":"0","epoch":null}` {
+		t.Errorf("Unexpected JSON: %s", enc)
+	}
+
+	var decoded response
+	if err := json.Unmarshal([]byte(`{"slot":"12","epoch":null}`), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if slot, ok := decoded.Slot.Get(); !ok || slot != 12 {
+		t.Errorf("Unexpected slot: %v", decoded.Slot)
+	}
+	if decoded.Epoch.IsSet() || decoded.Epoch.OrElse(7) != 7 {
+		t.Errorf("Unexpected epoch: %v", decoded.Epoch)
+	}
+	if err := json.Unmarshal([]byte(`{"slot":"x"}`), &decoded); err == nil {
+		t.Error("Expected error for invalid slot")
+	}
+}
+
+func TestOptional_SQL(t *testing.T) {
+	if v, err := None[Slot]().Value(); err != nil || v != nil {
+		t.Errorf("Unexpected value: %v (%v)", v, err)
+	}
+	if v, err := Some(Epoch(5)).Value(); err != nil || v != int64(5) {
+		t.Errorf("Unexpected value: %v (%v)", v, err)
+	}
+	if _, err := Some(FarFutureEpoch).Value(); err == nil {
+		t.Error("Expected error for value out of SQL range")
+	}
+
+	var o OptionalSlot
+	for src, want := range map[interface{}]string{nil: "none", int64(3): "3", "4": "4"} {
+		if err := o.Scan(src); err != nil {
+			t.Fatal(err)
+		}
+		if o.String() != want {
+			t.Errorf("Scan(%v) = %v, want %s", src, o, want)
+		}
+	}
+	if err := o.Scan([]byte("18446744073709551615")); err != nil || o.OrElse(0) != math.MaxUint64 {
+		t.Errorf("Unexpected value: %v (%v)", o, err)
+	}
+	for _, src := range []interface{}{int64(-1), "abc", 1.5} {
+		if err := o.Scan(src); err == nil {
+			t.Errorf("Expected error scanning %v", src)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+// Package parquetconv provides parquet column support (github.com/parquet-go/parquet-go) for
+// shared types, for chain-analytics exporters writing beacon data in parquet format.
+//
+// Slot, Epoch and Gwei are stored as INT64 columns annotated with the unsigned 64-bit integer
+// logical type, so values above math.MaxInt64 (e.g. FarFutureEpoch) survive round trips, and Root
+// is stored as FIXED_LEN_BYTE_ARRAY(32). Struct-derived schemas (parquet.SchemaOf) use the same
+// column types, the nodes below are for hand-built schemas:
+//
+//	schema := parquet.NewSchema("block", parquet.Group{
+//		"slot": parquetconv.SlotNode(),
+//		"root": parquetconv.RootNode(),
+//	})
+//	row := parquet.Row{parquetconv.RootValue(root).Level(0, 0, 0), parquetconv.SlotValue(slot).Level(0, 0, 1)}
+//
+// The package is a separate module, so that parquet-go is only pulled in by exporters.
+package parquetconv
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/parquet-go/parquet-go"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+// ErrInvalidValue is returned when parquet value has unexpected kind or length.
+var ErrInvalidValue = errors.New("invalid parquet value")
+
+// SlotNode returns parquet node of the slot column.
+func SlotNode() parquet.Node {
+	return parquet.Uint(64)
+}
+
+// EpochNode returns parquet node of the epoch column.
+func EpochNode() parquet.Node {
+	return parquet.Uint(64)
+}
+
+// GweiNode returns parquet node of the gwei column.
+func GweiNode() parquet.Node {
+	return parquet.Uint(64)
+}
+
+// RootNode returns parquet node of the root column.
+func RootNode() parquet.Node {
+	return parquet.Leaf(parquet.FixedLenByteArrayType(len(types.Root{})))
+}
+
+// SlotValue converts slot into parquet value.
+func SlotValue(slot types.Slot) parquet.Value {
+	return uint64Value(uint64(slot))
+}
+
+// SlotFromValue converts parquet value read from the slot column into slot.
+func SlotFromValue(v parquet.Value) (types.Slot, error) {
+	n, err := uint64FromValue(v)
+	return types.Slot(n), err
+}
+
+// EpochValue converts epoch into parquet value.
+func EpochValue(epoch types.Epoch) parquet.Value {
+	return uint64Value(uint64(epoch))
+}
+
+// EpochFromValue converts parquet value read from the epoch column into epoch.
+func EpochFromValue(v parquet.Value) (types.Epoch, error) {
+	n, err := uint64FromValue(v)
+	return types.Epoch(n), err
+}
+
+// GweiValue converts gwei into parquet value.
+func GweiValue(gwei types.Gwei) parquet.Value {
+	return uint64Value(uint64(gwei))
+}
+
+// GweiFromValue converts parquet value read from the gwei column into gwei.
+func GweiFromValue(v parquet.Value) (types.Gwei, error) {
+	n, err := uint64FromValue(v)
+	return types.Gwei(n), err
+}
+
+// RootValue converts root into parquet value.
+func RootValue(root types.Root) parquet.Value {
+	return parquet.FixedLenByteArrayValue(root[:])
+}
+
+// RootFromValue converts parquet value read from the root column into root.
+func RootFromValue(v parquet.Value) (types.Root, error) {
+	var root types.Root
+	if v.Kind() != parquet.FixedLenByteArray {
+		return root, fmt.Errorf("%w: %s, want %s", ErrInvalidValue, v.Kind(), parquet.FixedLenByteArray)
+	}
+	if len(v.ByteArray()) != len(root) {
+		return root, fmt.Errorf("%w: %d bytes, want %d", ErrInvalidValue, len(v.ByteArray()), len(root))
+	}
+	copy(root[:], v.ByteArray())
+	return root, nil
+}
+
+// uint64Value stores v in INT64 physical value, as done for the unsigned 64-bit logical type.
+func uint64Value(v uint64) parquet.Value {
+	return parquet.Int64Value(int64(v))
+}
+
+func uint64FromValue(v parquet.Value) (uint64, error) {
+	if v.Kind() != parquet.Int64 {
+		return 0, fmt.Errorf("%w: %s, want %s", ErrInvalidValue, v.Kind(), parquet.Int64)
+	}
+	return v.Uint64(), nil
+}
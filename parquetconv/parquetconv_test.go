@@ -0,0 +1,124 @@
+package parquetconv
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math"
+	"testing"
+
+	"github.com/parquet-go/parquet-go"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+type blockRow struct {
+	Slot    types.Slot  `parquet:"slot"`
+	Epoch   types.Epoch `parquet:"epoch"`
+	Balance types.Gwei  `parquet:"balance"`
+	Root    types.Root  `parquet:"root"`
+}
+
+func TestSchemaOf(t *testing.T) {
+	schema := parquet.SchemaOf(blockRow{})
+	for name, node := range map[string]parquet.Node{
+		"slot":    SlotNode(),
+		"epoch":   EpochNode(),
+		"balance": GweiNode(),
+		"root":    RootNode(),
+	} {
+		column, ok := schema.Lookup(name)
+		if !ok {
+			t.Fatalf("Column %q not found", name)
+		}
+		if !parquet.EqualNodes(column.Node, node) {
+			t.Errorf("Unexpected node of %q column: %v, want %v", name, column.Node.Type(), node.Type())
+		}
+	}
+}
+
+func TestRoundTrip_Struct(t *testing.T) {
+	rows := []blockRow{
+		{Slot: 1, Epoch: 0, Balance: 32e9, Root: types.Root{0x01, 31: 0xff}},
+		{Slot: math.MaxUint64, Epoch: math.MaxUint64, Balance: math.MaxUint64, Root: types.Root{}},
+	}
+	var buf bytes.Buffer
+	w := parquet.NewGenericWriter[blockRow](&buf)
+	if _, err := w.Write(rows); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	r := parquet.NewGenericReader[blockRow](bytes.NewReader(buf.Bytes()))
+	defer r.Close()
+	decoded := make([]blockRow, len(rows))
+	if n, err := r.Read(decoded); n != len(rows) || (err != nil && !errors.Is(err, io.EOF)) {
+		t.Fatalf("Unexpected read result: %d, %v", n, err)
+	}
+	for i := range rows {
+		if decoded[i] != rows[i] {
+			t.Errorf("Unexpected row %d: %+v, want %+v", i, decoded[i], rows[i])
+		}
+	}
+}
+
+func TestRoundTrip_Values(t *testing.T) {
+	// Group columns are ordered by name: epoch, gwei, root, slot.
+	schema := parquet.NewSchema("block", parquet.Group{
+		"slot":  SlotNode(),
+		"epoch": EpochNode(),
+		"gwei":  GweiNode(),
+		"root":  RootNode(),
+	})
+	root := types.Root{0xaa, 31: 0xbb}
+	var buf bytes.Buffer
+	w := parquet.NewWriter(&buf, schema)
+	if _, err := w.WriteRows([]parquet.Row{{
+		EpochValue(math.MaxUint64).Level(0, 0, 0),
+		GweiValue(32e9).Level(0, 0, 1),
+		RootValue(root).Level(0, 0, 2),
+		SlotValue(123).Level(0, 0, 3),
+	}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	r := parquet.NewReader(bytes.NewReader(buf.Bytes()))
+	defer r.Close()
+	rows := make([]parquet.Row, 1)
+	if n, err := r.ReadRows(rows); n != 1 || (err != nil && !errors.Is(err, io.EOF)) {
+		t.Fatalf("Unexpected read result: %d, %v", n, err)
+	}
+	row := rows[0]
+	if epoch, err := EpochFromValue(row[0]); err != nil || epoch != math.MaxUint64 {
+		t.Errorf("Unexpected epoch: %d, %v", epoch, err)
+	}
+	if gwei, err := GweiFromValue(row[1]); err != nil || gwei != 32e9 {
+		t.Errorf("Unexpected gwei: %d, %v", gwei, err)
+	}
+	if got, err := RootFromValue(row[2]); err != nil || got != root {
+		t.Errorf("Unexpected root: %#x, %v", got, err)
+	}
+	if slot, err := SlotFromValue(row[3]); err != nil || slot != 123 {
+		t.Errorf("Unexpected slot: %d, %v", slot, err)
+	}
+}
+
+func TestFromValue_Invalid(t *testing.T) {
+	if _, err := SlotFromValue(parquet.ByteArrayValue([]byte("1"))); !errors.Is(err, ErrInvalidValue) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if _, err := EpochFromValue(parquet.Int32Value(1)); !errors.Is(err, ErrInvalidValue) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if _, err := RootFromValue(parquet.FixedLenByteArrayValue(make([]byte, 20))); !errors.Is(err, ErrInvalidValue) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if _, err := RootFromValue(parquet.Int64Value(1)); !errors.Is(err, ErrInvalidValue) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,112 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParticipationRateScale is the fixed-point scale of ParticipationRate (value representing 100%).
+const ParticipationRateScale = ParticipationRate(1_000_000_000)
+
+// participationRateDecimals is the number of decimal places of ParticipationRate fraction.
+const participationRateDecimals = 9
+
+// ErrInvalidParticipationRate is returned when participation rate is out of [0, 1] range or malformed.
+var ErrInvalidParticipationRate = errors.New("invalid participation rate")
+
+// ParticipationRate is a fixed-point fraction (scaled by 10^9) of participating stake, in [0, 1] range.
+// It is encoded as a decimal fraction (e.g. 0.987654321) in JSON and text, and formatted as a
+// percentage (e.g. "98.7654321%") by String.
+type ParticipationRate uint64
+
+// NewParticipationRate returns share of participating balance in total balance, rounded down.
+// Zero total results in zero rate, participating balance exceeding total is an error.
+func NewParticipationRate(participating, total Gwei) (ParticipationRate, error) {
+	if participating > total {
+		return 0, fmt.Errorf("%w: participating balance %d exceeds total %d", ErrInvalidParticipationRate, participating, total)
+	}
+	if total == 0 {
+		return 0, nil
+	}
+	return ParticipationRate(MulDiv(participating, uint64(ParticipationRateScale), uint64(total))), nil
+}
+
+// ParseParticipationRate parses rate given either as a fraction (e.g. "0.75") or as a percentage
+// (e.g. "75%"), with at most nine decimal places of the fraction.
+func ParseParticipationRate(s string) (ParticipationRate, error) {
+	decimals := participationRateDecimals
+	value := strings.TrimSpace(s)
+	if strings.HasSuffix(value, "%") {
+		value = strings.TrimSpace(value[:len(value)-1])
+		decimals -= 2
+	}
+	whole, frac, _ := strings.Cut(value, ".")
+	if whole == "" || len(frac) > decimals {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidParticipationRate, s)
+	}
+	x, err := strconv.ParseUint(whole+frac+strings.Repeat("0", decimals-len(frac)), 10, 64)
+	if err != nil || x > uint64(ParticipationRateScale) {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidParticipationRate, s)
+	}
+	return ParticipationRate(x), nil
+}
+
+// Float64 returns rate as a fraction in [0, 1] range.
+func (r ParticipationRate) Float64() float64 {
+	return float64(r) / float64(ParticipationRateScale)
+}
+
+// Percent returns rate as a percentage in [0, 100] range.
+func (r ParticipationRate) Percent() float64 {
+	return r.Float64() * 100
+}
+
+// String returns rate formatted as a percentage, e.g. "66.6666666%".
+func (r ParticipationRate) String() string {
+	return string(appendFixed(nil, uint64(r), participationRateDecimals-2)) + "%"
+}
+
+// MarshalText encodes rate as a decimal fraction.
+func (r ParticipationRate) MarshalText() ([]byte, error) {
+	return appendFixed(nil, uint64(r), participationRateDecimals), nil
+}
+
+// UnmarshalText decodes rate from a decimal fraction or a percentage.
+func (r *ParticipationRate) UnmarshalText(text []byte) error {
+	rate, err := ParseParticipationRate(string(text))
+	if err != nil {
+		return err
+	}
+	*r = rate
+	return nil
+}
+
+// MarshalJSON encodes rate as a JSON number holding decimal fraction.
+func (r ParticipationRate) MarshalJSON() ([]byte, error) {
+	return r.MarshalText()
+}
+
+// UnmarshalJSON decodes rate from either a JSON number or a quoted string.
+func (r *ParticipationRate) UnmarshalJSON(data []byte) error {
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		data = data[1 : len(data)-1]
+	}
+	return r.UnmarshalText(data)
+}
+
+// appendFixed appends x divided by 10^decimals as a decimal number, omitting trailing zeros.
+func appendFixed(dst []byte, x uint64, decimals int) []byte {
+	digits := strconv.FormatUint(x, 10)
+	if len(digits) <= decimals {
+		digits = strings.Repeat("0", decimals-len(digits)+1) + digits
+	}
+	whole, frac := digits[:len(digits)-decimals], strings.TrimRight(digits[len(digits)-decimals:], "0")
+	dst = append(dst, whole...)
+	if frac != "" {
+		dst = append(dst, '.')
+		dst = append(dst, frac...)
+	}
+	return dst
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestNewParticipationRate(t *testing.T) {
+	tests := []struct {
+		participating, total Gwei
+		want                 ParticipationRate
+		str                  string
+	}{
+		{participating: 2, total: 3, want: 666_666_666, str: "66.6666666%"},
+		{participating: 32 * GweiPerEth, total: 32 * GweiPerEth, want: ParticipationRateScale, str: "100%"},
+		{participating: 0, total: 0, want: 0, str: "0%"},
+		{participating: 1, total: 4, want: 250_000_000, str: "25%"},
+		{participating: 1, total: 3_000_000_000, want: 0, str: "0%"},
+		{participating: 1<<64 - 2, total: 1<<64 - 1, want: 999_999_999, str: "99.9999999%"},
+	}
+	for _, tt := range tests {
+		got, err := NewParticipationRate(tt.participating, tt.total)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want || got.String() != tt.str {
+			t.Errorf("Unexpected rate of %d/%d: %d (%v)", tt.participating, tt.total, got, got)
+		}
+	}
+	if _, err := NewParticipationRate(2, 1); !errors.Is(err, ErrInvalidParticipationRate) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if got := ParticipationRate(750_000_000).Percent(); got != 75 {
+		t.Errorf("Unexpected percent: %v", got)
+	}
+}
+
+func TestParseParticipationRate(t *testing.T) {
+	tests := []struct {
+		input   string
+		want    ParticipationRate
+		wantErr bool
+	}{
+		{input: "0.75", want: 750_000_000},
+		{input: "75%", want: 750_000_000},
+		{input: "66.6666666 %", want: 666_666_666},
+		{input: "1", want: ParticipationRateScale},
+		{input: "0.000000001", want: 1},
+		{input: "0.0000000001", wantErr: true},
+		{input: "1.1", wantErr: true},
+		{input: "101%", wantErr: true},
+		{input: ".5", wantErr: true},
+		{input: "-0.5", wantErr: true},
+		{input: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseParticipationRate(tt.input)
+		if tt.wantErr {
+			if !errors.Is(err, ErrInvalidParticipationRate) {
+				t.Errorf("Expected error for %q, got: %v", tt.input, err)
+			}
+			continue
+		}
+		if err != nil || got != tt.want {
+			t.Errorf("Unexpected rate for %q: %d, %v", tt.input, got, err)
+		}
+	}
+}
+
+func TestParticipationRate_JSON(t *testing.T) {
+	type report struct {
+		Rate ParticipationRate `json:"rate"`
+	}
+	data, err := json.Marshal(report{Rate: 987_654_321})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != `{"rate":0.987654321}` {
+		t.Errorf("Unexpected encoding: %s", data)
+	}
+	var decoded report
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded.Rate != 987_654_321 {
+		t.Errorf("Unexpected decoded rate: %d, %v", decoded.Rate, err)
+	}
+	if err := json.Unmarshal([]byte(`{"rate":"50%"}`), &decoded); err != nil || decoded.Rate != 500_000_000 {
+		t.Errorf("Unexpected decoded rate: %d, %v", decoded.Rate, err)
+	}
+	data, _ = json.Marshal(report{Rate: ParticipationRateScale})
+	if string(data) != `{"rate":1}` {
+		t.Errorf("Unexpected encoding: %s", data)
+	}
+}
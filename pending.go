@@ -0,0 +1,212 @@
+package types
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// PendingDeposit is the spec (Electra) PendingDeposit container: a deposit waiting in the state's
+// pending_deposits queue to be applied, subject to the balance churn limit.
+type PendingDeposit struct {
+	Pubkey                BLSPubkey    `json:"pubkey"`
+	WithdrawalCredentials Root         `json:"withdrawal_credentials"`
+	Amount                Gwei         `json:"amount"`
+	Signature             BLSSignature `json:"signature"`
+	Slot                  Slot         `json:"slot"`
+}
+
+// Message returns the signed part of the deposit.
+func (d *PendingDeposit) Message() *DepositMessage {
+	return &DepositMessage{Pubkey: d.Pubkey, WithdrawalCredentials: d.WithdrawalCredentials, Amount: d.Amount}
+}
+
+// HashTreeRoot returns calculated hash root.
+func (d *PendingDeposit) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	err := d.HashTreeRootInto(&root)
+	return root, err
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (d *PendingDeposit) HashTreeRootInto(dst *[32]byte) error {
+	var chunks [5][32]byte
+	if err := d.Pubkey.HashTreeRootInto(&chunks[0]); err != nil {
+		return err
+	}
+	chunks[1] = d.WithdrawalCredentials
+	_ = d.Amount.HashTreeRootInto(&chunks[2])
+	if err := d.Signature.HashTreeRootInto(&chunks[3]); err != nil {
+		return err
+	}
+	_ = d.Slot.HashTreeRootInto(&chunks[4])
+	return merkleizeInto(dst, chunks[:], 0)
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the pending deposit object.
+func (d *PendingDeposit) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), d.SizeSSZ()); err != nil {
+		return err
+	}
+	copy(d.Pubkey[:], buf[:48])
+	copy(d.WithdrawalCredentials[:], buf[48:80])
+	d.Amount = Gwei(binary.LittleEndian.Uint64(buf[80:88]))
+	copy(d.Signature[:], buf[88:184])
+	d.Slot = Slot(binary.LittleEndian.Uint64(buf[184:192]))
+	return nil
+}
+
+// MarshalSSZTo marshals pending deposit with the provided byte slice.
+func (d *PendingDeposit) MarshalSSZTo(dst []byte) ([]byte, error) {
+	dst = append(dst, d.Pubkey[:]...)
+	dst = append(dst, d.WithdrawalCredentials[:]...)
+	dst = d.Amount.AppendSSZ(dst)
+	dst = append(dst, d.Signature[:]...)
+	return d.Slot.AppendSSZ(dst), nil
+}
+
+// MarshalSSZ marshals pending deposit into a serialized object.
+func (d *PendingDeposit) MarshalSSZ() ([]byte, error) {
+	return d.MarshalSSZTo(make([]byte, 0, d.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (d *PendingDeposit) SizeSSZ() int {
+	return 192
+}
+
+// WriteTo writes SSZ serialized pending deposit to w.
+func (d *PendingDeposit) WriteTo(w io.Writer) (int64, error) {
+	return writeSSZTo(w, d)
+}
+
+// ReadFrom reads SSZ serialized pending deposit from reader (exactly SizeSSZ bytes are consumed).
+func (d *PendingDeposit) ReadFrom(reader io.Reader) (int64, error) {
+	return readSSZFrom(reader, d)
+}
+
+// PendingPartialWithdrawal is the spec (Electra) PendingPartialWithdrawal container: an execution
+// layer triggered partial withdrawal, processed once its withdrawable epoch is reached.
+type PendingPartialWithdrawal struct {
+	ValidatorIndex    ValidatorIndex `json:"validator_index"`
+	Amount            Gwei           `json:"amount"`
+	WithdrawableEpoch Epoch          `json:"withdrawable_epoch"`
+}
+
+// IsWithdrawable returns true if withdrawal can be processed at the epoch.
+func (w *PendingPartialWithdrawal) IsWithdrawable(epoch Epoch) bool {
+	return w.WithdrawableEpoch <= epoch
+}
+
+// HashTreeRoot returns calculated hash root.
+func (w *PendingPartialWithdrawal) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	err := w.HashTreeRootInto(&root)
+	return root, err
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (w *PendingPartialWithdrawal) HashTreeRootInto(dst *[32]byte) error {
+	var chunks [3][32]byte
+	_ = w.ValidatorIndex.HashTreeRootInto(&chunks[0])
+	_ = w.Amount.HashTreeRootInto(&chunks[1])
+	_ = w.WithdrawableEpoch.HashTreeRootInto(&chunks[2])
+	return merkleizeInto(dst, chunks[:], 0)
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the pending partial withdrawal object.
+func (w *PendingPartialWithdrawal) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), w.SizeSSZ()); err != nil {
+		return err
+	}
+	w.ValidatorIndex = ValidatorIndex(binary.LittleEndian.Uint64(buf[:8]))
+	w.Amount = Gwei(binary.LittleEndian.Uint64(buf[8:16]))
+	w.WithdrawableEpoch = Epoch(binary.LittleEndian.Uint64(buf[16:24]))
+	return nil
+}
+
+// MarshalSSZTo marshals pending partial withdrawal with the provided byte slice.
+func (w *PendingPartialWithdrawal) MarshalSSZTo(dst []byte) ([]byte, error) {
+	dst = w.ValidatorIndex.AppendSSZ(dst)
+	dst = w.Amount.AppendSSZ(dst)
+	return w.WithdrawableEpoch.AppendSSZ(dst), nil
+}
+
+// MarshalSSZ marshals pending partial withdrawal into a serialized object.
+func (w *PendingPartialWithdrawal) MarshalSSZ() ([]byte, error) {
+	return w.MarshalSSZTo(make([]byte, 0, w.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (w *PendingPartialWithdrawal) SizeSSZ() int {
+	return 24
+}
+
+// WriteTo writes SSZ serialized pending partial withdrawal to writer.
+func (w *PendingPartialWithdrawal) WriteTo(writer io.Writer) (int64, error) {
+	return writeSSZTo(writer, w)
+}
+
+// ReadFrom reads SSZ serialized pending partial withdrawal from reader (exactly SizeSSZ bytes are consumed).
+func (w *PendingPartialWithdrawal) ReadFrom(reader io.Reader) (int64, error) {
+	return readSSZFrom(reader, w)
+}
+
+// PendingConsolidation is the spec (Electra) PendingConsolidation container: a request to move
+// balance of the source validator to the target one, processed once the source becomes withdrawable.
+type PendingConsolidation struct {
+	SourceIndex ValidatorIndex `json:"source_index"`
+	TargetIndex ValidatorIndex `json:"target_index"`
+}
+
+// HashTreeRoot returns calculated hash root.
+func (c *PendingConsolidation) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	err := c.HashTreeRootInto(&root)
+	return root, err
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (c *PendingConsolidation) HashTreeRootInto(dst *[32]byte) error {
+	var chunks [2][32]byte
+	_ = c.SourceIndex.HashTreeRootInto(&chunks[0])
+	_ = c.TargetIndex.HashTreeRootInto(&chunks[1])
+	return merkleizeInto(dst, chunks[:], 0)
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the pending consolidation object.
+func (c *PendingConsolidation) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), c.SizeSSZ()); err != nil {
+		return err
+	}
+	c.SourceIndex = ValidatorIndex(binary.LittleEndian.Uint64(buf[:8]))
+	c.TargetIndex = ValidatorIndex(binary.LittleEndian.Uint64(buf[8:16]))
+	return nil
+}
+
+// MarshalSSZTo marshals pending consolidation with the provided byte slice.
+func (c *PendingConsolidation) MarshalSSZTo(dst []byte) ([]byte, error) {
+	dst = c.SourceIndex.AppendSSZ(dst)
+	return c.TargetIndex.AppendSSZ(dst), nil
+}
+
+// MarshalSSZ marshals pending consolidation into a serialized object.
+func (c *PendingConsolidation) MarshalSSZ() ([]byte, error) {
+	return c.MarshalSSZTo(make([]byte, 0, c.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (c *PendingConsolidation) SizeSSZ() int {
+	return 16
+}
+
+// WriteTo writes SSZ serialized pending consolidation to w.
+func (c *PendingConsolidation) WriteTo(w io.Writer) (int64, error) {
+	return writeSSZTo(w, c)
+}
+
+// ReadFrom reads SSZ serialized pending consolidation from reader (exactly SizeSSZ bytes are consumed).
+func (c *PendingConsolidation) ReadFrom(reader io.Reader) (int64, error) {
+	return readSSZFrom(reader, c)
+}
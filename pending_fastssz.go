@@ -0,0 +1,47 @@
+//go:build !nofastssz
+
+package types
+
+import fssz "github.com/ferranbt/fastssz"
+
+var _ fssz.HashRoot = (*PendingDeposit)(nil)
+var _ fssz.Marshaler = (*PendingDeposit)(nil)
+var _ fssz.Unmarshaler = (*PendingDeposit)(nil)
+
+var _ fssz.HashRoot = (*PendingPartialWithdrawal)(nil)
+var _ fssz.Marshaler = (*PendingPartialWithdrawal)(nil)
+var _ fssz.Unmarshaler = (*PendingPartialWithdrawal)(nil)
+
+var _ fssz.HashRoot = (*PendingConsolidation)(nil)
+var _ fssz.Marshaler = (*PendingConsolidation)(nil)
+var _ fssz.Unmarshaler = (*PendingConsolidation)(nil)
+
+// HashTreeRootWith appends pending deposit root to the provided hasher.
+func (d *PendingDeposit) HashTreeRootWith(hh *fssz.Hasher) error {
+	root, err := d.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	hh.PutBytes(root[:])
+	return nil
+}
+
+// HashTreeRootWith appends pending partial withdrawal root to the provided hasher.
+func (w *PendingPartialWithdrawal) HashTreeRootWith(hh *fssz.Hasher) error {
+	root, err := w.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	hh.PutBytes(root[:])
+	return nil
+}
+
+// HashTreeRootWith appends pending consolidation root to the provided hasher.
+func (c *PendingConsolidation) HashTreeRootWith(hh *fssz.Hasher) error {
+	root, err := c.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	hh.PutBytes(root[:])
+	return nil
+}
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPending_SSZ(t *testing.T) {
+	type sszObject interface {
+		MarshalSSZ() ([]byte, error)
+		UnmarshalSSZ([]byte) error
+		SizeSSZ() int
+	}
+	tests := []struct {
+		name    string
+		obj     sszObject
+		decoded sszObject
+		size    int
+	}{
+		{"deposit", testPendingDeposit(), &PendingDeposit{}, 192},
+		{"partial withdrawal", &PendingPartialWithdrawal{ValidatorIndex: 1, Amount: 2, WithdrawableEpoch: 3}, &PendingPartialWithdrawal{}, 24},
+		{"consolidation", &PendingConsolidation{SourceIndex: 1, TargetIndex: 2}, &PendingConsolidation{}, 16},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			enc, err := tt.obj.MarshalSSZ()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(enc) != tt.size || tt.obj.SizeSSZ() != tt.size {
+				t.Errorf("Unexpected length: %d", len(enc))
+			}
+			if err := tt.decoded.UnmarshalSSZ(enc); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(tt.decoded, tt.obj) {
+				t.Errorf("Unexpected decoded object: %+v", tt.decoded)
+			}
+			if err := tt.decoded.UnmarshalSSZ(enc[1:]); err == nil {
+				t.Error("Expected error on short buffer")
+			}
+		})
+	}
+}
+
+func TestPending_JSON(t *testing.T) {
+	enc, err := json.Marshal(testPendingDeposit())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"pubkey":"0x93` + strings.Repeat("00", 47) + `","withdrawal_credentials":"0x01` + strings.Repeat("00", 31) +
+		`","amount":"32000000000","signature":"0xaa` + strings.Repeat("00", 95) + `","slot":"12345"}`
+	if string(enc) != want {
+		t.Errorf("Unexpected JSON: %s", enc)
+	}
+	enc, err = json.Marshal(&PendingPartialWithdrawal{ValidatorIndex: 1, Amount: 2, WithdrawableEpoch: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(enc) != `{"validator_index":"1","amount":"2","withdrawable_epoch":"3"}` {
+		t.Errorf("Unexpected JSON: %s", enc)
+	}
+	enc, err = json.Marshal(&PendingConsolidation{SourceIndex: 1, TargetIndex: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(enc) != `{"source_index":"1","target_index":"2"}` {
+		t.Errorf("Unexpected JSON: %s", enc)
+	}
+}
+
+func TestPendingDeposit_Message(t *testing.T) {
+	d := testPendingDeposit()
+	msg := d.Message()
+	if msg.Pubkey != d.Pubkey || msg.WithdrawalCredentials != d.WithdrawalCredentials || msg.Amount != d.Amount {
+		t.Errorf("Unexpected message: %+v", msg)
+	}
+	w := &PendingPartialWithdrawal{WithdrawableEpoch: 10}
+	if w.IsWithdrawable(9) || !w.IsWithdrawable(10) {
+		t.Error("Unexpected withdrawability")
+	}
+}
@@ -0,0 +1,85 @@
+package types
+
+// Typed values of the mainnet preset (and mainnet config), for simple tools doing conversions without
+// loading a full ChainSpec.
+const (
+	SlotsPerEpochMainnet                Slot  = 32
+	SecondsPerSlotMainnet                     = 12
+	MinAttestationInclusionDelayMainnet Slot  = 1
+	EffectiveBalanceIncrementMainnet    Gwei  = 1_000_000_000
+	MaxEffectiveBalanceMainnet          Gwei  = 32_000_000_000
+	MaxEffectiveBalanceElectraMainnet   Gwei  = 2_048_000_000_000
+	EpochsPerSyncCommitteePeriodMainnet Epoch = 256
+	SyncCommitteeSizeMainnet                  = 512
+	MinSeedLookaheadMainnet             Epoch = 1
+	MaxSeedLookaheadMainnet             Epoch = 4
+	EpochsPerHistoricalVectorMainnet    Epoch = 65536
+	SlotsPerHistoricalRootMainnet       Slot  = 8192
+)
+
+// Typed values of the minimal preset (and minimal config), used by spec tests and local devnets.
+const (
+	SlotsPerEpochMinimal                Slot  = 8
+	SecondsPerSlotMinimal                     = 6
+	MinAttestationInclusionDelayMinimal Slot  = 1
+	EffectiveBalanceIncrementMinimal    Gwei  = 1_000_000_000
+	MaxEffectiveBalanceMinimal          Gwei  = 32_000_000_000
+	MaxEffectiveBalanceElectraMinimal   Gwei  = 2_048_000_000_000
+	EpochsPerSyncCommitteePeriodMinimal Epoch = 8
+	SyncCommitteeSizeMinimal                  = 32
+	MinSeedLookaheadMinimal             Epoch = 1
+	MaxSeedLookaheadMinimal             Epoch = 4
+	EpochsPerHistoricalVectorMinimal    Epoch = 64
+	SlotsPerHistoricalRootMinimal       Slot  = 64
+)
+
+// MainnetChainSpec returns complete chain spec of the mainnet preset and config.
+func MainnetChainSpec() *ChainSpec {
+	return &ChainSpec{
+		SlotsPerEpoch:                       SlotsPerEpochMainnet,
+		SecondsPerSlot:                      SecondsPerSlotMainnet,
+		MinAttestationInclusionDelay:        MinAttestationInclusionDelayMainnet,
+		EffectiveBalanceIncrement:           EffectiveBalanceIncrementMainnet,
+		MaxEffectiveBalance:                 MaxEffectiveBalanceMainnet,
+		HysteresisQuotient:                  4,
+		HysteresisDownwardMultiplier:        1,
+		HysteresisUpwardMultiplier:          5,
+		MinPerEpochChurnLimit:               4,
+		ChurnLimitQuotient:                  65536,
+		MaxPerEpochActivationChurnLimit:     8,
+		MinPerEpochChurnLimitElectra:        128_000_000_000,
+		MaxPerEpochActivationExitChurnLimit: 256_000_000_000,
+		SyncCommitteeSize:                   SyncCommitteeSizeMainnet,
+		SyncCommitteeSubnetCount:            4,
+		EpochsPerSyncCommitteePeriod:        EpochsPerSyncCommitteePeriodMainnet,
+		MinValidatorWithdrawabilityDelay:    256,
+		MaxDeposits:                         16,
+		SafetyDecay:                         10,
+		MinSeedLookahead:                    MinSeedLookaheadMainnet,
+		MaxSeedLookahead:                    MaxSeedLookaheadMainnet,
+		EpochsPerHistoricalVector:           EpochsPerHistoricalVectorMainnet,
+		SlotsPerHistoricalRoot:              SlotsPerHistoricalRootMainnet,
+		NumberOfColumns:                     128,
+		NumberOfCustodyGroups:               128,
+		BlobSidecarSubnetCount:              6,
+		BlobSidecarSubnetCountElectra:       9,
+		DataColumnSidecarSubnetCount:        128,
+	}
+}
+
+// MinimalChainSpec returns complete chain spec of the minimal preset and config.
+func MinimalChainSpec() *ChainSpec {
+	spec := MainnetChainSpec()
+	spec.SlotsPerEpoch = SlotsPerEpochMinimal
+	spec.SecondsPerSlot = SecondsPerSlotMinimal
+	spec.MinPerEpochChurnLimit = 2
+	spec.ChurnLimitQuotient = 32
+	spec.MaxPerEpochActivationChurnLimit = 4
+	spec.MinPerEpochChurnLimitElectra = 64_000_000_000
+	spec.MaxPerEpochActivationExitChurnLimit = 128_000_000_000
+	spec.SyncCommitteeSize = SyncCommitteeSizeMinimal
+	spec.EpochsPerSyncCommitteePeriod = EpochsPerSyncCommitteePeriodMinimal
+	spec.EpochsPerHistoricalVector = EpochsPerHistoricalVectorMinimal
+	spec.SlotsPerHistoricalRoot = SlotsPerHistoricalRootMinimal
+	return spec
+}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPresetConstants(t *testing.T) {
+	spec := MainnetChainSpec()
+	if err := spec.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if err := MinimalChainSpec().Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if got := Slot(100).ToEpoch(spec); got != 3 {
+		t.Errorf("Unexpected epoch: %v", got)
+	}
+	if d := time.Duration(SecondsPerSlotMinimal) * time.Second * time.Duration(SlotsPerEpochMinimal); d != 48*time.Second {
+		t.Errorf("Unexpected minimal epoch duration: %v", d)
+	}
+	if EpochsPerSyncCommitteePeriodMainnet.Mul(uint64(SlotsPerEpochMainnet)) != 8192 {
+		t.Error("Unexpected mainnet sync committee period length")
+	}
+}
@@ -0,0 +1,52 @@
+// Package promclock exports chain time of a types.Clock as Prometheus gauges, computed on every
+// scrape, so that all services report consistent values without writing their own collector:
+//
+//	prometheus.MustRegister(promclock.NewCollector(clock, "beacon", nil))
+package promclock
+
+import (
+	types "github.com/farazdagi/prysm-shared-types"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Collector is a prometheus.Collector reporting current_slot, current_epoch, seconds_into_slot and
+// slots_until_next_epoch gauges of the clock.
+type Collector struct {
+	clock               *types.Clock
+	currentSlot         *prometheus.Desc
+	currentEpoch        *prometheus.Desc
+	secondsIntoSlot     *prometheus.Desc
+	slotsUntilNextEpoch *prometheus.Desc
+}
+
+// NewCollector creates collector of the clock gauges, with metric names prefixed by the namespace
+// (if not empty) and the given constant labels attached.
+func NewCollector(clock *types.Clock, namespace string, constLabels prometheus.Labels) *Collector {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "", name), help, nil, constLabels)
+	}
+	return &Collector{
+		clock:               clock,
+		currentSlot:         desc("current_slot", "Current slot of the chain."),
+		currentEpoch:        desc("current_epoch", "Current epoch of the chain."),
+		secondsIntoSlot:     desc("seconds_into_slot", "Seconds elapsed since the start of the current slot."),
+		slotsUntilNextEpoch: desc("slots_until_next_epoch", "Slots remaining until the start of the next epoch."),
+	}
+}
+
+// Describe sends descriptors of the clock gauges.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.currentSlot
+	ch <- c.currentEpoch
+	ch <- c.secondsIntoSlot
+	ch <- c.slotsUntilNextEpoch
+}
+
+// Collect sends gauges computed from the clock as of the current wall-clock time.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	m := c.clock.Metrics()
+	ch <- prometheus.MustNewConstMetric(c.currentSlot, prometheus.GaugeValue, float64(m.CurrentSlot))
+	ch <- prometheus.MustNewConstMetric(c.currentEpoch, prometheus.GaugeValue, float64(m.CurrentEpoch))
+	ch <- prometheus.MustNewConstMetric(c.secondsIntoSlot, prometheus.GaugeValue, m.SecondsIntoSlot)
+	ch <- prometheus.MustNewConstMetric(c.slotsUntilNextEpoch, prometheus.GaugeValue, float64(m.SlotsUntilNextEpoch))
+}
@@ -0,0 +1,56 @@
+package promclock
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	types "github.com/farazdagi/prysm-shared-types"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestCollector(t *testing.T) {
+	genesis := time.Date(2020, 12, 1, 12, 0, 23, 0, time.UTC)
+	spec := &types.ChainSpec{SlotsPerEpoch: 32, SecondsPerSlot: 12}
+	now := genesis.Add(100*12*time.Second + 4500*time.Millisecond)
+	clock := types.NewClock(genesis, spec).WithNow(func() time.Time { return now })
+	collector := NewCollector(clock, "beacon", prometheus.Labels{"network": "mainnet"})
+
+	want := `
+# HELP beacon_current_epoch Current epoch of the chain.
+# TYPE beacon_current_epoch gauge
+beacon_current_epoch{network="mainnet"} 3
+# HELP beacon_current_slot Current slot of the chain.
+# TYPE beacon_current_slot gauge
+beacon_current_slot{network="mainnet"} 100
+# HELP beacon_seconds_into_slot Seconds elapsed since the start of the current slot.
+# TYPE beacon_seconds_into_slot gauge
+beacon_seconds_into_slot{network="mainnet"} 4.5
+# HELP beacon_slots_until_next_epoch Slots remaining until the start of the next epoch.
+# TYPE beacon_slots_until_next_epoch gauge
+beacon_slots_until_next_epoch{network="mainnet"} 28
+`
+	if err := testutil.CollectAndCompare(collector, strings.NewReader(want)); err != nil {
+		t.Error(err)
+	}
+
+	// Values are computed on every scrape.
+	now = now.Add(12 * time.Second)
+	want = `
+# HELP beacon_current_slot Current slot of the chain.
+# TYPE beacon_current_slot gauge
+beacon_current_slot{network="mainnet"} 101
+`
+	if err := testutil.CollectAndCompare(collector, strings.NewReader(want), "beacon_current_slot"); err != nil {
+		t.Error(err)
+	}
+
+	registry := prometheus.NewPedanticRegistry()
+	if err := registry.Register(collector); err != nil {
+		t.Fatal(err)
+	}
+	if count, err := testutil.GatherAndCount(registry); err != nil || count != 4 {
+		t.Errorf("Unexpected number of metrics: %d, %v", count, err)
+	}
+}
@@ -0,0 +1,112 @@
+package types
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+)
+
+// ErrInvalidFieldIndex is returned when proof is requested for a non-existent container field.
+var ErrInvalidFieldIndex = errors.New("invalid container field index")
+
+// FieldProver is implemented by containers supporting single field proofs (those having layouts in
+// the gindex package). Containers defined downstream can implement it to be proven with ProveField
+// as well.
+type FieldProver interface {
+	HashRooter
+	// AppendFieldRoots appends hash roots of container fields (in declaration order) to dst.
+	AppendFieldRoots(dst [][32]byte) ([][32]byte, error)
+}
+
+// MerkleProof is a single leaf merkle proof: the leaf, its generalized index in the tree (1 being
+// the root, children of node i being 2i and 2i+1) and sibling nodes from the leaf up to the root.
+type MerkleProof struct {
+	GIndex uint64
+	Leaf   [32]byte
+	Branch [][32]byte
+}
+
+// ProveField returns proof of the field at the given index within the container, along with the
+// container hash root (the root the proof verifies against).
+func ProveField(obj FieldProver, index int) (*MerkleProof, [32]byte, error) {
+	var buf [8][32]byte
+	chunks, err := obj.AppendFieldRoots(buf[:0])
+	if err != nil {
+		return nil, [32]byte{}, err
+	}
+	if index < 0 || index >= len(chunks) {
+		return nil, [32]byte{}, fmt.Errorf("%w: %d not in [0, %d)", ErrInvalidFieldIndex, index, len(chunks))
+	}
+	depth := merkleDepth(uint64(len(chunks)))
+	proof := &MerkleProof{
+		GIndex: uint64(1)<<depth | uint64(index),
+		Leaf:   chunks[index],
+		Branch: make([][32]byte, 0, depth),
+	}
+	var pair [64]byte
+	layer := chunks
+	for level := 0; level < depth; level++ {
+		if len(layer)%2 == 1 {
+			layer = append(layer, zeroHashes[level])
+		}
+		proof.Branch = append(proof.Branch, layer[index^1])
+		for i := 0; i < len(layer)/2; i++ {
+			copy(pair[:32], layer[2*i][:])
+			copy(pair[32:], layer[2*i+1][:])
+			layer[i] = sum256(pair[:])
+		}
+		layer = layer[:len(layer)/2]
+		index /= 2
+	}
+	return proof, layer[0], nil
+}
+
+// Depth returns depth of the proven leaf, i.e. expected length of the branch.
+func (p *MerkleProof) Depth() int {
+	return bits.Len64(p.GIndex) - 1
+}
+
+// Root returns root of the tree implied by the leaf and the branch.
+func (p *MerkleProof) Root() [32]byte {
+	var pair [64]byte
+	node := p.Leaf
+	for i, sibling := range p.Branch {
+		if (p.GIndex>>i)&1 == 1 {
+			copy(pair[:32], sibling[:])
+			copy(pair[32:], node[:])
+		} else {
+			copy(pair[:32], node[:])
+			copy(pair[32:], sibling[:])
+		}
+		node = sum256(pair[:])
+	}
+	return node
+}
+
+// Verify returns true if proof is valid against the given root (see spec's is_valid_merkle_branch).
+func (p *MerkleProof) Verify(root [32]byte) bool {
+	return p.GIndex != 0 && len(p.Branch) == p.Depth() && p.Root() == root
+}
+
+// Within returns proof of the same leaf in the enclosing tree, given the proof of the subtree root
+// within it (e.g. proof of Checkpoint.Epoch extended by the proof of AttestationData.Target).
+func (p *MerkleProof) Within(outer *MerkleProof) *MerkleProof {
+	depth := p.Depth()
+	branch := make([][32]byte, 0, len(p.Branch)+len(outer.Branch))
+	branch = append(branch, p.Branch...)
+	branch = append(branch, outer.Branch...)
+	return &MerkleProof{
+		GIndex: outer.GIndex<<depth | p.GIndex&(1<<depth-1),
+		Leaf:   p.Leaf,
+		Branch: branch,
+	}
+}
+
+// VerifyUint64Proof returns true if proof is valid against the root and its leaf holds the value,
+// e.g. to check the slot of a block given the block root and a proof of BeaconBlockHeader.Slot.
+func VerifyUint64Proof[T Uint64Like](root [32]byte, value T, proof *MerkleProof) bool {
+	var leaf [32]byte
+	binary.LittleEndian.PutUint64(leaf[:8], uint64(value))
+	return proof.Leaf == leaf && proof.Verify(root)
+}
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestProveField(t *testing.T) {
+	header := &testBlockHeader().Message
+	headerRoot, err := header.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 5; i++ {
+		proof, root, err := ProveField(header, i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if root != headerRoot {
+			t.Errorf("Unexpected root: %#x", root)
+		}
+		if proof.GIndex != uint64(8+i) || len(proof.Branch) != 3 {
+			t.Errorf("Unexpected proof of field %d: gindex %d, branch length %d", i, proof.GIndex, len(proof.Branch))
+		}
+		if !proof.Verify(headerRoot) {
+			t.Errorf("Proof of field %d doesn't verify", i)
+		}
+	}
+
+	proof, _, err := ProveField(header, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !VerifyUint64Proof(headerRoot, header.Slot, proof) {
+		t.Error("Slot proof doesn't verify")
+	}
+	if VerifyUint64Proof(headerRoot, header.Slot+1, proof) {
+		t.Error("Slot proof verifies for another slot")
+	}
+	proof.GIndex++
+	if proof.Verify(headerRoot) {
+		t.Error("Proof verifies with wrong generalized index")
+	}
+
+	if _, _, err := ProveField(header, 5); !errors.Is(err, ErrInvalidFieldIndex) {
+		t.Errorf("Expected invalid field index error, got %v", err)
+	}
+}
+
+func TestMerkleProof_Within(t *testing.T) {
+	data := testAttestationData()
+	dataRoot, err := data.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	epochProof, _, err := ProveField(&data.Target, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	targetProof, _, err := ProveField(data, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	proof := epochProof.Within(targetProof)
+	// AttestationData.Target is at gindex 12, Checkpoint.Epoch at gindex 2 within it.
+	if proof.GIndex != 24 || proof.Depth() != 4 {
+		t.Errorf("Unexpected gindex: %d", proof.GIndex)
+	}
+	if !VerifyUint64Proof(dataRoot, data.Target.Epoch, proof) {
+		t.Error("Target epoch proof doesn't verify")
+	}
+}
+
+func TestProveField_Containers(t *testing.T) {
+	tests := []struct {
+		obj    FieldProver
+		fields int
+	}{
+		{&Checkpoint{Epoch: 3, Root: BlockRoot{Root{1}}}, 2},
+		{testEth1Data(), 3},
+		{testWithdrawal(), 4},
+	}
+	for _, tt := range tests {
+		want, err := tt.obj.HashTreeRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < tt.fields; i++ {
+			proof, root, err := ProveField(tt.obj, i)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if root != want || !proof.Verify(want) {
+				t.Errorf("Proof of field %d of %T doesn't verify", i, tt.obj)
+			}
+		}
+	}
+}
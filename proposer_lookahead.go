@@ -0,0 +1,113 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidProposerLookahead is returned when proposer lookahead has unexpected length, or is
+// queried for a slot it doesn't cover.
+var ErrInvalidProposerLookahead = errors.New("invalid proposer lookahead")
+
+// ProposerLookahead is the proposer_lookahead state vector (Fulu): proposer indices of all slots of
+// the current epoch and MIN_SEED_LOOKAHEAD following epochs, i.e.
+// Vector[ValidatorIndex, (MIN_SEED_LOOKAHEAD + 1) * SLOTS_PER_EPOCH].
+// Vector length depends on the preset, so it is checked against the spec by NewProposerLookahead
+// and Validate.
+type ProposerLookahead []ValidatorIndex
+
+// ProposerLookaheadLength returns length of the proposer lookahead vector.
+func ProposerLookaheadLength(spec *ChainSpec) uint64 {
+	return uint64(spec.MinSeedLookahead.AddEpoch(1).StartSlot(spec))
+}
+
+// NewProposerLookahead returns lookahead holding copy of the proposer indices, which must cover
+// exactly MIN_SEED_LOOKAHEAD + 1 epochs.
+func NewProposerLookahead(proposers []ValidatorIndex, spec *ChainSpec) (ProposerLookahead, error) {
+	l := append(ProposerLookahead(nil), proposers...)
+	if err := l.Validate(spec); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Validate checks that vector length matches the spec.
+func (l ProposerLookahead) Validate(spec *ChainSpec) error {
+	if want := ProposerLookaheadLength(spec); uint64(len(l)) != want {
+		return fmt.Errorf("%w: expected %d proposers, got %d", ErrInvalidProposerLookahead, want, len(l))
+	}
+	return nil
+}
+
+// Slots returns range of slots covered by the lookahead of the state at the current epoch.
+func (l ProposerLookahead) Slots(current Epoch, spec *ChainSpec) SlotRange {
+	start := current.StartSlot(spec)
+	return SlotRange{Start: start, End: start + Slot(len(l)) - 1}
+}
+
+// ProposerAt returns proposer of the slot, as seen by the state at the current epoch.
+func (l ProposerLookahead) ProposerAt(slot Slot, current Epoch, spec *ChainSpec) (ValidatorIndex, error) {
+	if len(l) == 0 || !l.Slots(current, spec).Contains(slot) {
+		return 0, fmt.Errorf("%w: slot %d is not covered as of epoch %d", ErrInvalidProposerLookahead, slot, current)
+	}
+	return l[slot-current.StartSlot(spec)], nil
+}
+
+// EpochProposers returns proposers of all slots of the epoch, as seen by the state at the current epoch.
+func (l ProposerLookahead) EpochProposers(epoch, current Epoch, spec *ChainSpec) ([]ValidatorIndex, error) {
+	// Epochs are compared before converting to slots, so that far future epochs can't overflow.
+	if epoch < current || spec.SlotsPerEpoch == 0 || uint64(epoch-current) >= uint64(len(l))/uint64(spec.SlotsPerEpoch) {
+		return nil, fmt.Errorf("%w: epoch %d is not covered as of epoch %d", ErrInvalidProposerLookahead, epoch, current)
+	}
+	start := (epoch - current).StartSlot(spec)
+	return l[start : start+spec.SlotsPerEpoch], nil
+}
+
+// Shift returns lookahead of the next epoch: proposers of the current epoch are dropped, and proposers
+// of the newly covered epoch are appended (see process_proposer_lookahead).
+func (l ProposerLookahead) Shift(next []ValidatorIndex, spec *ChainSpec) (ProposerLookahead, error) {
+	if uint64(len(next)) != uint64(spec.SlotsPerEpoch) || uint64(len(l)) < uint64(spec.SlotsPerEpoch) {
+		return nil, fmt.Errorf("%w: expected %d proposers of the next epoch, got %d", ErrInvalidProposerLookahead, spec.SlotsPerEpoch, len(next))
+	}
+	shifted := make(ProposerLookahead, 0, len(l))
+	shifted = append(shifted, l[spec.SlotsPerEpoch:]...)
+	return append(shifted, next...), nil
+}
+
+// HashTreeRoot returns calculated hash root of the vector.
+func (l ProposerLookahead) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	err := l.HashTreeRootInto(&root)
+	return root, err
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (l ProposerLookahead) HashTreeRootInto(dst *[32]byte) error {
+	return merkleizeInto(dst, packUint64s(l), 0)
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the proposer lookahead, its length must
+// be checked with Validate.
+func (l *ProposerLookahead) UnmarshalSSZ(buf []byte) error {
+	values, err := unmarshalUint64List[ValidatorIndex](buf)
+	if err != nil {
+		return err
+	}
+	*l = values
+	return nil
+}
+
+// MarshalSSZTo marshals proposer lookahead with the provided byte slice.
+func (l ProposerLookahead) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return marshalUint64List(dst, l), nil
+}
+
+// MarshalSSZ marshals proposer lookahead into a serialized object.
+func (l ProposerLookahead) MarshalSSZ() ([]byte, error) {
+	return l.MarshalSSZTo(make([]byte, 0, l.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (l ProposerLookahead) SizeSSZ() int {
+	return len(l) * 8
+}
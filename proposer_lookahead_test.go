@@ -0,0 +1,89 @@
+package types
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func testProposerLookahead(t *testing.T, spec *ChainSpec) ProposerLookahead {
+	proposers := make([]ValidatorIndex, ProposerLookaheadLength(spec))
+	for i := range proposers {
+		proposers[i] = ValidatorIndex(100 + i)
+	}
+	l, err := NewProposerLookahead(proposers, spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return l
+}
+
+func TestProposerLookahead(t *testing.T) {
+	spec := &ChainSpec{SlotsPerEpoch: 32, MinSeedLookahead: 1}
+	if n := ProposerLookaheadLength(spec); n != 64 {
+		t.Fatalf("Unexpected length: %d", n)
+	}
+	l := testProposerLookahead(t, spec)
+	if _, err := NewProposerLookahead(l[:63], spec); !errors.Is(err, ErrInvalidProposerLookahead) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	if r := l.Slots(10, spec); r != (SlotRange{Start: 320, End: 383}) {
+		t.Errorf("Unexpected covered slots: %v", r)
+	}
+	if v, err := l.ProposerAt(321, 10, spec); err != nil || v != 101 {
+		t.Errorf("Unexpected proposer: %v, %v", v, err)
+	}
+	if v, err := l.ProposerAt(383, 10, spec); err != nil || v != 163 {
+		t.Errorf("Unexpected proposer: %v, %v", v, err)
+	}
+	for _, slot := range []Slot{319, 384} {
+		if _, err := l.ProposerAt(slot, 10, spec); !errors.Is(err, ErrInvalidProposerLookahead) {
+			t.Errorf("Unexpected error for slot %d: %v", slot, err)
+		}
+	}
+
+	proposers, err := l.EpochProposers(11, 10, spec)
+	if err != nil || len(proposers) != 32 || proposers[0] != 132 {
+		t.Errorf("Unexpected epoch proposers: %v, %v", proposers, err)
+	}
+	for _, epoch := range []Epoch{9, 12, 1 << 59, FarFutureEpoch} {
+		if _, err := l.EpochProposers(epoch, 10, spec); !errors.Is(err, ErrInvalidProposerLookahead) {
+			t.Errorf("Unexpected error for epoch %d: %v", epoch, err)
+		}
+	}
+	if _, err := l.EpochProposers(10, 10, &ChainSpec{}); !errors.Is(err, ErrInvalidProposerLookahead) {
+		t.Errorf("Unexpected error for zero slots per epoch: %v", err)
+	}
+
+	next := make([]ValidatorIndex, 32)
+	shifted, err := l.Shift(next, spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, _ := shifted.ProposerAt(352, 11, spec); v != 132 || len(shifted) != 64 || shifted[63] != 0 {
+		t.Errorf("Unexpected shifted lookahead: %v", shifted)
+	}
+	if l[0] != 100 {
+		t.Error("Shift must not modify the original lookahead")
+	}
+	if _, err := l.Shift(next[:31], spec); !errors.Is(err, ErrInvalidProposerLookahead) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestProposerLookahead_SSZ(t *testing.T) {
+	spec := &ChainSpec{SlotsPerEpoch: 8, MinSeedLookahead: 1}
+	l := testProposerLookahead(t, spec)
+	enc, err := l.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded ProposerLookahead
+	if err := decoded.UnmarshalSSZ(enc); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, l) || decoded.Validate(spec) != nil {
+		t.Errorf("Unexpected decoded lookahead: %v", decoded)
+	}
+}
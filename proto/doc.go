@@ -0,0 +1,8 @@
+// Package sharedtypes contains gogoproto messages declaring their fields as shared types.
+//
+// Regenerate with protoc and protoc-gen-gogofaster on the path:
+//
+//	go generate ./proto
+package sharedtypes
+
+//go:generate protoc -I.. -I$GOPATH/src --gogofaster_out=paths=source_relative:.. proto/types.proto
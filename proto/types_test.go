@@ -0,0 +1,47 @@
+package sharedtypes
+
+import (
+	"testing"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+func TestCheckpoint_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		cp   types.Checkpoint
+	}{
+		{"zero", types.Checkpoint{}},
+		{"set", types.Checkpoint{Epoch: 42, Root: types.BlockRoot{Root: types.Root{0x01, 31: 0xff}}}},
+		{"far future", types.Checkpoint{Epoch: types.FarFutureEpoch, Root: types.BlockRoot{Root: types.Root{0xaa}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := &Checkpoint{Epoch: tt.cp.Epoch, Root: tt.cp.Root.Root}
+			enc, err := msg.Marshal()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(enc) != msg.Size() {
+				t.Errorf("Unexpected size: %d, encoded %d bytes", msg.Size(), len(enc))
+			}
+			var decoded Checkpoint
+			if err := decoded.Unmarshal(enc); err != nil {
+				t.Fatal(err)
+			}
+			got := types.Checkpoint{Epoch: decoded.GetEpoch(), Root: types.BlockRoot{Root: decoded.Root}}
+			if got != tt.cp {
+				t.Errorf("Unexpected checkpoint: %v, want %v", got, tt.cp)
+			}
+		})
+	}
+}
+
+func TestCheckpoint_InvalidRoot(t *testing.T) {
+	// Field 2 (root), wire type 2, with 31 bytes of payload.
+	enc := append([]byte{0x12, 31}, make([]byte, 31)...)
+	var decoded Checkpoint
+	if err := decoded.Unmarshal(enc); err == nil {
+		t.Error("Expected error for short root")
+	}
+}
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ReqRespProtocolPrefix is the common prefix of all req/resp protocol ids.
+const ReqRespProtocolPrefix = "/eth2/beacon_chain/req/"
+
+// EncodingSSZSnappy is the only req/resp encoding currently defined by the spec.
+const EncodingSSZSnappy = "ssz_snappy"
+
+// ProtocolID is a req/resp protocol identifier of the form
+// "/eth2/beacon_chain/req/<name>/<version>/<encoding>", e.g.
+// "/eth2/beacon_chain/req/beacon_blocks_by_range/2/ssz_snappy".
+type ProtocolID struct {
+	name     string
+	version  string
+	encoding string
+}
+
+// NewProtocolID returns protocol id with the given message name, version and encoding.
+// Panics if any of components is empty or contains '/'.
+func NewProtocolID(name, version, encoding string) ProtocolID {
+	for _, c := range []string{name, version, encoding} {
+		if !validProtocolComponent(c) {
+			panic(fmt.Sprintf("invalid protocol id component %q", c))
+		}
+	}
+	return ProtocolID{name: name, version: version, encoding: encoding}
+}
+
+// ParseProtocolID parses req/resp protocol identifier.
+func ParseProtocolID(s string) (ProtocolID, error) {
+	parts := strings.Split(strings.TrimPrefix(s, ReqRespProtocolPrefix), "/")
+	if !strings.HasPrefix(s, ReqRespProtocolPrefix) || len(parts) != 3 {
+		return ProtocolID{}, fmt.Errorf("invalid protocol id %q", s)
+	}
+	for _, c := range parts {
+		if !validProtocolComponent(c) {
+			return ProtocolID{}, fmt.Errorf("invalid protocol id %q", s)
+		}
+	}
+	return ProtocolID{name: parts[0], version: parts[1], encoding: parts[2]}, nil
+}
+
+// Name returns message name, e.g. "status" or "beacon_blocks_by_range".
+func (p ProtocolID) Name() string {
+	return p.name
+}
+
+// Version returns schema version, e.g. "1".
+func (p ProtocolID) Version() string {
+	return p.version
+}
+
+// Encoding returns encoding, e.g. "ssz_snappy".
+func (p ProtocolID) Encoding() string {
+	return p.encoding
+}
+
+// IsZero returns true if protocol id is not set.
+func (p ProtocolID) IsZero() bool {
+	return p == ProtocolID{}
+}
+
+// String returns protocol id in its canonical form.
+func (p ProtocolID) String() string {
+	return ReqRespProtocolPrefix + p.name + "/" + p.version + "/" + p.encoding
+}
+
+// MarshalText encodes protocol id in its canonical form.
+func (p ProtocolID) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText decodes protocol id from its canonical form.
+func (p *ProtocolID) UnmarshalText(text []byte) error {
+	parsed, err := ParseProtocolID(string(text))
+	if err != nil {
+		return err
+	}
+	*p = parsed
+	return nil
+}
+
+func validProtocolComponent(s string) bool {
+	return s != "" && !strings.Contains(s, "/")
+}
@@ -0,0 +1,46 @@
+package types
+
+import "testing"
+
+func TestParseProtocolID(t *testing.T) {
+	p, err := ParseProtocolID("/eth2/beacon_chain/req/beacon_blocks_by_range/2/ssz_snappy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Name() != "beacon_blocks_by_range" || p.Version() != "2" || p.Encoding() != EncodingSSZSnappy {
+		t.Errorf("Unexpected protocol id components: %q %q %q", p.Name(), p.Version(), p.Encoding())
+	}
+	if p != NewProtocolID("beacon_blocks_by_range", "2", EncodingSSZSnappy) {
+		t.Errorf("Unexpected protocol id: %v", p)
+	}
+	if s := NewProtocolID("status", "1", EncodingSSZSnappy).String(); s != "/eth2/beacon_chain/req/status/1/ssz_snappy" {
+		t.Errorf("Unexpected protocol id: %s", s)
+	}
+
+	for _, s := range []string{
+		"",
+		"/eth2/beacon_chain/req/status/1",
+		"/eth2/beacon_chain/req/status/1/ssz_snappy/extra",
+		"/eth2/beacon_chain/req//1/ssz_snappy",
+		"/eth2/beacon_chain/status/1/ssz_snappy",
+		"eth2/beacon_chain/req/status/1/ssz_snappy",
+	} {
+		if _, err := ParseProtocolID(s); err == nil {
+			t.Errorf("Expected error for %q", s)
+		}
+	}
+}
+
+func TestProtocolID_Text(t *testing.T) {
+	var p ProtocolID
+	if err := p.UnmarshalText([]byte("/eth2/beacon_chain/req/ping/1/ssz_snappy")); err != nil {
+		t.Fatal(err)
+	}
+	text, err := p.MarshalText()
+	if err != nil || string(text) != "/eth2/beacon_chain/req/ping/1/ssz_snappy" {
+		t.Errorf("Unexpected text: %s, %v", text, err)
+	}
+	if p.IsZero() || !(ProtocolID{}).IsZero() {
+		t.Error("Unexpected result of IsZero")
+	}
+}
@@ -0,0 +1,133 @@
+// Package protoconv converts shared types to and from protobuf well-known types, for gRPC gateways
+// exposing beacon data.
+//
+// The package doesn't depend on google.golang.org/protobuf: wrapper messages are accepted via the
+// getters generated for them, and values are returned in the form taken by their constructors, e.g.
+//
+//	slot := protoconv.SlotFromUInt64Value(req.Slot)            // req.Slot is *wrapperspb.UInt64Value
+//	resp.Slot = wrapperspb.UInt64(protoconv.UInt64Value(slot))
+//	resp.Time = timestamppb.New(protoconv.SlotStartTime(genesis, slot, secondsPerSlot))
+//
+// Within structpb values (e.g. google.protobuf.Struct fields), uint64 values are encoded as decimal
+// strings, as done by the beacon API, since number values only hold 53 bits of precision:
+//
+//	fields["slot"] = structpb.NewStringValue(protoconv.StringValue(slot))
+//	slot, err := protoconv.SlotFromStructValue(fields["slot"])
+package protoconv
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	types "github.com/farazdagi/prysm-shared-types"
+	"github.com/farazdagi/prysm-shared-types/mathutil"
+)
+
+// ErrInvalidStructValue is returned when structpb value doesn't hold a valid uint64.
+var ErrInvalidStructValue = errors.New("invalid struct value")
+
+// UInt64Getter is implemented by *wrapperspb.UInt64Value.
+type UInt64Getter interface {
+	GetValue() uint64
+}
+
+// Uint64Like matches all uint64-backed shared types.
+type Uint64Like interface {
+	~uint64
+}
+
+// UInt64Value returns value to be wrapped with wrapperspb.UInt64.
+func UInt64Value[T Uint64Like](v T) uint64 {
+	return uint64(v)
+}
+
+// SlotFromUInt64Value returns slot held by the wrapper, nil wrappers yield genesis slot.
+func SlotFromUInt64Value(w UInt64Getter) types.Slot {
+	return fromUInt64Value[types.Slot](w)
+}
+
+// EpochFromUInt64Value returns epoch held by the wrapper, nil wrappers yield genesis epoch.
+func EpochFromUInt64Value(w UInt64Getter) types.Epoch {
+	return fromUInt64Value[types.Epoch](w)
+}
+
+// ValidatorIndexFromUInt64Value returns validator index held by the wrapper, nil wrappers yield zero.
+func ValidatorIndexFromUInt64Value(w UInt64Getter) types.ValidatorIndex {
+	return fromUInt64Value[types.ValidatorIndex](w)
+}
+
+// StructValueGetter is implemented by *structpb.Value.
+type StructValueGetter interface {
+	GetStringValue() string
+	GetNumberValue() float64
+}
+
+// StringValue returns value to be wrapped with structpb.NewStringValue.
+func StringValue[T Uint64Like](v T) string {
+	return strconv.FormatUint(uint64(v), 10)
+}
+
+// SlotFromStructValue returns slot held by the value, see fromStructValue.
+func SlotFromStructValue(v StructValueGetter) (types.Slot, error) {
+	return fromStructValue[types.Slot](v)
+}
+
+// EpochFromStructValue returns epoch held by the value, see fromStructValue.
+func EpochFromStructValue(v StructValueGetter) (types.Epoch, error) {
+	return fromStructValue[types.Epoch](v)
+}
+
+// ValidatorIndexFromStructValue returns validator index held by the value, see fromStructValue.
+func ValidatorIndexFromStructValue(v StructValueGetter) (types.ValidatorIndex, error) {
+	return fromStructValue[types.ValidatorIndex](v)
+}
+
+// GweiFromStructValue returns amount held by the value, see fromStructValue.
+func GweiFromStructValue(v StructValueGetter) (types.Gwei, error) {
+	return fromStructValue[types.Gwei](v)
+}
+
+// SlotStartTime returns start time of the slot, to be passed to timestamppb.New. The offset from
+// genesis saturates at the maximum representable duration (e.g. for FarFutureSlot).
+func SlotStartTime(genesisTime time.Time, slot types.Slot, secondsPerSlot uint64) time.Time {
+	seconds, err := mathutil.SafeMul(uint64(slot), secondsPerSlot)
+	if err != nil || seconds > uint64(math.MaxInt64/time.Second) {
+		return genesisTime.Add(math.MaxInt64)
+	}
+	return genesisTime.Add(time.Duration(seconds) * time.Second)
+}
+
+// EpochStartTime returns start time of the epoch, to be passed to timestamppb.New.
+func EpochStartTime(genesisTime time.Time, epoch types.Epoch, spec *types.ChainSpec, secondsPerSlot uint64) time.Time {
+	return SlotStartTime(genesisTime, epoch.StartSlot(spec), secondsPerSlot)
+}
+
+func fromUInt64Value[T Uint64Like](w UInt64Getter) T {
+	if w == nil {
+		return 0
+	}
+	return T(w.GetValue())
+}
+
+// fromStructValue parses decimal string values, number values are accepted as long as they hold
+// non-negative integers exactly representable as float64. Nil values yield zero.
+func fromStructValue[T Uint64Like](v StructValueGetter) (T, error) {
+	if v == nil {
+		return 0, nil
+	}
+	if s := v.GetStringValue(); s != "" {
+		n, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %q", ErrInvalidStructValue, s)
+		}
+		return T(n), nil
+	}
+	f := v.GetNumberValue()
+	if f < 0 || f > 1<<53 || f != math.Trunc(f) {
+		return 0, fmt.Errorf("%w: %v", ErrInvalidStructValue, f)
+	}
+	return T(f), nil
+}
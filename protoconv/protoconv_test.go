@@ -0,0 +1,113 @@
+package protoconv
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+// uint64Value mimics generated *wrapperspb.UInt64Value getter, which is nil-safe.
+type uint64Value struct {
+	Value uint64
+}
+
+func (v *uint64Value) GetValue() uint64 {
+	if v == nil {
+		return 0
+	}
+	return v.Value
+}
+
+// structValue mimics generated *structpb.Value getters, which are nil-safe.
+type structValue struct {
+	str string
+	num float64
+}
+
+func (v *structValue) GetStringValue() string {
+	if v == nil {
+		return ""
+	}
+	return v.str
+}
+
+func (v *structValue) GetNumberValue() float64 {
+	if v == nil {
+		return 0
+	}
+	return v.num
+}
+
+func TestStructValue(t *testing.T) {
+	var missing *structValue
+	tests := []struct {
+		name    string
+		value   StructValueGetter
+		want    types.Slot
+		wantErr bool
+	}{
+		{"nil", nil, 0, false},
+		{"nil pointer", missing, 0, false},
+		{"string", &structValue{str: "18446744073709551615"}, types.FarFutureSlot, false},
+		{"number", &structValue{num: 42}, 42, false},
+		{"invalid string", &structValue{str: "0x10"}, 0, true},
+		{"negative number", &structValue{num: -1}, 0, true},
+		{"fractional number", &structValue{num: 1.5}, 0, true},
+		{"imprecise number", &structValue{num: 1 << 60}, 0, true},
+		{"nan", &structValue{num: math.NaN()}, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SlotFromStructValue(tt.value)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidStructValue) {
+					t.Errorf("Unexpected error: %v", err)
+				}
+				return
+			}
+			if err != nil || got != tt.want {
+				t.Errorf("Unexpected slot: %d (%v), want %d", got, err, tt.want)
+			}
+		})
+	}
+	if s := StringValue(types.FarFutureEpoch); s != "18446744073709551615" {
+		t.Errorf("Unexpected string value: %s", s)
+	}
+	epoch, err := EpochFromStructValue(&structValue{str: StringValue(types.Epoch(7))})
+	if err != nil || epoch != 7 {
+		t.Errorf("Unexpected epoch: %d (%v)", epoch, err)
+	}
+}
+
+func TestUInt64Value(t *testing.T) {
+	if slot := SlotFromUInt64Value(&uint64Value{Value: 42}); slot != 42 {
+		t.Errorf("Unexpected slot: %d", slot)
+	}
+	if epoch := EpochFromUInt64Value(nil); epoch != 0 {
+		t.Errorf("Unexpected epoch: %d", epoch)
+	}
+	var missing *uint64Value
+	if index := ValidatorIndexFromUInt64Value(missing); index != 0 {
+		t.Errorf("Unexpected index: %d", index)
+	}
+	if v := UInt64Value(types.Gwei(32)); v != 32 {
+		t.Errorf("Unexpected value: %d", v)
+	}
+}
+
+func TestSlotStartTime(t *testing.T) {
+	genesis := time.Unix(1606824023, 0)
+	if got := SlotStartTime(genesis, 10, 12); !got.Equal(genesis.Add(120 * time.Second)) {
+		t.Errorf("Unexpected slot start time: %v", got)
+	}
+	spec := &types.ChainSpec{SlotsPerEpoch: 32}
+	if got := EpochStartTime(genesis, 2, spec, 12); !got.Equal(genesis.Add(768 * time.Second)) {
+		t.Errorf("Unexpected epoch start time: %v", got)
+	}
+	if got := SlotStartTime(genesis, types.FarFutureSlot, 12); !got.Equal(genesis.Add(math.MaxInt64)) {
+		t.Errorf("Unexpected far future slot start time: %v", got)
+	}
+}
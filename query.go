@@ -0,0 +1,121 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrInvalidQueryParam is returned when Beacon API query parameter cannot be decoded.
+// API servers should respond with HTTP 400 to errors wrapping it.
+var ErrInvalidQueryParam = errors.New("invalid query parameter")
+
+// ValidatorID is a Beacon API validator identifier: either validator index or 0x-prefixed public key.
+type ValidatorID struct {
+	index    ValidatorIndex
+	pubkey   BLSPubkey
+	isPubkey bool
+}
+
+// ValidatorIDFromIndex returns identifier referring to the given validator index.
+func ValidatorIDFromIndex(index ValidatorIndex) ValidatorID {
+	return ValidatorID{index: index}
+}
+
+// ValidatorIDFromPubkey returns identifier referring to the given public key.
+func ValidatorIDFromPubkey(pubkey BLSPubkey) ValidatorID {
+	return ValidatorID{pubkey: pubkey, isPubkey: true}
+}
+
+// ParseValidatorID parses validator identifier from decimal index or 0x-prefixed public key.
+func ParseValidatorID(s string) (ValidatorID, error) {
+	if strings.HasPrefix(s, "0x") {
+		var pubkey BLSPubkey
+		if err := pubkey.UnmarshalText([]byte(s)); err != nil {
+			return ValidatorID{}, fmt.Errorf("%w: validator id %q: %v", ErrInvalidQueryParam, s, err)
+		}
+		return ValidatorIDFromPubkey(pubkey), nil
+	}
+	index, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return ValidatorID{}, fmt.Errorf("%w: validator id %q", ErrInvalidQueryParam, s)
+	}
+	return ValidatorIDFromIndex(ValidatorIndex(index)), nil
+}
+
+// Index returns referenced validator index, false is returned if identifier is a public key.
+func (id ValidatorID) Index() (ValidatorIndex, bool) {
+	return id.index, !id.isPubkey
+}
+
+// Pubkey returns referenced public key, false is returned if identifier is a validator index.
+func (id ValidatorID) Pubkey() (BLSPubkey, bool) {
+	return id.pubkey, id.isPubkey
+}
+
+// String returns identifier in its Beacon API form.
+func (id ValidatorID) String() string {
+	if id.isPubkey {
+		return id.pubkey.String()
+	}
+	return id.index.String()
+}
+
+// ParseValidatorIDs decodes query values (each possibly comma-separated) into validator identifiers.
+func ParseValidatorIDs(values ...string) ([]ValidatorID, error) {
+	return parseQueryList(values, ParseValidatorID)
+}
+
+// ParseValidatorIndices decodes query values (each possibly comma-separated) into validator indices.
+func ParseValidatorIndices(values ...string) ([]ValidatorIndex, error) {
+	return parseQueryList(values, func(s string) (ValidatorIndex, error) {
+		index, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%w: validator index %q", ErrInvalidQueryParam, s)
+		}
+		return ValidatorIndex(index), nil
+	})
+}
+
+// ParseValidatorStatuses decodes query values (each possibly comma-separated) into status filters.
+func ParseValidatorStatuses(values ...string) ([]ValidatorStatus, error) {
+	return parseQueryList(values, func(s string) (ValidatorStatus, error) {
+		status, err := ParseValidatorStatus(s)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %v", ErrInvalidQueryParam, err)
+		}
+		return status, nil
+	})
+}
+
+// FormatQueryList encodes values as a comma-separated query parameter value.
+func FormatQueryList[T fmt.Stringer](values []T) string {
+	var b strings.Builder
+	for i, v := range values {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		b.WriteString(v.String())
+	}
+	return b.String()
+}
+
+// parseQueryList splits comma-separated values and decodes each element, empty elements are skipped.
+func parseQueryList[T any](values []string, parse func(string) (T, error)) ([]T, error) {
+	var result []T
+	for _, value := range values {
+		for _, elem := range strings.Split(value, ",") {
+			elem = strings.TrimSpace(elem)
+			if elem == "" {
+				continue
+			}
+			v, err := parse(elem)
+			if err != nil {
+				return nil, err
+			}
+			result = append(result, v)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestParseValidatorIDs(t *testing.T) {
+	pubkeyHex := "0x" + strings.Repeat("a0", 48)
+	ids, err := ParseValidatorIDs("1,2", pubkeyHex)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 3 {
+		t.Fatalf("Unexpected number of ids: %d", len(ids))
+	}
+	if index, ok := ids[1].Index(); !ok || index != 2 {
+		t.Errorf("Unexpected index: %d", index)
+	}
+	if pubkey, ok := ids[2].Pubkey(); !ok || pubkey[0] != 0xa0 {
+		t.Errorf("Unexpected pubkey: %v", pubkey)
+	}
+	if got := FormatQueryList(ids); got != "1,2,"+pubkeyHex {
+		t.Errorf("Unexpected query value: %s", got)
+	}
+
+	for _, input := range []string{"1,x", "0xabc", "-1"} {
+		if _, err := ParseValidatorIDs(input); !errors.Is(err, ErrInvalidQueryParam) {
+			t.Errorf("Expected ErrInvalidQueryParam for %q, got: %v", input, err)
+		}
+	}
+}
+
+func TestParseValidatorStatuses(t *testing.T) {
+	statuses, err := ParseValidatorStatuses("active, exited_slashed")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(statuses) != 2 || statuses[0] != ValidatorStatusActive || statuses[1] != ValidatorStatusExitedSlashed {
+		t.Errorf("Unexpected statuses: %v", statuses)
+	}
+	if _, err := ParseValidatorStatuses("sleeping"); !errors.Is(err, ErrInvalidQueryParam) {
+		t.Errorf("Expected ErrInvalidQueryParam, got: %v", err)
+	}
+
+	indices, err := ParseValidatorIndices("3,,4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if FormatQueryList(indices) != "3,4" {
+		t.Errorf("Unexpected indices: %v", indices)
+	}
+	if _, err := ParseValidatorIndices("0x01"); !errors.Is(err, ErrInvalidQueryParam) {
+		t.Errorf("Expected ErrInvalidQueryParam, got: %v", err)
+	}
+}
@@ -0,0 +1,90 @@
+// Package quickgen contains testing/quick generators of shared types, biased towards boundary values
+// (0, 1, math.MaxUint64, FarFutureEpoch etc). Generators are defined on wrapper types, so that the
+// types package itself never links testing machinery:
+//
+//	f := func(s quickgen.Slot, e quickgen.Epoch) bool {
+//		slot, epoch := types.Slot(s), types.Epoch(e)
+//		...
+//	}
+//	err := quick.Check(f, nil)
+package quickgen
+
+import (
+	"math"
+	"math/rand"
+	"reflect"
+	"testing/quick"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+// Slot generates types.Slot values.
+type Slot types.Slot
+
+// Epoch generates types.Epoch values.
+type Epoch types.Epoch
+
+// Gwei generates types.Gwei values.
+type Gwei types.Gwei
+
+// Root generates types.Root values.
+type Root types.Root
+
+var _ quick.Generator = Slot(0)
+var _ quick.Generator = Epoch(0)
+var _ quick.Generator = Gwei(0)
+var _ quick.Generator = Root{}
+
+// Generate returns random slot, biased towards boundary values (implements quick.Generator).
+func (Slot) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(Slot(generateUint64(r, size)))
+}
+
+// Generate returns random epoch, biased towards boundary values (implements quick.Generator).
+func (Epoch) Generate(r *rand.Rand, size int) reflect.Value {
+	return reflect.ValueOf(Epoch(generateUint64(r, size)))
+}
+
+// Generate returns random gwei amount, biased towards boundary values (implements quick.Generator).
+func (Gwei) Generate(r *rand.Rand, size int) reflect.Value {
+	// 32 ETH is the (pre-Electra) maximum effective balance.
+	if r.Intn(8) == 0 {
+		return reflect.ValueOf(Gwei(32_000_000_000))
+	}
+	return reflect.ValueOf(Gwei(generateUint64(r, size)))
+}
+
+// Generate returns random root, with zero and all-ones roots being generated more often
+// (implements quick.Generator).
+func (Root) Generate(r *rand.Rand, _ int) reflect.Value {
+	var root Root
+	switch r.Intn(8) {
+	case 0:
+	case 1:
+		for i := range root {
+			root[i] = 0xff
+		}
+	default:
+		r.Read(root[:])
+	}
+	return reflect.ValueOf(root)
+}
+
+// boundaryValues are returned by generators more often than any other values.
+var boundaryValues = []uint64{0, 1, 2, math.MaxUint64 - 1, math.MaxUint64}
+
+// generateUint64 returns either a boundary value, a small value (bounded by size) or
+// a uniformly distributed uint64, each with roughly equal probability.
+func generateUint64(r *rand.Rand, size int) uint64 {
+	switch r.Intn(3) {
+	case 0:
+		return boundaryValues[r.Intn(len(boundaryValues))]
+	case 1:
+		if size <= 0 {
+			return 0
+		}
+		return uint64(r.Intn(size))
+	default:
+		return r.Uint64()
+	}
+}
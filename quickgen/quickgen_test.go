@@ -0,0 +1,28 @@
+package quickgen
+
+import (
+	"testing"
+	"testing/quick"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+func TestGenerators(t *testing.T) {
+	seen := make(map[types.Epoch]bool)
+	f := func(s Slot, e Epoch, g Gwei, r Root) bool {
+		seen[types.Epoch(e)] = true
+		slot := types.Slot(s)
+		var decoded types.Slot
+		enc, err := slot.MarshalSSZ()
+		if err != nil {
+			return false
+		}
+		return decoded.UnmarshalSSZ(enc) == nil && decoded == slot
+	}
+	if err := quick.Check(f, &quick.Config{MaxCount: 500}); err != nil {
+		t.Error(err)
+	}
+	if !seen[0] || !seen[types.FarFutureEpoch] {
+		t.Error("Boundary values should be generated")
+	}
+}
@@ -0,0 +1,24 @@
+package types
+
+// VectorIndex is an index into one of the fixed-size state vectors (randao_mixes, block_roots etc).
+type VectorIndex uint64
+
+// RandaoMixIndex returns index of the epoch's mix in randao_mixes, see get_randao_mix.
+func RandaoMixIndex(epoch Epoch, spec *ChainSpec) VectorIndex {
+	return VectorIndex(epoch.ModEpoch(spec.EpochsPerHistoricalVector))
+}
+
+// SeedMixEpoch returns epoch whose randao mix seeds the given epoch's shuffling and proposer
+// selection (epoch + EPOCHS_PER_HISTORICAL_VECTOR - MIN_SEED_LOOKAHEAD - 1), see get_seed.
+// Panics on overflow.
+func SeedMixEpoch(epoch Epoch, spec *ChainSpec) Epoch {
+	return epoch.AddEpoch(spec.EpochsPerHistoricalVector - spec.MinSeedLookahead - 1)
+}
+
+// SeedMixIndex returns index in randao_mixes of the mix seeding the given epoch, see get_seed.
+// Unlike SeedMixEpoch it never overflows, as computation is done modulo the vector length.
+func SeedMixIndex(epoch Epoch, spec *ChainSpec) VectorIndex {
+	length := uint64(spec.EpochsPerHistoricalVector)
+	lag := (uint64(spec.MinSeedLookahead) + 1) % length
+	return VectorIndex((uint64(epoch)%length + length - lag) % length)
+}
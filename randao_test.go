@@ -0,0 +1,25 @@
+package types
+
+import "testing"
+
+func TestRandaoMixIndex(t *testing.T) {
+	spec := &ChainSpec{EpochsPerHistoricalVector: 65536, MinSeedLookahead: 1}
+	for epoch, want := range map[Epoch]VectorIndex{0: 0, 65535: 65535, 65536: 0, 70000: 4464} {
+		if got := RandaoMixIndex(epoch, spec); got != want {
+			t.Errorf("RandaoMixIndex(%d) = %d, want %d", epoch, got, want)
+		}
+	}
+
+	for _, epoch := range []Epoch{0, 1, 2, 65535, 100000} {
+		seedEpoch := SeedMixEpoch(epoch, spec)
+		if seedEpoch != epoch+65534 {
+			t.Errorf("SeedMixEpoch(%d) = %d", epoch, seedEpoch)
+		}
+		if got, want := SeedMixIndex(epoch, spec), RandaoMixIndex(seedEpoch, spec); got != want {
+			t.Errorf("SeedMixIndex(%d) = %d, want %d", epoch, got, want)
+		}
+	}
+	if got := SeedMixIndex(FarFutureEpoch, spec); got != 65533 {
+		t.Errorf("Unexpected seed mix index: %d", got)
+	}
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"math"
+	"math/rand"
+)
+
+// RandomSlot returns uniformly distributed slot in [0, max) range, panics if max is zero.
+func RandomSlot(r *rand.Rand, max Slot) Slot {
+	if max == 0 {
+		panic("invalid range")
+	}
+	return Slot(randomUint64n(r, uint64(max)))
+}
+
+// RandomSlotInRange returns uniformly distributed slot in [lo, hi] range.
+func RandomSlotInRange(r *rand.Rand, lo, hi Slot) Slot {
+	return Slot(randomUint64InRange(r, uint64(lo), uint64(hi)))
+}
+
+// RandomEpoch returns uniformly distributed epoch in [0, max) range, panics if max is zero.
+func RandomEpoch(r *rand.Rand, max Epoch) Epoch {
+	if max == 0 {
+		panic("invalid range")
+	}
+	return Epoch(randomUint64n(r, uint64(max)))
+}
+
+// RandomEpochInRange returns uniformly distributed epoch in [lo, hi] range.
+func RandomEpochInRange(r *rand.Rand, lo, hi Epoch) Epoch {
+	return Epoch(randomUint64InRange(r, uint64(lo), uint64(hi)))
+}
+
+// RandomRoot returns random root.
+func RandomRoot(r *rand.Rand) Root {
+	var root Root
+	r.Read(root[:])
+	return root
+}
+
+// randomUint64InRange returns uniformly distributed value in [lo, hi] range.
+func randomUint64InRange(r *rand.Rand, lo, hi uint64) uint64 {
+	if lo > hi {
+		panic("invalid range")
+	}
+	if lo == 0 && hi == math.MaxUint64 {
+		return r.Uint64()
+	}
+	return lo + randomUint64n(r, hi-lo+1)
+}
+
+// randomUint64n returns uniformly distributed value in [0, n) range (n must be non-zero).
+func randomUint64n(r *rand.Rand, n uint64) uint64 {
+	if n&(n-1) == 0 {
+		return r.Uint64() & (n - 1)
+	}
+	// Reject values from the incomplete last bucket, to avoid modulo bias.
+	limit := math.MaxUint64 - math.MaxUint64%n
+	v := r.Uint64()
+	for v >= limit {
+		v = r.Uint64()
+	}
+	return v % n
+}
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 1000; i++ {
+		if s := RandomSlot(r, 10); s >= 10 {
+			t.Fatalf("Slot out of range: %v", s)
+		}
+		if e := RandomEpochInRange(r, 5, 7); e < 5 || e > 7 {
+			t.Fatalf("Epoch out of range: %v", e)
+		}
+		if s := RandomSlotInRange(r, 3, 3); s != 3 {
+			t.Fatalf("Slot out of range: %v", s)
+		}
+	}
+	RandomEpochInRange(r, 0, FarFutureEpoch)
+
+	// Same seed must produce same values.
+	r1, r2 := rand.New(rand.NewSource(1)), rand.New(rand.NewSource(1))
+	if RandomRoot(r1) != RandomRoot(r2) || RandomEpoch(r1, 1000) != RandomEpoch(r2, 1000) {
+		t.Error("Values should be reproducible")
+	}
+
+	t.Run("invalid range", func(t *testing.T) {
+		defer func() {
+			if recover() == nil {
+				t.Error("Expected panic")
+			}
+		}()
+		RandomEpochInRange(r, 7, 5)
+	})
+}
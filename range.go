@@ -0,0 +1,67 @@
+package types
+
+import "fmt"
+
+// SlotRange is an inclusive range of slots [Start, End].
+type SlotRange struct {
+	Start Slot `json:"start"`
+	End   Slot `json:"end"`
+}
+
+// Len returns number of slots in the range.
+func (r SlotRange) Len() uint64 {
+	return uint64(r.End-r.Start) + 1
+}
+
+// Contains returns true if slot falls within the range.
+func (r SlotRange) Contains(s Slot) bool {
+	return r.Start <= s && s <= r.End
+}
+
+// String returns range in "[start, end]" form.
+func (r SlotRange) String() string {
+	return fmt.Sprintf("[%d, %d]", r.Start, r.End)
+}
+
+// RollingWindow returns window of n slots ending at (and including) the slot, clamped at genesis.
+// Zero n is treated as one, i.e. window consists of the slot itself.
+func (s Slot) RollingWindow(n uint64) SlotRange {
+	start, _ := s.SafeSub(windowLookback(n))
+	return SlotRange{Start: start, End: s}
+}
+
+// EpochRange is an inclusive range of epochs [Start, End].
+type EpochRange struct {
+	Start Epoch `json:"start"`
+	End   Epoch `json:"end"`
+}
+
+// Len returns number of epochs in the range.
+func (r EpochRange) Len() uint64 {
+	return uint64(r.End-r.Start) + 1
+}
+
+// Contains returns true if epoch falls within the range.
+func (r EpochRange) Contains(e Epoch) bool {
+	return r.Start <= e && e <= r.End
+}
+
+// String returns range in "[start, end]" form.
+func (r EpochRange) String() string {
+	return fmt.Sprintf("[%d, %d]", r.Start, r.End)
+}
+
+// RollingWindow returns window of n epochs ending at (and including) the epoch, clamped at genesis.
+// Zero n is treated as one, i.e. window consists of the epoch itself.
+func (e Epoch) RollingWindow(n uint64) EpochRange {
+	start, _ := e.SafeSub(windowLookback(n))
+	return EpochRange{Start: start, End: e}
+}
+
+// windowLookback returns number of values preceding the last one in window of size n.
+func windowLookback(n uint64) uint64 {
+	if n == 0 {
+		return 0
+	}
+	return n - 1
+}
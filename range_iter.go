@@ -0,0 +1,58 @@
+package types
+
+// Iterators below return push-style sequences, with the signature of iter.Seq, so that they can be
+// ranged over directly on Go 1.23+ (older callers invoke them with a yield function). Iteration stops
+// once yield returns false.
+
+// SlotsDescending returns sequence of slots from down to (and including) to, empty if from < to.
+func SlotsDescending(from, to Slot) func(yield func(Slot) bool) {
+	return func(yield func(Slot) bool) {
+		if from < to {
+			return
+		}
+		for s := from; yield(s) && s > to; s-- {
+		}
+	}
+}
+
+// EpochsDescending returns sequence of epochs from down to (and including) to, empty if from < to.
+func EpochsDescending(from, to Epoch) func(yield func(Epoch) bool) {
+	return func(yield func(Epoch) bool) {
+		if from < to {
+			return
+		}
+		for e := from; yield(e) && e > to; e-- {
+		}
+	}
+}
+
+// Descending returns sequence of slots of the range, starting at its end.
+func (r SlotRange) Descending() func(yield func(Slot) bool) {
+	return SlotsDescending(r.End, r.Start)
+}
+
+// Descending returns sequence of epochs of the range, starting at its end.
+func (r EpochRange) Descending() func(yield func(Epoch) bool) {
+	return EpochsDescending(r.End, r.Start)
+}
+
+// BackfillWindow returns sequence of consecutive ranges of (at most) size slots, covering slots from
+// down to (and including) to, as requested by backfill sync: [from-size+1, from], [from-2*size+1, from-size]
+// and so on, with the last batch clamped at to. Zero size is treated as one.
+func BackfillWindow(from, to Slot, size uint64) func(yield func(SlotRange) bool) {
+	lookback := Slot(windowLookback(size))
+	return func(yield func(SlotRange) bool) {
+		if from < to {
+			return
+		}
+		for end := from; ; end -= lookback + 1 {
+			start := to
+			if end-to > lookback {
+				start = end - lookback
+			}
+			if !yield(SlotRange{Start: start, End: end}) || start == to {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSlotsDescending(t *testing.T) {
+	tests := []struct {
+		from, to Slot
+		want     []Slot
+	}{
+		{from: 5, to: 2, want: []Slot{5, 4, 3, 2}},
+		{from: 2, to: 0, want: []Slot{2, 1, 0}},
+		{from: 3, to: 3, want: []Slot{3}},
+		{from: 2, to: 3, want: nil},
+		{from: FarFutureSlot, to: FarFutureSlot - 1, want: []Slot{FarFutureSlot, FarFutureSlot - 1}},
+	}
+	for _, tt := range tests {
+		var got []Slot
+		SlotsDescending(tt.from, tt.to)(func(s Slot) bool {
+			got = append(got, s)
+			return true
+		})
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("SlotsDescending(%d, %d) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+
+	var got []Epoch
+	EpochRange{Start: 0, End: 10}.Descending()(func(e Epoch) bool {
+		got = append(got, e)
+		return e > 8
+	})
+	if !reflect.DeepEqual(got, []Epoch{10, 9, 8}) {
+		t.Errorf("Iteration must stop once yield returns false, got: %v", got)
+	}
+}
+
+func TestBackfillWindow(t *testing.T) {
+	tests := []struct {
+		from, to Slot
+		size     uint64
+		want     []SlotRange
+	}{
+		{from: 100, to: 0, size: 32, want: []SlotRange{{69, 100}, {37, 68}, {5, 36}, {0, 4}}},
+		{from: 63, to: 0, size: 32, want: []SlotRange{{32, 63}, {0, 31}}},
+		{from: 10, to: 5, size: 0, want: []SlotRange{{10, 10}, {9, 9}, {8, 8}, {7, 7}, {6, 6}, {5, 5}}},
+		{from: 10, to: 5, size: 100, want: []SlotRange{{5, 10}}},
+		{from: 4, to: 5, size: 2, want: nil},
+		{from: FarFutureSlot, to: 0, size: 1 << 63, want: []SlotRange{{1 << 63, FarFutureSlot}, {0, 1<<63 - 1}}},
+	}
+	for _, tt := range tests {
+		var got []SlotRange
+		BackfillWindow(tt.from, tt.to, tt.size)(func(r SlotRange) bool {
+			got = append(got, r)
+			return true
+		})
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("BackfillWindow(%d, %d, %d) = %v, want %v", tt.from, tt.to, tt.size, got, tt.want)
+		}
+	}
+}
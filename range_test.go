@@ -0,0 +1,36 @@
+package types
+
+import "testing"
+
+func TestRollingWindow(t *testing.T) {
+	tests := []struct {
+		slot Slot
+		n    uint64
+		want SlotRange
+	}{
+		{slot: 100, n: 10, want: SlotRange{Start: 91, End: 100}},
+		{slot: 100, n: 1, want: SlotRange{Start: 100, End: 100}},
+		{slot: 100, n: 0, want: SlotRange{Start: 100, End: 100}},
+		{slot: 5, n: 10, want: SlotRange{Start: 0, End: 5}},
+		{slot: 0, n: 32, want: SlotRange{Start: 0, End: 0}},
+	}
+	for _, tt := range tests {
+		got := tt.slot.RollingWindow(tt.n)
+		if got != tt.want {
+			t.Errorf("Unexpected window of %d slots ending at %d: %v", tt.n, tt.slot, got)
+		}
+		if !got.Contains(tt.slot) || got.Contains(tt.slot+1) {
+			t.Errorf("Window %v should end at %d", got, tt.slot)
+		}
+	}
+	if r := Slot(100).RollingWindow(10); r.Len() != 10 || r.String() != "[91, 100]" {
+		t.Errorf("Unexpected window: %v", r)
+	}
+
+	if r := Epoch(10).RollingWindow(4); r != (EpochRange{Start: 7, End: 10}) || r.Len() != 4 {
+		t.Errorf("Unexpected window: %v", r)
+	}
+	if r := Epoch(2).RollingWindow(4); r != (EpochRange{Start: 0, End: 2}) || !r.Contains(0) {
+		t.Errorf("Unexpected window: %v", r)
+	}
+}
@@ -0,0 +1,55 @@
+package types
+
+import "github.com/farazdagi/prysm-shared-types/mathutil"
+
+// RewardComponents is a per-validator breakdown of rewards and penalties for an epoch, as exposed
+// by the Beacon API rewards endpoints.
+type RewardComponents struct {
+	ValidatorIndex ValidatorIndex `json:"validator_index"`
+
+	Head           Gwei `json:"head"`
+	Target         Gwei `json:"target"`
+	Source         Gwei `json:"source"`
+	InclusionDelay Gwei `json:"inclusion_delay"`
+	Sync           Gwei `json:"sync"`
+	Proposer       Gwei `json:"proposer"`
+
+	// Penalties are expressed as non-positive deltas.
+	TargetPenalty     BalanceDelta `json:"target_penalty"`
+	SourcePenalty     BalanceDelta `json:"source_penalty"`
+	InactivityPenalty BalanceDelta `json:"inactivity"`
+	SyncPenalty       BalanceDelta `json:"sync_penalty"`
+}
+
+// Rewards returns sum of all reward components, panics on overflow.
+func (c *RewardComponents) Rewards() Gwei {
+	var total Gwei
+	for _, reward := range []Gwei{c.Head, c.Target, c.Source, c.InclusionDelay, c.Sync, c.Proposer} {
+		total = mathutil.Add(total, reward)
+	}
+	return total
+}
+
+// Penalties returns sum of all penalty components as a positive amount, panics on overflow.
+func (c *RewardComponents) Penalties() Gwei {
+	var total Gwei
+	for _, penalty := range []BalanceDelta{c.TargetPenalty, c.SourcePenalty, c.InactivityPenalty, c.SyncPenalty} {
+		total = mathutil.Add(total, Gwei(absInt64(int64(penalty))))
+	}
+	return total
+}
+
+// Net returns combined delta of rewards and penalties, saturating at int64 bounds.
+func (c *RewardComponents) Net() BalanceDelta {
+	return BalanceDeltaBetween(c.Penalties(), c.Rewards())
+}
+
+// ApplyTo returns balance increased by rewards and then decreased by penalties (saturating at zero),
+// panics if rewarded balance overflows.
+func (c *RewardComponents) ApplyTo(balance Gwei) Gwei {
+	balance = mathutil.Add(balance, c.Rewards())
+	if penalties := c.Penalties(); balance > penalties {
+		return balance - penalties
+	}
+	return 0
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestRewardComponents(t *testing.T) {
+	c := &RewardComponents{
+		ValidatorIndex:    7,
+		Head:              2000,
+		Target:            4000,
+		Source:            2000,
+		Sync:              500,
+		InactivityPenalty: -1000,
+		SyncPenalty:       -500,
+	}
+	if got := c.Rewards(); got != 8500 {
+		t.Errorf("Unexpected rewards: %d", uint64(got))
+	}
+	if got := c.Penalties(); got != 1500 {
+		t.Errorf("Unexpected penalties: %d", uint64(got))
+	}
+	if got := c.Net(); got != 7000 {
+		t.Errorf("Unexpected net delta: %d", got)
+	}
+	if got := c.ApplyTo(32 * GweiPerEth); got != 32*GweiPerEth+7000 {
+		t.Errorf("Unexpected balance: %d", uint64(got))
+	}
+	if got := (&RewardComponents{SourcePenalty: -10}).ApplyTo(5); got != 0 {
+		t.Errorf("Unexpected balance: %d", uint64(got))
+	}
+}
+
+func TestRewardComponents_JSON(t *testing.T) {
+	input := `{"validator_index":"7","head":"2000","target":"4000","source":"2000","inclusion_delay":"0","sync":"0","proposer":"0",` +
+		`"target_penalty":"0","source_penalty":"0","inactivity":"-1000","sync_penalty":"0"}`
+	var c RewardComponents
+	if err := json.Unmarshal([]byte(input), &c); err != nil {
+		t.Fatal(err)
+	}
+	if c.ValidatorIndex != 7 || c.Target != 4000 || c.InactivityPenalty != -1000 {
+		t.Errorf("Unexpected components: %+v", c)
+	}
+	enc, err := json.Marshal(&c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(enc) != input {
+		t.Errorf("Unexpected JSON: %s", enc)
+	}
+}
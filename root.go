@@ -0,0 +1,31 @@
+package types
+
+import (
+	"bytes"
+	"io"
+)
+
+// Root represents a 32 byte hash tree root (of a block, state etc).
+type Root Bytes32
+
+// Compare returns an integer comparing two roots lexicographically (-1, 0 or +1).
+func (r Root) Compare(x Root) int {
+	return bytes.Compare(r[:], x[:])
+}
+
+// AppendSSZ appends serialized root to dst, allocating only if dst has no spare capacity.
+func (r Root) AppendSSZ(dst []byte) []byte {
+	return append(dst, r[:]...)
+}
+
+// WriteTo writes SSZ serialized root to w.
+func (r Root) WriteTo(w io.Writer) (int64, error) {
+	n, err := w.Write(r[:])
+	return int64(n), err
+}
+
+// ReadFrom reads SSZ serialized root from r (exactly 32 bytes are consumed).
+func (r *Root) ReadFrom(reader io.Reader) (int64, error) {
+	n, err := io.ReadFull(reader, r[:])
+	return int64(n), err
+}
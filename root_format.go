@@ -0,0 +1,68 @@
+package types
+
+import "sync"
+
+// ShortRoot formats root in a short form (0x-prefixed first 8 hex characters) for logs, e.g.
+// 0x4d611d5b. Wrapping a root is free, and hex encoding happens only once the value is formatted,
+// so that it costs nothing on disabled log levels.
+type ShortRoot Root
+
+// Short returns root wrapped for short formatting.
+func (r Root) Short() ShortRoot {
+	return ShortRoot(r)
+}
+
+// String returns 0x-prefixed first 8 hex characters of the root.
+func (r ShortRoot) String() string {
+	return string(appendHex(make([]byte, 0, 10), r[:4]))
+}
+
+// Full returns 0x-prefixed hex representation of the whole root.
+func (r ShortRoot) Full() string {
+	return Root(r).String()
+}
+
+// RootFormatCache caches string representations of roots, for hot logging paths formatting the same
+// roots (e.g. head and checkpoint roots) over and over again. Cache holds at most size entries; once
+// full, it is cleared (see RootMemo). It is safe for concurrent use.
+type RootFormatCache struct {
+	lock    sync.Mutex
+	size    int
+	short   map[Root]string
+	strings map[Root]string
+}
+
+// NewRootFormatCache creates cache holding up to size roots of each form.
+func NewRootFormatCache(size int) *RootFormatCache {
+	return &RootFormatCache{
+		size:    size,
+		short:   make(map[Root]string, size),
+		strings: make(map[Root]string, size),
+	}
+}
+
+// Short returns cached short form of the root, see ShortRoot.
+func (c *RootFormatCache) Short(r Root) string {
+	return c.format(c.short, r, func() string { return ShortRoot(r).String() })
+}
+
+// String returns cached 0x-prefixed hex representation of the root.
+func (c *RootFormatCache) String(r Root) string {
+	return c.format(c.strings, r, r.String)
+}
+
+func (c *RootFormatCache) format(cache map[Root]string, r Root, format func() string) string {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if s, ok := cache[r]; ok {
+		return s
+	}
+	if len(cache) >= c.size {
+		for k := range cache {
+			delete(cache, k)
+		}
+	}
+	s := format()
+	cache[r] = s
+	return s
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestRoot_Short(t *testing.T) {
+	root := Root{0x4d, 0x61, 0x1d, 0x5b, 0xff}
+	if got := root.Short().String(); got != "0x4d611d5b" {
+		t.Errorf("Unexpected short form: %v", got)
+	}
+	if got := fmt.Sprintf("%v", root.Short()); got != "0x4d611d5b" {
+		t.Errorf("Unexpected formatted short form: %v", got)
+	}
+	if got := root.Short().Full(); got != root.String() {
+		t.Errorf("Unexpected full form: %v", got)
+	}
+}
+
+func TestRootFormatCache(t *testing.T) {
+	cache := NewRootFormatCache(2)
+	root := Root{0x01, 0x02, 0x03, 0x04}
+	if got := cache.Short(root); got != "0x01020304" {
+		t.Errorf("Unexpected short form: %v", got)
+	}
+	if got := cache.String(root); got != root.String() {
+		t.Errorf("Unexpected string: %v", got)
+	}
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = cache.Short(root)
+		_ = cache.String(root)
+	})
+	if allocs != 0 {
+		t.Errorf("Unexpected allocations of cached roots: %v", allocs)
+	}
+
+	for i := byte(0); i < 5; i++ {
+		r := Root{i}
+		if got, want := cache.Short(r), r.Short().String(); got != want {
+			t.Errorf("Unexpected short form: %v, want %v", got, want)
+		}
+	}
+	if len(cache.short) > 2 {
+		t.Errorf("Cache exceeds its size: %d", len(cache.short))
+	}
+}
@@ -0,0 +1,79 @@
+// Code generated by typegen. DO NOT EDIT.
+
+package types
+
+import (
+	"fmt"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// NewRoot copies b into Root, returns an error if b is not exactly 32 bytes long.
+func NewRoot(b []byte) (Root, error) {
+	var v Root
+	if len(b) != len(v) {
+		return v, fmt.Errorf("%w: expected 32 bytes, got %d", ErrInvalidBytesLength, len(b))
+	}
+	copy(v[:], b)
+	return v, nil
+}
+
+// Equal returns true if both values hold the same bytes.
+func (r Root) Equal(x Root) bool {
+	return r == x
+}
+
+// IsZero returns true if all bytes of the root are zero.
+func (r Root) IsZero() bool {
+	return r == Root{}
+}
+
+// String returns 0x-prefixed hex representation of the root.
+func (r Root) String() string {
+	return string(appendHex(nil, r[:]))
+}
+
+// MarshalText encodes root as 0x-prefixed hex string.
+func (r Root) MarshalText() ([]byte, error) {
+	return appendHex(make([]byte, 0, 66), r[:]), nil
+}
+
+// UnmarshalText decodes root from 0x-prefixed hex string.
+func (r *Root) UnmarshalText(text []byte) error {
+	return decodeHexInto(r[:], text)
+}
+
+// HashTreeRoot returns calculated hash root (which is the root itself).
+func (r Root) HashTreeRoot() ([32]byte, error) {
+	return r, nil
+}
+
+// HashTreeRootInto writes hash root (the root itself) into dst.
+func (r Root) HashTreeRootInto(dst *[32]byte) error {
+	*dst = r
+	return nil
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the root object.
+func (r *Root) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), r.SizeSSZ()); err != nil {
+		return err
+	}
+	copy(r[:], buf)
+	return nil
+}
+
+// MarshalSSZTo marshals root with the provided byte slice.
+func (r *Root) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return append(dst, r[:]...), nil
+}
+
+// MarshalSSZ marshals root into a serialized object.
+func (r *Root) MarshalSSZ() ([]byte, error) {
+	return append(make([]byte, 0, 32), r[:]...), nil
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (r *Root) SizeSSZ() int {
+	return 32
+}
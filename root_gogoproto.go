@@ -0,0 +1,33 @@
+package types
+
+import "fmt"
+
+// Methods below implement gogoproto's customtype interface, allowing Root to be used directly in
+// protobuf messages, see proto/types.proto.
+
+// Marshal returns root bytes.
+func (r Root) Marshal() ([]byte, error) {
+	return r[:], nil
+}
+
+// MarshalTo copies root into data, which must have at least 32 bytes.
+func (r *Root) MarshalTo(data []byte) (int, error) {
+	if len(data) < len(r) {
+		return 0, fmt.Errorf("expected buffer of length at least %d received %d", len(r), len(data))
+	}
+	return copy(data, r[:]), nil
+}
+
+// Unmarshal sets root from data, empty data results in zero root.
+func (r *Root) Unmarshal(data []byte) error {
+	if len(data) == 0 {
+		*r = Root{}
+		return nil
+	}
+	return r.UnmarshalSSZ(data)
+}
+
+// Size returns size of the protobuf encoded root.
+func (r *Root) Size() int {
+	return len(r)
+}
@@ -0,0 +1,32 @@
+package types
+
+import "testing"
+
+func TestRoot_CustomType(t *testing.T) {
+	root := Root{0x01, 31: 0x02}
+	enc, err := root.Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, root.Size())
+	if n, err := root.MarshalTo(buf); err != nil || n != 32 {
+		t.Fatalf("Unexpected MarshalTo result: %d, %v", n, err)
+	}
+	var decoded Root
+	if err := decoded.Unmarshal(buf); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.Equal(root) || string(enc) != string(buf) {
+		t.Errorf("Unexpected root: %v", decoded)
+	}
+
+	if err := decoded.Unmarshal(nil); err != nil || !decoded.IsZero() {
+		t.Errorf("Expected zero root, got %v (%v)", decoded, err)
+	}
+	if err := decoded.Unmarshal(buf[:31]); err == nil {
+		t.Error("Expected error for short buffer")
+	}
+	if _, err := root.MarshalTo(buf[:31]); err == nil {
+		t.Error("Expected error for short buffer")
+	}
+}
@@ -0,0 +1,55 @@
+package types
+
+import "sync"
+
+// RootMemo memoizes hash tree roots of comparable values (e.g. Checkpoint, AttestationData), which
+// are otherwise re-hashed for every attestation referencing them. Memoizing basic uint64-backed values
+// (Slot, Epoch etc) makes no sense: their root is just the padded little-endian encoding.
+//
+// Memo holds at most size entries; once full, it is cleared, which is cheap and works well for the
+// typical access pattern (a small working set of values per epoch). It is safe for concurrent use.
+type RootMemo[K comparable] struct {
+	lock  sync.Mutex
+	roots map[K][32]byte
+	size  int
+	hash  func(K) ([32]byte, error)
+}
+
+// NewRootMemo creates memo of the given size, using hash to compute missing roots.
+func NewRootMemo[K comparable](size int, hash func(K) ([32]byte, error)) *RootMemo[K] {
+	return &RootMemo[K]{
+		roots: make(map[K][32]byte, size),
+		size:  size,
+		hash:  hash,
+	}
+}
+
+// HashTreeRoot returns memoized root of the value, computing it if necessary.
+// Hashing is done outside the lock, so concurrent misses for the same value may hash it twice.
+func (m *RootMemo[K]) HashTreeRoot(v K) ([32]byte, error) {
+	m.lock.Lock()
+	root, ok := m.roots[v]
+	m.lock.Unlock()
+	if ok {
+		return root, nil
+	}
+
+	root, err := m.hash(v)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if len(m.roots) >= m.size {
+		m.roots = make(map[K][32]byte, m.size)
+	}
+	m.roots[v] = root
+	return root, nil
+}
+
+// Len returns number of memoized roots.
+func (m *RootMemo[K]) Len() int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return len(m.roots)
+}
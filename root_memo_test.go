@@ -0,0 +1,49 @@
+package types
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRootMemo(t *testing.T) {
+	calls := 0
+	memo := NewRootMemo(2, func(c Checkpoint) ([32]byte, error) {
+		calls++
+		return c.HashTreeRoot()
+	})
+	cp := Checkpoint{Epoch: 5, Root: BlockRoot{Root{0x01}}}
+	want, err := cp.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		got, err := memo.HashTreeRoot(cp)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Errorf("Unexpected root: %#x", got)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("Expected single hash computation, got %d", calls)
+	}
+
+	for epoch := Epoch(10); epoch < 13; epoch++ {
+		if _, err := memo.HashTreeRoot(Checkpoint{Epoch: epoch}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if memo.Len() > 2 {
+		t.Errorf("Memo exceeds its size: %d", memo.Len())
+	}
+
+	errHash := errors.New("hash failed")
+	failing := NewRootMemo(2, func(Slot) ([32]byte, error) { return [32]byte{}, errHash })
+	if _, err := failing.HashTreeRoot(1); !errors.Is(err, errHash) {
+		t.Errorf("Expected hash error, got: %v", err)
+	}
+	if failing.Len() != 0 {
+		t.Error("Failed computations should not be memoized")
+	}
+}
@@ -0,0 +1,8 @@
+//go:build !nofastssz
+
+package types
+
+import "github.com/minio/sha256-simd"
+
+// sum256 returns SHA-256 checksum of data, using SIMD accelerated implementation.
+var sum256 = sha256.Sum256
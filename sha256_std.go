@@ -0,0 +1,8 @@
+//go:build nofastssz
+
+package types
+
+import "crypto/sha256"
+
+// sum256 returns SHA-256 checksum of data, using standard library implementation.
+var sum256 = sha256.Sum256
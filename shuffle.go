@@ -0,0 +1,101 @@
+package types
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// Shuffle round counts of the spec presets (SHUFFLE_ROUND_COUNT).
+const (
+	ShuffleRoundCountMainnet = 90
+	ShuffleRoundCountMinimal = 10
+)
+
+// ErrInvalidShuffleIndex is returned when shuffled index is not below the index count.
+var ErrInvalidShuffleIndex = errors.New("invalid shuffle index")
+
+// ComputeShuffledIndex returns position of the index after swap-or-not shuffling of count indices,
+// see compute_shuffled_index.
+func ComputeShuffledIndex(index ValidatorIndex, count uint64, seed Root, rounds uint8) (ValidatorIndex, error) {
+	return shuffleIndex(index, count, seed, rounds, false)
+}
+
+// ComputeUnshuffledIndex is the inverse of ComputeShuffledIndex: it returns the index which is
+// shuffled into the given position.
+func ComputeUnshuffledIndex(position ValidatorIndex, count uint64, seed Root, rounds uint8) (ValidatorIndex, error) {
+	return shuffleIndex(position, count, seed, rounds, true)
+}
+
+// ShuffledIndices returns shuffled positions of all indices in [0, count) range, i.e. the result of
+// ComputeShuffledIndex for each of them, hashing every source chunk once per round.
+func ShuffledIndices(count uint64, seed Root, rounds uint8) []ValidatorIndex {
+	indices := make([]ValidatorIndex, count)
+	for i := range indices {
+		indices[i] = ValidatorIndex(i)
+	}
+	if count <= 1 {
+		return indices
+	}
+	var buf [32 + 1 + 4]byte
+	copy(buf[:], seed[:])
+	sources := make([][32]byte, (count+255)/256)
+	for round := uint8(0); round < rounds; round++ {
+		buf[32] = round
+		pivot := shufflePivot(&buf, count)
+		for i := range sources {
+			binary.LittleEndian.PutUint32(buf[33:], uint32(i))
+			sources[i] = sum256(buf[:])
+		}
+		for i, index := range indices {
+			flip := ValidatorIndex((pivot + count - uint64(index)) % count)
+			position := index
+			if flip > position {
+				position = flip
+			}
+			if shuffleBit(&sources[position/256], uint64(position)) {
+				indices[i] = flip
+			}
+		}
+	}
+	return indices
+}
+
+// shuffleIndex runs swap-or-not rounds on the index, in reverse order if inverse is set (each round
+// is an involution, so reversing the order inverts the permutation).
+func shuffleIndex(index ValidatorIndex, count uint64, seed Root, rounds uint8, inverse bool) (ValidatorIndex, error) {
+	if uint64(index) >= count {
+		return 0, fmt.Errorf("%w: %d, index count %d", ErrInvalidShuffleIndex, index, count)
+	}
+	var buf [32 + 1 + 4]byte
+	copy(buf[:], seed[:])
+	for i := uint8(0); i < rounds; i++ {
+		round := i
+		if inverse {
+			round = rounds - 1 - i
+		}
+		buf[32] = round
+		flip := ValidatorIndex((shufflePivot(&buf, count) + count - uint64(index)) % count)
+		position := index
+		if flip > position {
+			position = flip
+		}
+		binary.LittleEndian.PutUint32(buf[33:], uint32(position/256))
+		source := sum256(buf[:])
+		if shuffleBit(&source, uint64(position)) {
+			index = flip
+		}
+	}
+	return index, nil
+}
+
+// shufflePivot returns pivot of the round, buf must hold seed and round number.
+func shufflePivot(buf *[37]byte, count uint64) uint64 {
+	digest := sum256(buf[:33])
+	return binary.LittleEndian.Uint64(digest[:8]) % count
+}
+
+// shuffleBit returns bit of the position within its source chunk.
+func shuffleBit(source *[32]byte, position uint64) bool {
+	return (source[(position%256)/8]>>(position%8))&1 == 1
+}
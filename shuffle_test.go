@@ -0,0 +1,60 @@
+package types
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestComputeShuffledIndex(t *testing.T) {
+	seed := Root{0x01}
+	want := []ValidatorIndex{0, 3, 6, 9, 1, 5, 4, 7, 8, 2}
+	for i, w := range want {
+		got, err := ComputeShuffledIndex(ValidatorIndex(i), 10, seed, ShuffleRoundCountMainnet)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != w {
+			t.Errorf("Unexpected shuffled index of %d: %d, want %d", i, got, w)
+		}
+	}
+	for i, w := range map[ValidatorIndex]ValidatorIndex{0: 248, 1: 396, 255: 531, 256: 234, 999: 67} {
+		got, err := ComputeShuffledIndex(i, 1000, seed, ShuffleRoundCountMinimal)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != w {
+			t.Errorf("Unexpected shuffled index of %d: %d, want %d", i, got, w)
+		}
+		if inv, err := ComputeUnshuffledIndex(got, 1000, seed, ShuffleRoundCountMinimal); err != nil || inv != i {
+			t.Errorf("Unexpected unshuffled index of %d: %d, want %d", got, inv, i)
+		}
+	}
+	if _, err := ComputeShuffledIndex(10, 10, seed, 1); !errors.Is(err, ErrInvalidShuffleIndex) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestShuffledIndices(t *testing.T) {
+	seed := Root{0xab, 31: 0xcd}
+	for _, count := range []uint64{0, 1, 2, 255, 256, 257, 1000} {
+		indices := ShuffledIndices(count, seed, ShuffleRoundCountMinimal)
+		seen := make(map[ValidatorIndex]bool, count)
+		for i, index := range indices {
+			want, err := ComputeShuffledIndex(ValidatorIndex(i), count, seed, ShuffleRoundCountMinimal)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if index != want {
+				t.Fatalf("Unexpected shuffled index of %d out of %d: %d, want %d", i, count, index, want)
+			}
+			seen[index] = true
+		}
+		if uint64(len(seen)) != count {
+			t.Errorf("Shuffling of %d indices is not a permutation", count)
+		}
+	}
+	if !reflect.DeepEqual(ShuffledIndices(5, seed, 0), []ValidatorIndex{0, 1, 2, 3, 4}) {
+		t.Error("Zero rounds must leave indices intact")
+	}
+}
@@ -0,0 +1,43 @@
+package types
+
+// ShufflingSourceEpoch returns epoch whose randao mix (as of its last slot) seeds the epoch's committee
+// shuffling, i.e. e - MIN_SEED_LOOKAHEAD - 1, clamped at genesis (see get_seed).
+func (e Epoch) ShufflingSourceEpoch(spec *ChainSpec) Epoch {
+	return saturatingSubEpoch(e, spec.MinSeedLookahead+1)
+}
+
+// ShufflingKnownFrom returns the earliest epoch at which the epoch's committee shuffling can be
+// computed, i.e. e - MIN_SEED_LOOKAHEAD, clamped at genesis. This is how far ahead validators can
+// predict their attester duties.
+func (e Epoch) ShufflingKnownFrom(spec *ChainSpec) Epoch {
+	return saturatingSubEpoch(e, spec.MinSeedLookahead)
+}
+
+// ShufflingDecisionSlot returns slot whose block determines the epoch's committee shuffling (the last
+// slot of ShufflingKnownFrom's previous epoch), GenesisSlot for the early epochs. Root of the block at
+// that slot (dependent_root in duties responses) identifies the shuffling. Panics on overflow.
+func (e Epoch) ShufflingDecisionSlot(spec *ChainSpec) Slot {
+	return lastSlotBefore(e.ShufflingKnownFrom(spec), spec)
+}
+
+// ProposerDecisionSlot returns slot whose block determines the epoch's proposers (the last slot of
+// the previous epoch), GenesisSlot for the genesis epoch. Panics on overflow.
+func (e Epoch) ProposerDecisionSlot(spec *ChainSpec) Slot {
+	return lastSlotBefore(e, spec)
+}
+
+// lastSlotBefore returns the last slot of the epoch preceding e, GenesisSlot for the genesis epoch.
+func lastSlotBefore(e Epoch, spec *ChainSpec) Slot {
+	if e.IsGenesis() {
+		return GenesisSlot
+	}
+	return e.StartSlot(spec) - 1
+}
+
+// saturatingSubEpoch returns e - x, clamped at GenesisEpoch.
+func saturatingSubEpoch(e, x Epoch) Epoch {
+	if diff, err := e.SafeSub(uint64(x)); err == nil {
+		return diff
+	}
+	return GenesisEpoch
+}
@@ -0,0 +1,32 @@
+package types
+
+import "testing"
+
+func TestEpoch_Shuffling(t *testing.T) {
+	spec := &ChainSpec{SlotsPerEpoch: 32, MinSeedLookahead: 1}
+	tests := []struct {
+		epoch         Epoch
+		source, known Epoch
+		decision      Slot
+		proposer      Slot
+	}{
+		{epoch: 0, source: 0, known: 0, decision: 0, proposer: 0},
+		{epoch: 1, source: 0, known: 0, decision: 0, proposer: 31},
+		{epoch: 2, source: 0, known: 1, decision: 31, proposer: 63},
+		{epoch: 10, source: 8, known: 9, decision: 287, proposer: 319},
+	}
+	for _, tt := range tests {
+		if got := tt.epoch.ShufflingSourceEpoch(spec); got != tt.source {
+			t.Errorf("Unexpected shuffling source epoch of %d: %d", tt.epoch, got)
+		}
+		if got := tt.epoch.ShufflingKnownFrom(spec); got != tt.known {
+			t.Errorf("Unexpected shuffling known epoch of %d: %d", tt.epoch, got)
+		}
+		if got := tt.epoch.ShufflingDecisionSlot(spec); got != tt.decision {
+			t.Errorf("Unexpected shuffling decision slot of %d: %d", tt.epoch, got)
+		}
+		if got := tt.epoch.ProposerDecisionSlot(spec); got != tt.proposer {
+			t.Errorf("Unexpected proposer decision slot of %d: %d", tt.epoch, got)
+		}
+	}
+}
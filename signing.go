@@ -0,0 +1,41 @@
+package types
+
+// Domain represents a 32 byte signing domain: domain type followed by the fork data root prefix.
+type Domain Bytes32
+
+// HashRooter is implemented by objects which can be signed, i.e. have hash tree root.
+type HashRooter interface {
+	HashTreeRoot() ([32]byte, error)
+}
+
+// ComputeForkDataRoot returns hash tree root of the ForkData container, see compute_fork_data_root.
+func ComputeForkDataRoot(version ForkVersion, genesisValidatorsRoot Root) (Root, error) {
+	var chunks [2][32]byte
+	chunks[0], _ = version.HashTreeRoot()
+	chunks[1] = genesisValidatorsRoot
+	return merkleize(chunks[:], 0)
+}
+
+// ComputeDomain returns signing domain of the given type for the fork, see compute_domain.
+// Fork-agnostic domains (deposits, builder registrations) use the genesis fork version and zero root.
+func ComputeDomain(domainType DomainType, version ForkVersion, genesisValidatorsRoot Root) (Domain, error) {
+	forkDataRoot, err := ComputeForkDataRoot(version, genesisValidatorsRoot)
+	if err != nil {
+		return Domain{}, err
+	}
+	var domain Domain
+	copy(domain[:4], domainType[:])
+	copy(domain[4:], forkDataRoot[:28])
+	return domain, nil
+}
+
+// ComputeSigningRoot returns root to be signed for the object under the domain, see compute_signing_root.
+func ComputeSigningRoot(obj HashRooter, domain Domain) (Root, error) {
+	var chunks [2][32]byte
+	var err error
+	if chunks[0], err = obj.HashTreeRoot(); err != nil {
+		return Root{}, err
+	}
+	chunks[1] = domain
+	return merkleize(chunks[:], 0)
+}
@@ -0,0 +1,126 @@
+// Package slasher contains types and computations for the chunked storage of min/max attestation spans.
+//
+// Spans of each validator cover the last HistoryLength epochs (wrapping around), and are split into
+// chunks of ChunkSize epochs. Chunks of ValidatorChunkSize consecutive validators are stored together
+// in a single flat slice, identified by (ValidatorChunkIndex, ChunkIndex) pair:
+//
+//	            chunk 0         chunk 1
+//	val 0   [e0 e1 e2 e3] [e4 e5 e6 e7] ...
+//	val 1   [e0 e1 e2 e3] [e4 e5 e6 e7] ...
+//
+// With ChunkSize = 4 and ValidatorChunkSize = 2, chunk 1 of validator chunk 0 is a flat slice of
+// 8 cells: epochs 4..7 of validator 0 followed by epochs 4..7 of validator 1.
+package slasher
+
+import (
+	"errors"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+// ErrInvalidParams is returned when chunking parameters are inconsistent.
+var ErrInvalidParams = errors.New("invalid slasher chunking parameters")
+
+// DefaultHistoryLength is the number of epochs of history kept by Prysm slasher.
+const DefaultHistoryLength types.Epoch = 4096
+
+// ChunkIndex identifies chunk of epochs within the history.
+type ChunkIndex uint64
+
+// ValidatorChunkIndex identifies group of validators stored together.
+type ValidatorChunkIndex uint64
+
+// Params define how spans are split into chunks.
+type Params struct {
+	// ChunkSize is the number of epochs in a single chunk (C).
+	ChunkSize uint64
+	// ValidatorChunkSize is the number of validators stored in a single flat chunk (K).
+	ValidatorChunkSize uint64
+	// HistoryLength is the number of epochs of history kept (H), must be a multiple of ChunkSize.
+	HistoryLength types.Epoch
+}
+
+// DefaultParams returns chunking parameters used by Prysm slasher.
+func DefaultParams() *Params {
+	return &Params{
+		ChunkSize:          16,
+		ValidatorChunkSize: 256,
+		HistoryLength:      DefaultHistoryLength,
+	}
+}
+
+// NewParams returns validated chunking parameters.
+func NewParams(chunkSize, validatorChunkSize uint64, historyLength types.Epoch) (*Params, error) {
+	p := &Params{
+		ChunkSize:          chunkSize,
+		ValidatorChunkSize: validatorChunkSize,
+		HistoryLength:      historyLength,
+	}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Validate checks that parameters are non-zero, and history consists of whole chunks.
+func (p *Params) Validate() error {
+	if p.ChunkSize == 0 || p.ValidatorChunkSize == 0 || p.HistoryLength == 0 {
+		return ErrInvalidParams
+	}
+	if uint64(p.HistoryLength)%p.ChunkSize != 0 {
+		return ErrInvalidParams
+	}
+	return nil
+}
+
+// ChunksPerValidatorChunk returns number of chunks spanning the whole history (H / C).
+func (p *Params) ChunksPerValidatorChunk() uint64 {
+	return uint64(p.HistoryLength) / p.ChunkSize
+}
+
+// ChunkIndex returns index of the chunk holding the epoch: `(epoch % H) / C`.
+func (p *Params) ChunkIndex(epoch types.Epoch) ChunkIndex {
+	return ChunkIndex(uint64(epoch.ModEpoch(p.HistoryLength)) / p.ChunkSize)
+}
+
+// ValidatorChunkIndex returns index of the validator chunk holding the validator: `v / K`.
+func (p *Params) ValidatorChunkIndex(validatorIndex types.ValidatorIndex) ValidatorChunkIndex {
+	return ValidatorChunkIndex(uint64(validatorIndex) / p.ValidatorChunkSize)
+}
+
+// CellIndex returns offset of the (validator, epoch) pair within its flat chunk: `(v % K) * C + epoch % C`.
+func (p *Params) CellIndex(validatorIndex types.ValidatorIndex, epoch types.Epoch) uint64 {
+	validatorOffset := uint64(validatorIndex) % p.ValidatorChunkSize
+	epochOffset := uint64(epoch) % p.ChunkSize
+	return validatorOffset*p.ChunkSize + epochOffset
+}
+
+// FlatChunkID returns unique identifier of the flat chunk (e.g. to be used as DB key): `H / C * vci + ci`.
+func (p *Params) FlatChunkID(validatorChunkIndex ValidatorChunkIndex, chunkIndex ChunkIndex) uint64 {
+	return p.ChunksPerValidatorChunk()*uint64(validatorChunkIndex) + uint64(chunkIndex)
+}
+
+// ChunkLength returns number of cells in a single flat chunk (K * C).
+func (p *Params) ChunkLength() uint64 {
+	return p.ValidatorChunkSize * p.ChunkSize
+}
+
+// FirstEpoch returns the first epoch covered by chunk (within the first history window).
+func (p *Params) FirstEpoch(chunkIndex ChunkIndex) types.Epoch {
+	return types.Epoch(uint64(chunkIndex) * p.ChunkSize)
+}
+
+// LastEpoch returns the last epoch covered by chunk (within the first history window).
+func (p *Params) LastEpoch(chunkIndex ChunkIndex) types.Epoch {
+	return p.FirstEpoch(chunkIndex).Add(p.ChunkSize - 1)
+}
+
+// ValidatorIndices returns indices of validators stored in the validator chunk.
+func (p *Params) ValidatorIndices(validatorChunkIndex ValidatorChunkIndex) []types.ValidatorIndex {
+	first := uint64(validatorChunkIndex) * p.ValidatorChunkSize
+	indices := make([]types.ValidatorIndex, p.ValidatorChunkSize)
+	for i := range indices {
+		indices[i] = types.ValidatorIndex(first + uint64(i))
+	}
+	return indices
+}
@@ -0,0 +1,116 @@
+package slasher
+
+import (
+	"errors"
+	"testing"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+func TestNewParams(t *testing.T) {
+	if _, err := NewParams(16, 256, 4096); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if err := DefaultParams().Validate(); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	for _, p := range []Params{
+		{ChunkSize: 0, ValidatorChunkSize: 1, HistoryLength: 1},
+		{ChunkSize: 1, ValidatorChunkSize: 0, HistoryLength: 1},
+		{ChunkSize: 1, ValidatorChunkSize: 1, HistoryLength: 0},
+		{ChunkSize: 3, ValidatorChunkSize: 1, HistoryLength: 10},
+	} {
+		if _, err := NewParams(p.ChunkSize, p.ValidatorChunkSize, p.HistoryLength); !errors.Is(err, ErrInvalidParams) {
+			t.Errorf("Expected error for %+v", p)
+		}
+	}
+}
+
+func TestParams_Indices(t *testing.T) {
+	p, err := NewParams(4, 2, 12)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		validator types.ValidatorIndex
+		epoch     types.Epoch
+		vci       ValidatorChunkIndex
+		ci        ChunkIndex
+		cell      uint64
+		flatID    uint64
+	}{
+		{validator: 0, epoch: 0, vci: 0, ci: 0, cell: 0, flatID: 0},
+		{validator: 0, epoch: 3, vci: 0, ci: 0, cell: 3, flatID: 0},
+		{validator: 1, epoch: 3, vci: 0, ci: 0, cell: 7, flatID: 0},
+		{validator: 1, epoch: 4, vci: 0, ci: 1, cell: 4, flatID: 1},
+		{validator: 2, epoch: 11, vci: 1, ci: 2, cell: 3, flatID: 5},
+		// History wraps around.
+		{validator: 3, epoch: 12, vci: 1, ci: 0, cell: 4, flatID: 3},
+		{validator: 3, epoch: 17, vci: 1, ci: 1, cell: 5, flatID: 4},
+	}
+	for _, tt := range tests {
+		if got := p.ValidatorChunkIndex(tt.validator); got != tt.vci {
+			t.Errorf("ValidatorChunkIndex(%d) = %d, want %d", tt.validator, got, tt.vci)
+		}
+		if got := p.ChunkIndex(tt.epoch); got != tt.ci {
+			t.Errorf("ChunkIndex(%d) = %d, want %d", tt.epoch, got, tt.ci)
+		}
+		if got := p.CellIndex(tt.validator, tt.epoch); got != tt.cell {
+			t.Errorf("CellIndex(%d, %d) = %d, want %d", tt.validator, tt.epoch, got, tt.cell)
+		}
+		if got := p.FlatChunkID(tt.vci, tt.ci); got != tt.flatID {
+			t.Errorf("FlatChunkID(%d, %d) = %d, want %d", tt.vci, tt.ci, got, tt.flatID)
+		}
+	}
+}
+
+// TestParams_Exhaustive checks that every (validator, epoch) pair within the history window maps
+// to a distinct (flat chunk, cell) position, and that positions are densely packed.
+func TestParams_Exhaustive(t *testing.T) {
+	for _, p := range []*Params{
+		{ChunkSize: 1, ValidatorChunkSize: 1, HistoryLength: 1},
+		{ChunkSize: 4, ValidatorChunkSize: 2, HistoryLength: 12},
+		{ChunkSize: 3, ValidatorChunkSize: 5, HistoryLength: 9},
+		{ChunkSize: 16, ValidatorChunkSize: 8, HistoryLength: 64},
+	} {
+		const numValidators = 40
+		type position struct {
+			flatID, cell uint64
+		}
+		seen := make(map[position]bool)
+		for v := types.ValidatorIndex(0); v < numValidators; v++ {
+			for e := types.Epoch(0); e < p.HistoryLength; e++ {
+				vci, ci := p.ValidatorChunkIndex(v), p.ChunkIndex(e)
+				pos := position{flatID: p.FlatChunkID(vci, ci), cell: p.CellIndex(v, e)}
+				if pos.cell >= p.ChunkLength() {
+					t.Fatalf("Cell %d is outside of chunk of length %d", pos.cell, p.ChunkLength())
+				}
+				if seen[pos] {
+					t.Fatalf("Position %+v is already taken (validator %d, epoch %d, params %+v)", pos, v, e, p)
+				}
+				seen[pos] = true
+
+				if e < p.FirstEpoch(ci) || e > p.LastEpoch(ci) {
+					t.Fatalf("Epoch %d is outside of chunk %d bounds", e, ci)
+				}
+				found := false
+				for _, idx := range p.ValidatorIndices(vci) {
+					found = found || idx == v
+				}
+				if !found {
+					t.Fatalf("Validator %d is not in validator chunk %d", v, vci)
+				}
+
+				// Same position must be used after history wraps around.
+				wrapped := e.AddEpoch(p.HistoryLength)
+				if p.ChunkIndex(wrapped) != ci || p.CellIndex(v, wrapped) != pos.cell {
+					t.Fatalf("Epoch %d maps to different position than epoch %d", wrapped, e)
+				}
+			}
+		}
+		// Densely packed: number of positions equals validators * history length.
+		if uint64(len(seen)) != numValidators*uint64(p.HistoryLength) {
+			t.Errorf("Unexpected number of positions: %d", len(seen))
+		}
+	}
+}
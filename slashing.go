@@ -0,0 +1,299 @@
+package types
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// ErrInvalidSlashing is returned when slashing evidence doesn't prove a slashable offence.
+var ErrInvalidSlashing = errors.New("invalid slashing")
+
+// attesterSlashingFixedSize is the size of the fixed part: offsets of both attestations.
+const attesterSlashingFixedSize = 8
+
+// ProposerSlashing is the spec ProposerSlashing container: two distinct signed headers of the same
+// proposer for the same slot.
+type ProposerSlashing struct {
+	SignedHeader1 SignedBeaconBlockHeader `json:"signed_header_1"`
+	SignedHeader2 SignedBeaconBlockHeader `json:"signed_header_2"`
+}
+
+// Validate checks that headers are distinct, but have the same slot and proposer (signatures are not
+// verified), see process_proposer_slashing.
+func (s *ProposerSlashing) Validate() error {
+	h1, h2 := &s.SignedHeader1.Message, &s.SignedHeader2.Message
+	if h1.Slot != h2.Slot {
+		return fmt.Errorf("%w: header slots differ (%d != %d)", ErrInvalidSlashing, h1.Slot, h2.Slot)
+	}
+	if h1.ProposerIndex != h2.ProposerIndex {
+		return fmt.Errorf("%w: header proposers differ (%d != %d)", ErrInvalidSlashing, h1.ProposerIndex, h2.ProposerIndex)
+	}
+	if *h1 == *h2 {
+		return fmt.Errorf("%w: headers are equal", ErrInvalidSlashing)
+	}
+	return nil
+}
+
+// HashTreeRoot returns calculated hash root.
+func (s *ProposerSlashing) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	err := s.HashTreeRootInto(&root)
+	return root, err
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (s *ProposerSlashing) HashTreeRootInto(dst *[32]byte) error {
+	var chunks [2][32]byte
+	if err := s.SignedHeader1.HashTreeRootInto(&chunks[0]); err != nil {
+		return err
+	}
+	if err := s.SignedHeader2.HashTreeRootInto(&chunks[1]); err != nil {
+		return err
+	}
+	return merkleizeInto(dst, chunks[:], 0)
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the proposer slashing object.
+func (s *ProposerSlashing) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), s.SizeSSZ()); err != nil {
+		return err
+	}
+	if err := s.SignedHeader1.UnmarshalSSZ(buf[:208]); err != nil {
+		return err
+	}
+	return s.SignedHeader2.UnmarshalSSZ(buf[208:])
+}
+
+// MarshalSSZTo marshals proposer slashing with the provided byte slice.
+func (s *ProposerSlashing) MarshalSSZTo(dst []byte) ([]byte, error) {
+	dst, _ = s.SignedHeader1.MarshalSSZTo(dst)
+	return s.SignedHeader2.MarshalSSZTo(dst)
+}
+
+// MarshalSSZ marshals proposer slashing into a serialized object.
+func (s *ProposerSlashing) MarshalSSZ() ([]byte, error) {
+	return s.MarshalSSZTo(make([]byte, 0, s.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (s *ProposerSlashing) SizeSSZ() int {
+	return 416
+}
+
+// WriteTo writes SSZ serialized proposer slashing to w.
+func (s *ProposerSlashing) WriteTo(w io.Writer) (int64, error) {
+	return writeSSZTo(w, s)
+}
+
+// ReadFrom reads SSZ serialized proposer slashing from reader (exactly SizeSSZ bytes are consumed).
+func (s *ProposerSlashing) ReadFrom(reader io.Reader) (int64, error) {
+	return readSSZFrom(reader, s)
+}
+
+// AttesterSlashing is the spec (pre-Electra) AttesterSlashing container: two conflicting attestations.
+type AttesterSlashing struct {
+	Attestation1 IndexedAttestation `json:"attestation_1"`
+	Attestation2 IndexedAttestation `json:"attestation_2"`
+}
+
+// AttesterSlashingElectra is the Electra AttesterSlashing container.
+type AttesterSlashingElectra struct {
+	Attestation1 IndexedAttestationElectra `json:"attestation_1"`
+	Attestation2 IndexedAttestationElectra `json:"attestation_2"`
+}
+
+// Validate checks that attestations are well-formed and slashable (signatures are not verified),
+// see process_attester_slashing.
+func (s *AttesterSlashing) Validate() error {
+	if err := s.Attestation1.Validate(); err != nil {
+		return err
+	}
+	if err := s.Attestation2.Validate(); err != nil {
+		return err
+	}
+	return validateAttesterSlashing(&s.Attestation1.Data, &s.Attestation2.Data,
+		s.Attestation1.AttestingIndices, s.Attestation2.AttestingIndices)
+}
+
+// SlashableIndices returns sorted indices of validators attesting in both attestations.
+func (s *AttesterSlashing) SlashableIndices() []ValidatorIndex {
+	return intersectSorted(s.Attestation1.AttestingIndices, s.Attestation2.AttestingIndices)
+}
+
+// HashTreeRoot returns calculated hash root.
+func (s *AttesterSlashing) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	err := s.HashTreeRootInto(&root)
+	return root, err
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (s *AttesterSlashing) HashTreeRootInto(dst *[32]byte) error {
+	return hashTreeRootPairInto(dst, &s.Attestation1, &s.Attestation2)
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the attester slashing object.
+func (s *AttesterSlashing) UnmarshalSSZ(buf []byte) error {
+	return unmarshalVariablePair(buf, &s.Attestation1, &s.Attestation2)
+}
+
+// MarshalSSZTo marshals attester slashing with the provided byte slice.
+func (s *AttesterSlashing) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return marshalVariablePair(dst, &s.Attestation1, &s.Attestation2)
+}
+
+// MarshalSSZ marshals attester slashing into a serialized object.
+func (s *AttesterSlashing) MarshalSSZ() ([]byte, error) {
+	return s.MarshalSSZTo(make([]byte, 0, s.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (s *AttesterSlashing) SizeSSZ() int {
+	return attesterSlashingFixedSize + s.Attestation1.SizeSSZ() + s.Attestation2.SizeSSZ()
+}
+
+// Validate checks that attestations are well-formed and slashable (signatures are not verified),
+// see process_attester_slashing.
+func (s *AttesterSlashingElectra) Validate() error {
+	if err := s.Attestation1.Validate(); err != nil {
+		return err
+	}
+	if err := s.Attestation2.Validate(); err != nil {
+		return err
+	}
+	return validateAttesterSlashing(&s.Attestation1.Data, &s.Attestation2.Data,
+		s.Attestation1.AttestingIndices, s.Attestation2.AttestingIndices)
+}
+
+// SlashableIndices returns sorted indices of validators attesting in both attestations.
+func (s *AttesterSlashingElectra) SlashableIndices() []ValidatorIndex {
+	return intersectSorted(s.Attestation1.AttestingIndices, s.Attestation2.AttestingIndices)
+}
+
+// HashTreeRoot returns calculated hash root.
+func (s *AttesterSlashingElectra) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	err := s.HashTreeRootInto(&root)
+	return root, err
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (s *AttesterSlashingElectra) HashTreeRootInto(dst *[32]byte) error {
+	return hashTreeRootPairInto(dst, &s.Attestation1, &s.Attestation2)
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the attester slashing object.
+func (s *AttesterSlashingElectra) UnmarshalSSZ(buf []byte) error {
+	return unmarshalVariablePair(buf, &s.Attestation1, &s.Attestation2)
+}
+
+// MarshalSSZTo marshals attester slashing with the provided byte slice.
+func (s *AttesterSlashingElectra) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return marshalVariablePair(dst, &s.Attestation1, &s.Attestation2)
+}
+
+// MarshalSSZ marshals attester slashing into a serialized object.
+func (s *AttesterSlashingElectra) MarshalSSZ() ([]byte, error) {
+	return s.MarshalSSZTo(make([]byte, 0, s.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (s *AttesterSlashingElectra) SizeSSZ() int {
+	return attesterSlashingFixedSize + s.Attestation1.SizeSSZ() + s.Attestation2.SizeSSZ()
+}
+
+// sszObject is implemented by containers composing variable-size containers.
+type sszObject interface {
+	HashTreeRootInto(dst *[32]byte) error
+	MarshalSSZTo(dst []byte) ([]byte, error)
+	UnmarshalSSZ(buf []byte) error
+	SizeSSZ() int
+}
+
+func validateAttesterSlashing(a, b *AttestationData, indices1, indices2 []ValidatorIndex) error {
+	if !IsSlashable(a, b) {
+		return fmt.Errorf("%w: attestations are neither double nor surround vote", ErrInvalidSlashing)
+	}
+	if !intersectsSorted(indices1, indices2) {
+		return fmt.Errorf("%w: no validator attested in both attestations", ErrInvalidSlashing)
+	}
+	return nil
+}
+
+// intersectsSorted returns true if sorted slices have at least one value in common.
+func intersectsSorted(a, b []ValidatorIndex) bool {
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case a[i] > b[j]:
+			j++
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// intersectSorted returns values present in both sorted slices.
+func intersectSorted(a, b []ValidatorIndex) []ValidatorIndex {
+	var result []ValidatorIndex
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] < b[j]:
+			i++
+		case a[i] > b[j]:
+			j++
+		default:
+			result = append(result, a[i])
+			i++
+			j++
+		}
+	}
+	return result
+}
+
+func hashTreeRootPairInto(dst *[32]byte, a, b sszObject) error {
+	var chunks [2][32]byte
+	if err := a.HashTreeRootInto(&chunks[0]); err != nil {
+		return err
+	}
+	if err := b.HashTreeRootInto(&chunks[1]); err != nil {
+		return err
+	}
+	return merkleizeInto(dst, chunks[:], 0)
+}
+
+// unmarshalVariablePair decodes container consisting of two variable-size fields.
+func unmarshalVariablePair(buf []byte, a, b sszObject) error {
+	if err := sszutil.CheckMinLength(len(buf), attesterSlashingFixedSize); err != nil {
+		return err
+	}
+	offset1, offset2 := binary.LittleEndian.Uint32(buf[:4]), binary.LittleEndian.Uint32(buf[4:8])
+	if err := sszutil.CheckOffset(offset1, attesterSlashingFixedSize); err != nil {
+		return err
+	}
+	if offset2 < offset1 || uint64(offset2) > uint64(len(buf)) {
+		return &sszutil.OffsetError{Expected: len(buf), Actual: offset2}
+	}
+	if err := a.UnmarshalSSZ(buf[offset1:offset2]); err != nil {
+		return err
+	}
+	return b.UnmarshalSSZ(buf[offset2:])
+}
+
+// marshalVariablePair encodes container consisting of two variable-size fields.
+func marshalVariablePair(dst []byte, a, b sszObject) ([]byte, error) {
+	offset2 := uint32(attesterSlashingFixedSize + a.SizeSSZ())
+	dst = append(dst, attesterSlashingFixedSize, 0, 0, 0)
+	dst = append(dst, byte(offset2), byte(offset2>>8), byte(offset2>>16), byte(offset2>>24))
+	dst, err := a.MarshalSSZTo(dst)
+	if err != nil {
+		return dst, err
+	}
+	return b.MarshalSSZTo(dst)
+}
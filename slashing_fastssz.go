@@ -0,0 +1,45 @@
+//go:build !nofastssz
+
+package types
+
+import fssz "github.com/ferranbt/fastssz"
+
+var _ fssz.HashRoot = (*ProposerSlashing)(nil)
+var _ fssz.Marshaler = (*ProposerSlashing)(nil)
+var _ fssz.Unmarshaler = (*ProposerSlashing)(nil)
+var _ fssz.HashRoot = (*AttesterSlashing)(nil)
+var _ fssz.Marshaler = (*AttesterSlashing)(nil)
+var _ fssz.Unmarshaler = (*AttesterSlashing)(nil)
+var _ fssz.HashRoot = (*AttesterSlashingElectra)(nil)
+var _ fssz.Marshaler = (*AttesterSlashingElectra)(nil)
+var _ fssz.Unmarshaler = (*AttesterSlashingElectra)(nil)
+
+// HashTreeRootWith appends proposer slashing root to the provided hasher.
+func (s *ProposerSlashing) HashTreeRootWith(hh *fssz.Hasher) error {
+	root, err := s.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	hh.PutBytes(root[:])
+	return nil
+}
+
+// HashTreeRootWith appends attester slashing root to the provided hasher.
+func (s *AttesterSlashing) HashTreeRootWith(hh *fssz.Hasher) error {
+	root, err := s.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	hh.PutBytes(root[:])
+	return nil
+}
+
+// HashTreeRootWith appends attester slashing root to the provided hasher.
+func (s *AttesterSlashingElectra) HashTreeRootWith(hh *fssz.Hasher) error {
+	root, err := s.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	hh.PutBytes(root[:])
+	return nil
+}
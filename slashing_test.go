@@ -0,0 +1,106 @@
+package types
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestProposerSlashing(t *testing.T) {
+	s := &ProposerSlashing{SignedHeader1: *testBlockHeader(), SignedHeader2: *testBlockHeader()}
+	s.SignedHeader2.Message.BodyRoot = BodyRoot{Root{0x04}}
+	if err := s.Validate(); err != nil {
+		t.Error(err)
+	}
+	enc, err := s.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := &ProposerSlashing{}
+	if err := decoded.UnmarshalSSZ(enc); err != nil {
+		t.Fatal(err)
+	}
+	if *decoded != *s {
+		t.Errorf("Unexpected slashing: %+v", decoded)
+	}
+
+	tests := []struct {
+		name   string
+		modify func(h *BeaconBlockHeader)
+	}{
+		{name: "equal headers", modify: func(h *BeaconBlockHeader) { h.BodyRoot = BodyRoot{Root{0x03}} }},
+		{name: "different slots", modify: func(h *BeaconBlockHeader) { h.Slot++ }},
+		{name: "different proposers", modify: func(h *BeaconBlockHeader) { h.ProposerIndex++ }},
+	}
+	for _, tt := range tests {
+		invalid := *s
+		tt.modify(&invalid.SignedHeader2.Message)
+		if err := invalid.Validate(); !errors.Is(err, ErrInvalidSlashing) {
+			t.Errorf("%s: unexpected error: %v", tt.name, err)
+		}
+	}
+}
+
+func TestAttesterSlashing(t *testing.T) {
+	a1 := testIndexedAttestation()
+	a2 := testIndexedAttestation()
+	a2.AttestingIndices = []ValidatorIndex{1, 17, 42, 100}
+	a2.Data.BeaconBlockRoot = BlockRoot{Root{0xff}}
+	s := &AttesterSlashing{Attestation1: *a1, Attestation2: *a2}
+	if err := s.Validate(); err != nil {
+		t.Error(err)
+	}
+	if indices := s.SlashableIndices(); !reflect.DeepEqual(indices, []ValidatorIndex{17, 42}) {
+		t.Errorf("Unexpected slashable indices: %v", indices)
+	}
+
+	enc, err := s.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(enc) != s.SizeSSZ() {
+		t.Errorf("Unexpected length: %d", len(enc))
+	}
+	decoded := &AttesterSlashing{}
+	if err := decoded.UnmarshalSSZ(enc); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, s) {
+		t.Errorf("Unexpected slashing: %+v", decoded)
+	}
+	enc[4] = 0xff
+	if err := decoded.UnmarshalSSZ(enc); err == nil {
+		t.Error("Expected error for invalid offset")
+	}
+
+	disjoint := *s
+	disjoint.Attestation2.AttestingIndices = []ValidatorIndex{1, 2, 100}
+	if err := disjoint.Validate(); !errors.Is(err, ErrInvalidSlashing) {
+		t.Errorf("Expected error for disjoint attesting indices, got: %v", err)
+	}
+	s.Attestation2.Data = s.Attestation1.Data
+	if err := s.Validate(); !errors.Is(err, ErrInvalidSlashing) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+
+	electra := &AttesterSlashingElectra{
+		Attestation1: IndexedAttestationElectra(*a1),
+		Attestation2: IndexedAttestationElectra(*a2),
+	}
+	if err := electra.Validate(); err != nil {
+		t.Error(err)
+	}
+	electra.Attestation1.AttestingIndices = []ValidatorIndex{2, 3}
+	if err := electra.Validate(); !errors.Is(err, ErrInvalidSlashing) {
+		t.Errorf("Expected error for empty intersection, got: %v", err)
+	}
+	electra.Attestation1.AttestingIndices = a1.AttestingIndices
+	enc, err = electra.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decodedElectra := &AttesterSlashingElectra{}
+	if err := decodedElectra.UnmarshalSSZ(enc); err != nil || !reflect.DeepEqual(decodedElectra, electra) {
+		t.Errorf("Unexpected slashing: %+v, %v", decodedElectra, err)
+	}
+}
@@ -0,0 +1,58 @@
+package types
+
+import "unsafe"
+
+// CastSlice reinterprets slice of one uint64-backed type as another without copying.
+// This is safe, as all such types share the memory layout of uint64. The result aliases values:
+// modifications are visible through both slices.
+func CastSlice[To, From Uint64Like](values []From) []To {
+	if values == nil {
+		return nil
+	}
+	if len(values) == 0 {
+		return []To{}
+	}
+	return unsafe.Slice((*To)(unsafe.Pointer(&values[0])), len(values))
+}
+
+// ConvertSlice copies slice of one uint64-backed type into a new slice of another.
+func ConvertSlice[To, From Uint64Like](values []From) []To {
+	if values == nil {
+		return nil
+	}
+	converted := make([]To, len(values))
+	for i, v := range values {
+		converted[i] = To(v)
+	}
+	return converted
+}
+
+// SlotsToUint64s returns slots as []uint64 without copying, see CastSlice.
+func SlotsToUint64s(slots []Slot) []uint64 {
+	return CastSlice[uint64](slots)
+}
+
+// Uint64sToSlots returns values as []Slot without copying, see CastSlice.
+func Uint64sToSlots(values []uint64) []Slot {
+	return CastSlice[Slot](values)
+}
+
+// EpochsToUint64s returns epochs as []uint64 without copying, see CastSlice.
+func EpochsToUint64s(epochs []Epoch) []uint64 {
+	return CastSlice[uint64](epochs)
+}
+
+// Uint64sToEpochs returns values as []Epoch without copying, see CastSlice.
+func Uint64sToEpochs(values []uint64) []Epoch {
+	return CastSlice[Epoch](values)
+}
+
+// ValidatorIndicesToUint64s returns indices as []uint64 without copying, see CastSlice.
+func ValidatorIndicesToUint64s(indices []ValidatorIndex) []uint64 {
+	return CastSlice[uint64](indices)
+}
+
+// Uint64sToValidatorIndices returns values as []ValidatorIndex without copying, see CastSlice.
+func Uint64sToValidatorIndices(values []uint64) []ValidatorIndex {
+	return CastSlice[ValidatorIndex](values)
+}
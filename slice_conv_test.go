@@ -0,0 +1,46 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCastSlice(t *testing.T) {
+	slots := []Slot{1, 2, 3}
+	raw := SlotsToUint64s(slots)
+	if !reflect.DeepEqual(raw, []uint64{1, 2, 3}) {
+		t.Errorf("Unexpected values: %v", raw)
+	}
+	raw[0] = 100
+	if slots[0] != 100 {
+		t.Error("Cast slice should alias the original")
+	}
+	if epochs := Uint64sToEpochs(raw); cap(epochs) != cap(slots) || epochs[2] != 3 {
+		t.Errorf("Unexpected epochs: %v", epochs)
+	}
+
+	if Uint64sToSlots(nil) != nil {
+		t.Error("Nil slice should stay nil")
+	}
+	if empty := ValidatorIndicesToUint64s([]ValidatorIndex{}); empty == nil || len(empty) != 0 {
+		t.Error("Empty slice should stay empty and non-nil")
+	}
+}
+
+func TestConvertSlice(t *testing.T) {
+	indices := []ValidatorIndex{5, 6}
+	converted := ConvertSlice[uint64](indices)
+	converted[0] = 0
+	if indices[0] != 5 {
+		t.Error("Converted slice should not alias the original")
+	}
+	if ConvertSlice[Slot]([]uint64(nil)) != nil {
+		t.Error("Nil slice should stay nil")
+	}
+	if back := Uint64sToValidatorIndices(converted); !reflect.DeepEqual(back, []ValidatorIndex{0, 6}) {
+		t.Errorf("Unexpected indices: %v", back)
+	}
+	if got := EpochsToUint64s([]Epoch{9}); got[0] != 9 {
+		t.Errorf("Unexpected values: %v", got)
+	}
+}
@@ -0,0 +1,15 @@
+package types
+
+import "context"
+
+// WithSlotDeadline returns context expiring at the end of the slot (i.e. at the start of the next one).
+func WithSlotDeadline(ctx context.Context, clock *Clock, slot Slot) (context.Context, context.CancelFunc) {
+	return context.WithDeadline(ctx, clock.SlotStart(slot.Add(1)))
+}
+
+// WithIntoSlotDeadline returns context expiring at the given fraction of the current wall-clock slot,
+// e.g. 1/3 for duties that must be done by the attestation deadline. If that time has already passed,
+// the returned context is done. Panics if fraction is not within [0, 1].
+func WithIntoSlotDeadline(ctx context.Context, clock *Clock, fraction float64) (context.Context, context.CancelFunc) {
+	return context.WithDeadline(ctx, clock.IntoSlot(clock.CurrentSlot(), fraction))
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestWithSlotDeadline(t *testing.T) {
+	genesis := time.Now().Add(-time.Hour)
+	clock := NewClock(genesis, &ChainSpec{SlotsPerEpoch: 32, SecondsPerSlot: 12})
+	ctx, cancel := WithSlotDeadline(context.Background(), clock, 10)
+	defer cancel()
+	deadline, ok := ctx.Deadline()
+	if !ok || !deadline.Equal(genesis.Add(11*12*time.Second)) {
+		t.Errorf("Unexpected deadline: %v", deadline)
+	}
+	if ctx.Err() == nil {
+		t.Error("Context of a past slot is not done")
+	}
+
+	current := clock.CurrentSlot()
+	ctx, cancel = WithSlotDeadline(context.Background(), clock, current+1)
+	defer cancel()
+	if ctx.Err() != nil {
+		t.Errorf("Context of a future slot is done: %v", ctx.Err())
+	}
+}
+
+func TestWithIntoSlotDeadline(t *testing.T) {
+	genesis := time.Date(2020, 12, 1, 12, 0, 23, 0, time.UTC)
+	now := genesis.Add(100*12*time.Second + time.Second)
+	clock := NewClock(genesis, &ChainSpec{SlotsPerEpoch: 32, SecondsPerSlot: 12}).WithNow(func() time.Time { return now })
+	ctx, cancel := WithIntoSlotDeadline(context.Background(), clock, 1.0/3)
+	defer cancel()
+	deadline, ok := ctx.Deadline()
+	if !ok || !deadline.Equal(genesis.Add(100*12*time.Second+4*time.Second)) {
+		t.Errorf("Unexpected deadline: %v", deadline)
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Expected panic on invalid fraction")
+		}
+	}()
+	clock.IntoSlot(0, 1.5)
+}
@@ -0,0 +1,112 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/farazdagi/prysm-shared-types/mathutil"
+)
+
+// ParseSlotDuration parses span expressed in slots ("64 slots"), epochs ("2 epochs") or wall time
+// ("90m", "1h30m", see time.ParseDuration), returning the number of slots. Bare numbers are
+// interpreted as slots, wall time is rounded up to the whole number of slots.
+func ParseSlotDuration(s string, spec *ChainSpec) (Slot, error) {
+	s = strings.TrimSpace(s)
+	number := strings.TrimRightFunc(s, func(r rune) bool {
+		return r < '0' || r > '9'
+	})
+	unit := strings.ToLower(strings.TrimSpace(s[len(number):]))
+	switch unit {
+	case "", "slot", "slots", "epoch", "epochs":
+		n, err := strconv.ParseUint(number, 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid slot duration %q", s)
+		}
+		if strings.HasPrefix(unit, "epoch") {
+			return mathutil.SafeMul(Slot(n), spec.SlotsPerEpoch)
+		}
+		return Slot(n), nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf("invalid slot duration %q", s)
+	}
+	return DurationToSlots(d, spec, RoundUp), nil
+}
+
+// FormatSlotDuration renders number of slots in the form accepted by ParseSlotDuration, using
+// epochs whenever slots make up a whole number of epochs (e.g. "2 epochs", "1 slot").
+func FormatSlotDuration(slots Slot, spec *ChainSpec) string {
+	if slots != 0 && spec.SlotsPerEpoch != 0 && slots.ModSlot(spec.SlotsPerEpoch) == 0 {
+		return pluralize(uint64(slots.DivSlot(spec.SlotsPerEpoch)), "epoch")
+	}
+	return pluralize(uint64(slots), "slot")
+}
+
+func pluralize(n uint64, unit string) string {
+	if n == 1 {
+		return "1 " + unit
+	}
+	return strconv.FormatUint(n, 10) + " " + unit + "s"
+}
+
+// Rounding specifies how durations not spanning a whole number of slots are converted.
+type Rounding uint8
+
+// Supported rounding modes.
+const (
+	RoundDown Rounding = iota
+	RoundUp
+	RoundNearest
+)
+
+// DurationToSlots returns number of slots spanning the duration, negative durations yield zero.
+func DurationToSlots(d time.Duration, spec *ChainSpec, mode Rounding) Slot {
+	if d <= 0 {
+		return 0
+	}
+	perSlot := time.Duration(spec.SecondsPerSlot) * time.Second
+	slots, rem := Slot(d/perSlot), d%perSlot
+	switch {
+	case mode == RoundUp && rem > 0, mode == RoundNearest && rem >= perSlot-rem:
+		slots++
+	}
+	return slots
+}
+
+// DurationToEpochs returns number of epochs spanning the duration, negative durations yield zero.
+func DurationToEpochs(d time.Duration, spec *ChainSpec, mode Rounding) Epoch {
+	if d <= 0 {
+		return 0
+	}
+	perEpoch := time.Duration(spec.SecondsPerSlot) * time.Duration(spec.SlotsPerEpoch) * time.Second
+	epochs, rem := Epoch(d/perEpoch), d%perEpoch
+	switch {
+	case mode == RoundUp && rem > 0, mode == RoundNearest && rem >= perEpoch-rem:
+		epochs++
+	}
+	return epochs
+}
+
+// SlotsToDuration returns wall time spanned by the given number of slots, saturating at the
+// maximum representable duration.
+func SlotsToDuration(n Slot, spec *ChainSpec) time.Duration {
+	seconds, err := mathutil.SafeMul(uint64(n), spec.SecondsPerSlot)
+	if err != nil || seconds > uint64(math.MaxInt64/time.Second) {
+		return math.MaxInt64
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// EpochsToDuration returns wall time spanned by the given number of epochs, saturating at the
+// maximum representable duration.
+func EpochsToDuration(n Epoch, spec *ChainSpec) time.Duration {
+	slots, err := mathutil.SafeMul(Slot(n), spec.SlotsPerEpoch)
+	if err != nil {
+		return math.MaxInt64
+	}
+	return SlotsToDuration(slots, spec)
+}
@@ -0,0 +1,96 @@
+package types
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestParseSlotDuration(t *testing.T) {
+	spec := &ChainSpec{SlotsPerEpoch: 32, SecondsPerSlot: 12}
+	tests := map[string]Slot{
+		"64 slots": 64,
+		"1slot":    1,
+		"2 epochs": 64,
+		"1 Epoch":  32,
+		"100":      100,
+		"90m":      450,
+		"1h30m":    450,
+		"13s":      2,
+		"0s":       0,
+	}
+	for input, want := range tests {
+		got, err := ParseSlotDuration(input, spec)
+		if err != nil {
+			t.Errorf("Could not parse %q: %v", input, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("ParseSlotDuration(%q) = %d, want %d", input, got, want)
+		}
+	}
+
+	for _, input := range []string{"", "slots", "2 weeks", "-5m", "1.5 epochs", "18446744073709551615 epochs"} {
+		if _, err := ParseSlotDuration(input, spec); err == nil {
+			t.Errorf("Expected error for %q", input)
+		}
+	}
+}
+
+func TestFormatSlotDuration(t *testing.T) {
+	spec := &ChainSpec{SlotsPerEpoch: 32, SecondsPerSlot: 12}
+	tests := map[Slot]string{0: "0 slots", 1: "1 slot", 31: "31 slots", 32: "1 epoch", 64: "2 epochs", 65: "65 slots"}
+	for slots, want := range tests {
+		got := FormatSlotDuration(slots, spec)
+		if got != want {
+			t.Errorf("FormatSlotDuration(%d) = %q, want %q", slots, got, want)
+		}
+		if parsed, err := ParseSlotDuration(got, spec); err != nil || parsed != slots {
+			t.Errorf("Could not round trip %q: %d (%v)", got, parsed, err)
+		}
+	}
+}
+
+func TestDurationToSlots(t *testing.T) {
+	spec := &ChainSpec{SlotsPerEpoch: 32, SecondsPerSlot: 12}
+	tests := []struct {
+		d                 time.Duration
+		down, up, nearest Slot
+	}{
+		{d: -time.Second, down: 0, up: 0, nearest: 0},
+		{d: 0, down: 0, up: 0, nearest: 0},
+		{d: 5 * time.Second, down: 0, up: 1, nearest: 0},
+		{d: 6 * time.Second, down: 0, up: 1, nearest: 1},
+		{d: 24 * time.Second, down: 2, up: 2, nearest: 2},
+		{d: 25 * time.Second, down: 2, up: 3, nearest: 2},
+	}
+	for _, tt := range tests {
+		for mode, want := range map[Rounding]Slot{RoundDown: tt.down, RoundUp: tt.up, RoundNearest: tt.nearest} {
+			if got := DurationToSlots(tt.d, spec, mode); got != want {
+				t.Errorf("DurationToSlots(%v, %d) = %d, want %d", tt.d, mode, got, want)
+			}
+		}
+	}
+	if got := DurationToEpochs(7*time.Minute, spec, RoundUp); got != 2 {
+		t.Errorf("Unexpected epochs: %d", got)
+	}
+	if got := DurationToEpochs(7*time.Minute, spec, RoundDown); got != 1 {
+		t.Errorf("Unexpected epochs: %d", got)
+	}
+}
+
+func TestSlotsToDuration(t *testing.T) {
+	spec := &ChainSpec{SlotsPerEpoch: 32, SecondsPerSlot: 12}
+	if got := SlotsToDuration(5, spec); got != time.Minute {
+		t.Errorf("Unexpected duration: %v", got)
+	}
+	if got := EpochsToDuration(2, spec); got != 768*time.Second {
+		t.Errorf("Unexpected duration: %v", got)
+	}
+	if got := SlotsToDuration(math.MaxUint64/2, spec); got != math.MaxInt64 {
+		t.Errorf("Expected saturated duration, got: %v", got)
+	}
+	if got := EpochsToDuration(math.MaxUint64, spec); got != math.MaxInt64 {
+		t.Errorf("Expected saturated duration, got: %v", got)
+	}
+}
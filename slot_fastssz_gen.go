@@ -0,0 +1,17 @@
+// Code generated by typegen. DO NOT EDIT.
+
+//go:build !nofastssz
+
+package types
+
+import fssz "github.com/ferranbt/fastssz"
+
+var _ fssz.HashRoot = (Slot)(0)
+var _ fssz.Marshaler = (*Slot)(nil)
+var _ fssz.Unmarshaler = (*Slot)(nil)
+
+// HashTreeRootWith appends slot to the provided hasher.
+func (s Slot) HashTreeRootWith(hh *fssz.Hasher) error {
+	hh.PutUint64(uint64(s))
+	return nil
+}
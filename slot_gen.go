@@ -0,0 +1,268 @@
+// Code generated by typegen. DO NOT EDIT.
+
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/farazdagi/prysm-shared-types/mathutil"
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// FarFutureSlot is a slot which is never reached.
+// Increasing it using Add* and Mul* methods leaves it unchanged.
+const FarFutureSlot = Slot(1<<64 - 1)
+
+// IsFarFuture returns true if slot is equal to FarFutureSlot.
+func (s Slot) IsFarFuture() bool {
+	return s == FarFutureSlot
+}
+
+// IsZero returns true if slot has zero value.
+func (s Slot) IsZero() bool {
+	return s == 0
+}
+
+// Add increases slot by x, panics on overflow.
+func (s Slot) Add(x uint64) Slot {
+	return s.AddSlot(Slot(x))
+}
+
+// AddSlot increases slot by another slot, panics on overflow.
+func (s Slot) AddSlot(x Slot) Slot {
+	if s.IsFarFuture() {
+		return s
+	}
+	return mathutil.Add(s, x)
+}
+
+// SafeAdd increases slot by x, returns an error on overflow.
+func (s Slot) SafeAdd(x uint64) (Slot, error) {
+	if s.IsFarFuture() {
+		return s, nil
+	}
+	return mathutil.SafeAdd(s, Slot(x))
+}
+
+// Sub subtracts x from the slot, panics on underflow.
+func (s Slot) Sub(x uint64) Slot {
+	return s.SubSlot(Slot(x))
+}
+
+// SubSlot finds difference between two slot values, panics on underflow.
+func (s Slot) SubSlot(x Slot) Slot {
+	return mathutil.Sub(s, x)
+}
+
+// SafeSub subtracts x from the slot, returns an error on underflow.
+func (s Slot) SafeSub(x uint64) (Slot, error) {
+	return mathutil.SafeSub(s, Slot(x))
+}
+
+// AddInt shifts slot by signed offset x, returns an error if result overflows or goes below zero.
+func (s Slot) AddInt(x int64) (Slot, error) {
+	if x >= 0 && s.IsFarFuture() {
+		return s, nil
+	}
+	return mathutil.SafeAddInt(s, x)
+}
+
+// Mul multiplies slot by x, panics on overflow.
+func (s Slot) Mul(x uint64) Slot {
+	return s.MulSlot(Slot(x))
+}
+
+// MulSlot multiplies slot by another slot, panics on overflow.
+func (s Slot) MulSlot(x Slot) Slot {
+	if s.IsFarFuture() {
+		return s
+	}
+	return mathutil.Mul(s, x)
+}
+
+// SafeMul multiplies slot by x, returns an error on overflow.
+func (s Slot) SafeMul(x uint64) (Slot, error) {
+	if s.IsFarFuture() {
+		return s, nil
+	}
+	return mathutil.SafeMul(s, Slot(x))
+}
+
+// Div divides slot by x, panics if x is zero.
+func (s Slot) Div(x uint64) Slot {
+	return s.DivSlot(Slot(x))
+}
+
+// DivSlot divides slot by another slot, panics if x is zero.
+func (s Slot) DivSlot(x Slot) Slot {
+	return mathutil.Div(s, x)
+}
+
+// SafeDiv divides slot by x, returns an error if x is zero.
+func (s Slot) SafeDiv(x uint64) (Slot, error) {
+	return mathutil.SafeDiv(s, Slot(x))
+}
+
+// Mod returns result of `slot % x`, panics if x is zero.
+func (s Slot) Mod(x uint64) Slot {
+	return s.ModSlot(Slot(x))
+}
+
+// ModSlot returns result of `slot % slot`, panics if x is zero.
+func (s Slot) ModSlot(x Slot) Slot {
+	return mathutil.Mod(s, x)
+}
+
+// SafeMod returns result of `slot % x`, returns an error if x is zero.
+func (s Slot) SafeMod(x uint64) (Slot, error) {
+	return mathutil.SafeMod(s, Slot(x))
+}
+
+// Compare returns an integer comparing two slot values (-1, 0 or +1).
+func (s Slot) Compare(x Slot) int {
+	switch {
+	case s < x:
+		return -1
+	case s > x:
+		return 1
+	}
+	return 0
+}
+
+// IsAfter returns true if slot is strictly greater than x.
+func (s Slot) IsAfter(x Slot) bool {
+	return s > x
+}
+
+// IsBefore returns true if slot is strictly less than x.
+func (s Slot) IsBefore(x Slot) bool {
+	return s < x
+}
+
+// WithinN returns true if slot is at most n away from x (in either direction).
+func (s Slot) WithinN(x Slot, n uint64) bool {
+	if s > x {
+		return uint64(s-x) <= n
+	}
+	return uint64(x-s) <= n
+}
+
+// String returns decimal representation of the slot.
+func (s Slot) String() string {
+	return strconv.FormatUint(uint64(s), 10)
+}
+
+// PaddedString returns decimal representation of the slot, left-padded with zeros to width digits,
+// so that values sort lexicographically (e.g. in file names and object keys). Wider values are not truncated.
+func (s Slot) PaddedString(width int) string {
+	return string(s.AppendPadded(nil, width))
+}
+
+// AppendPadded appends zero-padded decimal representation of the slot to dst, see PaddedString.
+func (s Slot) AppendPadded(dst []byte, width int) []byte {
+	var digits [20]byte
+	b := strconv.AppendUint(digits[:0], uint64(s), 10)
+	for i := len(b); i < width; i++ {
+		dst = append(dst, '0')
+	}
+	return append(dst, b...)
+}
+
+// MarshalText encodes slot as a decimal string.
+func (s Slot) MarshalText() ([]byte, error) {
+	return strconv.AppendUint(nil, uint64(s), 10), nil
+}
+
+// UnmarshalText decodes slot from a decimal string.
+func (s *Slot) UnmarshalText(data []byte) error {
+	parsed, err := strconv.ParseUint(string(data), 10, 64)
+	if err != nil {
+		return fmt.Errorf("could not parse slot: %w", err)
+	}
+	*s = Slot(parsed)
+	return nil
+}
+
+// MarshalJSON encodes slot as a quoted decimal string (as expected by the Beacon API).
+func (s Slot) MarshalJSON() ([]byte, error) {
+	data := make([]byte, 0, 22)
+	data = append(data, '"')
+	data = strconv.AppendUint(data, uint64(s), 10)
+	return append(data, '"'), nil
+}
+
+// UnmarshalJSON decodes slot from either a quoted decimal string or a JSON number.
+func (s *Slot) UnmarshalJSON(data []byte) error {
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		data = data[1 : len(data)-1]
+	}
+	return s.UnmarshalText(data)
+}
+
+// HashTreeRoot returns calculated hash root.
+// Root of a basic uint64 value is its little-endian encoding padded to 32 bytes, so no hashing is involved.
+func (s Slot) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	binary.LittleEndian.PutUint64(root[:8], uint64(s))
+	return root, nil
+}
+
+// HashTreeRootInto writes hash root into dst, avoiding copying of the returned array.
+func (s Slot) HashTreeRootInto(dst *[32]byte) error {
+	*dst = [32]byte{}
+	binary.LittleEndian.PutUint64(dst[:8], uint64(s))
+	return nil
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the slot object.
+func (s *Slot) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), s.SizeSSZ()); err != nil {
+		return err
+	}
+	*s = Slot(binary.LittleEndian.Uint64(buf))
+	return nil
+}
+
+// MarshalSSZTo marshals slot with the provided byte slice.
+func (s *Slot) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return s.AppendSSZ(dst), nil
+}
+
+// MarshalSSZ marshals slot into a serialized object.
+func (s *Slot) MarshalSSZ() ([]byte, error) {
+	return s.AppendSSZ(make([]byte, 0, 8)), nil
+}
+
+// AppendSSZ appends serialized slot to dst, allocating only if dst has no spare capacity.
+func (s Slot) AppendSSZ(dst []byte) []byte {
+	return append(dst, byte(s), byte(s>>8), byte(s>>16), byte(s>>24),
+		byte(s>>32), byte(s>>40), byte(s>>48), byte(s>>56))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (s *Slot) SizeSSZ() int {
+	return 8
+}
+
+// WriteTo writes SSZ serialized slot to w.
+func (s Slot) WriteTo(w io.Writer) (int64, error) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], uint64(s))
+	n, err := w.Write(buf[:])
+	return int64(n), err
+}
+
+// ReadFrom reads SSZ serialized slot from r.
+// Exactly 8 bytes are consumed, so values can be read one after another from the same stream.
+func (s *Slot) ReadFrom(r io.Reader) (int64, error) {
+	var buf [8]byte
+	n, err := io.ReadFull(r, buf[:])
+	if err != nil {
+		return int64(n), err
+	}
+	*s = Slot(binary.LittleEndian.Uint64(buf[:]))
+	return int64(n), nil
+}
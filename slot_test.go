@@ -3,6 +3,8 @@ package types
 import (
 	"testing"
 	"time"
+
+	"github.com/farazdagi/prysm-shared-types/mathutil"
 )
 
 func TestSlot_Casting(t *testing.T) {
@@ -34,3 +36,72 @@ func TestSlot_Casting(t *testing.T) {
 	})
 }
 
+func TestSlot_ArithmeticPanics(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   func()
+	}{
+		{name: "add overflow", fn: func() { Slot(1<<64 - 2).Add(2) }},
+		{name: "mul overflow", fn: func() { Slot(1 << 63).Mul(2) }},
+		{name: "sub underflow", fn: func() { Slot(1).SubSlot(2) }},
+		{name: "div by zero", fn: func() { Slot(1).DivEpoch(0) }},
+		{name: "mod by zero", fn: func() { Slot(1).ModSlot(0) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if recover() == nil {
+					t.Error("Expected panic")
+				}
+			}()
+			tt.fn()
+		})
+	}
+}
+
+func TestSlot_PanicFreeArithmetic(t *testing.T) {
+	var reported int
+	mathutil.SetErrorHook(func(error) { reported++ })
+	defer mathutil.SetErrorHook(nil)
+
+	if got := Slot(1<<64 - 2).Add(2); got != 1<<64-1 {
+		t.Errorf("Unexpected saturated sum: %v", got)
+	}
+	if got := Slot(1).SubSlot(2); got != 0 {
+		t.Errorf("Unexpected saturated difference: %v", got)
+	}
+	if got := MulDiv(Epoch(1), 1, 0); got != 0 {
+		t.Errorf("Unexpected MulDiv result: %v", got)
+	}
+	if got := CeilDiv(Slot(1), 0); got != 0 {
+		t.Errorf("Unexpected CeilDiv result: %v", got)
+	}
+	if reported != 4 {
+		t.Errorf("Unexpected number of reported errors: %d", reported)
+	}
+}
+
+func TestSlot_PaddedString(t *testing.T) {
+	tests := []struct {
+		slot  Slot
+		width int
+		want  string
+	}{
+		{slot: 0, width: 5, want: "00000"},
+		{slot: 123, width: 5, want: "00123"},
+		{slot: 123456, width: 5, want: "123456"},
+		{slot: 7, width: 0, want: "7"},
+		{slot: FarFutureSlot, width: MaxDecimalWidth, want: "18446744073709551615"},
+	}
+	for _, tt := range tests {
+		if got := tt.slot.PaddedString(tt.width); got != tt.want {
+			t.Errorf("PaddedString(%d, %d) = %q, want %q", tt.slot, tt.width, got, tt.want)
+		}
+	}
+	if got := string(Epoch(42).AppendPadded([]byte("epoch-"), 4)); got != "epoch-0042" {
+		t.Errorf("Unexpected output: %s", got)
+	}
+	if Slot(99).PaddedString(MaxDecimalWidth) >= Slot(100).PaddedString(MaxDecimalWidth) {
+		t.Error("Padded values should sort lexicographically")
+	}
+}
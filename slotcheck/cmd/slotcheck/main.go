@@ -0,0 +1,19 @@
+// Command slotcheck reports suspicious mixing of Slot and Epoch values in packages using shared types,
+// see package slotcheck for the list of checks.
+//
+// Usage:
+//
+//	go run github.com/farazdagi/prysm-shared-types/slotcheck/cmd/slotcheck@latest ./beacon-chain/... ./validator
+//
+// Arguments are package patterns, as accepted by the go command. The command exits with non-zero
+// status if anything is reported. Use cmd/slotvet to run the checks with `go vet -vettool`.
+package main
+
+import (
+	"github.com/farazdagi/prysm-shared-types/slotcheck"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(slotcheck.Analyzer)
+}
@@ -0,0 +1,14 @@
+// Command slotvet runs slotcheck analyzer as a vet tool:
+//
+//	go install github.com/farazdagi/prysm-shared-types/slotcheck/cmd/slotvet@latest
+//	go vet -vettool=$(which slotvet) ./...
+package main
+
+import (
+	"github.com/farazdagi/prysm-shared-types/slotcheck"
+	"golang.org/x/tools/go/analysis/unitchecker"
+)
+
+func main() {
+	unitchecker.Main(slotcheck.Analyzer)
+}
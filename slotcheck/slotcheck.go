@@ -0,0 +1,211 @@
+// Package slotcheck defines an analyzer reporting suspicious mixing of Slot and Epoch values in
+// packages using shared types: conversions between the two, arithmetic on their integer conversions,
+// hardcoded slots per epoch and integer literals assigned to slot and epoch variables. The whole
+// point of the newtypes is that such mixing is explicit and spec-aware.
+//
+// The analyzer is run by cmd/slotcheck (standalone) and cmd/slotvet (with `go vet -vettool`). The
+// package is a separate module, so that the types module keeps its Go version and doesn't depend on
+// golang.org/x/tools.
+package slotcheck
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+// typesPkgPath is import path of the package declaring Slot and Epoch.
+const typesPkgPath = "github.com/farazdagi/prysm-shared-types"
+
+// Analyzer reports Slot/Epoch mixing:
+//   - conversions between Slot and Epoch (directly or through an integer type);
+//   - arithmetic and comparisons on operands converted to integers from Slot and Epoch respectively;
+//   - multiplication, division and remainder of Slot or Epoch by integer literals, which usually
+//     hardcode slots per epoch instead of using ChainSpec;
+//   - integer literals (other than 0 and 1) assigned to variables and struct fields of Slot or Epoch
+//     type outside of test files, which usually hardcode network specific values (constant
+//     declarations are fine, they name the value).
+//
+// The types package itself is exempt, as it implements the conversions.
+var Analyzer = &analysis.Analyzer{
+	Name:     "slotcheck",
+	Doc:      "report suspicious mixing of Slot and Epoch values",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+func run(pass *analysis.Pass) (interface{}, error) {
+	if pass.Pkg.Path() == typesPkgPath {
+		return nil, nil
+	}
+	nodes := []ast.Node{
+		(*ast.CallExpr)(nil),
+		(*ast.BinaryExpr)(nil),
+		(*ast.AssignStmt)(nil),
+		(*ast.GenDecl)(nil),
+		(*ast.KeyValueExpr)(nil),
+	}
+	pass.ResultOf[inspect.Analyzer].(*inspector.Inspector).Preorder(nodes, func(n ast.Node) {
+		switch n := n.(type) {
+		case *ast.CallExpr:
+			to := conversionTarget(pass.TypesInfo, n)
+			if to == "" {
+				return
+			}
+			if from := underlyingTimeType(pass.TypesInfo, n.Args[0]); from != "" && from != to {
+				pass.Reportf(n.Pos(), "conversion of %s to %s, use explicit spec-aware helpers (e.g. Epoch.StartSlot, Slot.ToEpoch)", from, to)
+			}
+		case *ast.BinaryExpr:
+			checkBinary(pass, n)
+		case *ast.AssignStmt:
+			if len(n.Lhs) == len(n.Rhs) {
+				for i := range n.Lhs {
+					checkLiteral(pass, pass.TypesInfo.TypeOf(n.Lhs[i]), n.Rhs[i])
+				}
+			}
+		case *ast.GenDecl:
+			if n.Tok != token.VAR {
+				return
+			}
+			for _, spec := range n.Specs {
+				spec := spec.(*ast.ValueSpec)
+				for i, name := range spec.Names {
+					if i < len(spec.Values) {
+						checkLiteral(pass, pass.TypesInfo.TypeOf(name), spec.Values[i])
+					}
+				}
+			}
+		case *ast.KeyValueExpr:
+			if key, ok := n.Key.(*ast.Ident); ok {
+				if field, ok := pass.TypesInfo.Uses[key].(*types.Var); ok && field.IsField() {
+					checkLiteral(pass, field.Type(), n.Value)
+				}
+			}
+		}
+	})
+	return nil, nil
+}
+
+func checkBinary(pass *analysis.Pass, n *ast.BinaryExpr) {
+	x, y := underlyingTimeType(pass.TypesInfo, n.X), underlyingTimeType(pass.TypesInfo, n.Y)
+	if x != "" && y != "" && x != y {
+		pass.Reportf(n.OpPos, "%s %s %s mixes slot and epoch values", x, n.Op, y)
+		return
+	}
+	switch n.Op {
+	case token.MUL, token.QUO, token.REM:
+	default:
+		return
+	}
+	for _, pair := range [][2]ast.Expr{{n.X, n.Y}, {n.Y, n.X}} {
+		name := timeTypeName(pass.TypesInfo.TypeOf(pair[0]))
+		if name == "" {
+			continue
+		}
+		if lit, v, ok := intLiteral(pass.TypesInfo, pair[1]); ok && v > 1 {
+			pass.Reportf(lit.Pos(), "%s %s literal %s, use ChainSpec values instead of hardcoded constants", name, n.Op, lit.Value)
+		}
+	}
+}
+
+// checkLiteral reports integer literal (possibly converted, e.g. Slot(32)) stored into a slot or
+// epoch destination outside of test files.
+func checkLiteral(pass *analysis.Pass, dst types.Type, value ast.Expr) {
+	name := timeTypeName(dst)
+	if name == "" || strings.HasSuffix(pass.Fset.File(value.Pos()).Name(), "_test.go") {
+		return
+	}
+	if lit, v, ok := intLiteral(pass.TypesInfo, value); ok && v > 1 {
+		pass.Reportf(lit.Pos(), "literal %s assigned to %s, derive it from ChainSpec or use a named constant", lit.Value, name)
+	}
+}
+
+// intLiteral returns integer literal the expression consists of, looking through parentheses and
+// conversions (e.g. Slot(32) or uint64(32)), together with its value.
+func intLiteral(info *types.Info, expr ast.Expr) (*ast.BasicLit, uint64, bool) {
+	for {
+		expr = unparen(expr)
+		call, ok := expr.(*ast.CallExpr)
+		if !ok || len(call.Args) != 1 {
+			break
+		}
+		if tv, ok := info.Types[call.Fun]; !ok || !tv.IsType() {
+			return nil, 0, false
+		}
+		expr = call.Args[0]
+	}
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.INT {
+		return nil, 0, false
+	}
+	v, ok := constant.Uint64Val(constant.MakeFromLiteral(lit.Value, lit.Kind, 0))
+	return lit, v, ok
+}
+
+// conversionTarget returns name of the time type (Slot or Epoch) call expression converts to.
+func conversionTarget(info *types.Info, call *ast.CallExpr) string {
+	if len(call.Args) != 1 {
+		return ""
+	}
+	tv, ok := info.Types[call.Fun]
+	if !ok || !tv.IsType() {
+		return ""
+	}
+	return timeTypeName(tv.Type)
+}
+
+// underlyingTimeType returns name of the time type of the expression, looking through conversions
+// to integer types (e.g. uint64(slot)).
+func underlyingTimeType(info *types.Info, expr ast.Expr) string {
+	for {
+		expr = unparen(expr)
+		if name := timeTypeName(info.TypeOf(expr)); name != "" {
+			return name
+		}
+		call, ok := expr.(*ast.CallExpr)
+		if !ok || len(call.Args) != 1 {
+			return ""
+		}
+		tv, ok := info.Types[call.Fun]
+		if !ok || !tv.IsType() {
+			return ""
+		}
+		if basic, ok := tv.Type.Underlying().(*types.Basic); !ok || basic.Info()&types.IsInteger == 0 {
+			return ""
+		}
+		expr = call.Args[0]
+	}
+}
+
+// timeTypeName returns "Slot" or "Epoch" if t is one of these types, empty string otherwise.
+func timeTypeName(t types.Type) string {
+	named, ok := t.(*types.Named)
+	if !ok {
+		return ""
+	}
+	obj := named.Obj()
+	if obj.Pkg() == nil || obj.Pkg().Path() != typesPkgPath {
+		return ""
+	}
+	if name := obj.Name(); name == "Slot" || name == "Epoch" {
+		return name
+	}
+	return ""
+}
+
+// unparen strips enclosing parentheses.
+func unparen(expr ast.Expr) ast.Expr {
+	for {
+		paren, ok := expr.(*ast.ParenExpr)
+		if !ok {
+			return expr
+		}
+		expr = paren.X
+	}
+}
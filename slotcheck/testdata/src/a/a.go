@@ -0,0 +1,34 @@
+package a
+
+import types "github.com/farazdagi/prysm-shared-types"
+
+func mixing(s types.Slot, e types.Epoch, spec *types.ChainSpec) {
+	_ = types.Slot(e) + s         // want `conversion of Epoch to Slot`
+	_ = types.Epoch(uint64(s))    // want `conversion of Slot to Epoch`
+	_ = uint64(s) + uint64(e)     // want `Slot \+ Epoch mixes slot and epoch values`
+	_ = uint64(s) < uint64(e)     // want `Slot < Epoch mixes slot and epoch values`
+	_ = s / 32                    // want `Slot / literal 32`
+	_ = 32 * e                    // want `Epoch \* literal 32`
+	_ = s.ToEpoch(spec) + e       // ok
+	_ = e.StartSlot(spec) + s     // ok
+	_ = s * 1                     // ok
+	_ = uint64(s) / 32            // ok, not a slot anymore
+	_ = types.Slot(uint64(s) + 1) // ok
+}
+
+const capellaEpoch = 194048
+
+const denebEpoch types.Epoch = 269568 // ok, named constant
+
+var electraEpoch types.Epoch = 364032 // want `literal 364032 assigned to Epoch`
+
+func literals(spec *types.ChainSpec) types.Checkpoint {
+	var s types.Slot = 32    // want `literal 32 assigned to Slot`
+	e := types.Epoch(194048) // want `literal 194048 assigned to Epoch`
+	s = (64)                 // want `literal 64 assigned to Slot`
+	e = capellaEpoch         // ok, named constant
+	s, e = 0, 1              // ok
+	var n uint64 = 32        // ok
+	_, _, _ = s, e, n
+	return types.Checkpoint{Epoch: 10} // want `literal 10 assigned to Epoch`
+}
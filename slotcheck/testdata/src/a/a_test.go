@@ -0,0 +1,9 @@
+package a
+
+import types "github.com/farazdagi/prysm-shared-types"
+
+func fixture() types.Checkpoint {
+	var s types.Slot = 32 // literals are fine in tests
+	_ = s / 32            // want `Slot / literal 32`
+	return types.Checkpoint{Epoch: 10}
+}
@@ -0,0 +1,18 @@
+// Package types is a stub of the shared types package.
+package types
+
+type Slot uint64
+
+type Epoch uint64
+
+type ChainSpec struct {
+	SlotsPerEpoch Slot
+}
+
+func (s Slot) ToEpoch(spec *ChainSpec) Epoch { return Epoch(s / spec.SlotsPerEpoch) }
+
+func (e Epoch) StartSlot(spec *ChainSpec) Slot { return Slot(e) * spec.SlotsPerEpoch }
+
+type Checkpoint struct {
+	Epoch Epoch
+}
@@ -0,0 +1,155 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/farazdagi/prysm-shared-types/mathutil"
+)
+
+// ErrInvalidSpec is returned when spec is missing required values.
+var ErrInvalidSpec = errors.New("invalid spec")
+
+// ChainSpec holds the chain configuration values required by spec dependent helpers.
+// Field tags follow the keys of the chain config (config.yaml and preset files).
+type ChainSpec struct {
+	// SlotsPerEpoch is the number of slots in a single epoch.
+	SlotsPerEpoch Slot `yaml:"SLOTS_PER_EPOCH"`
+	// SecondsPerSlot is the duration of a single slot, in seconds.
+	SecondsPerSlot uint64 `yaml:"SECONDS_PER_SLOT"`
+	// MinAttestationInclusionDelay is the minimum number of slots between attestation and its inclusion.
+	MinAttestationInclusionDelay Slot `yaml:"MIN_ATTESTATION_INCLUSION_DELAY"`
+
+	// EffectiveBalanceIncrement is the granularity of effective balances.
+	EffectiveBalanceIncrement Gwei `yaml:"EFFECTIVE_BALANCE_INCREMENT"`
+	// MaxEffectiveBalance is the upper bound of effective balance.
+	MaxEffectiveBalance Gwei `yaml:"MAX_EFFECTIVE_BALANCE"`
+	// HysteresisQuotient divides EffectiveBalanceIncrement into hysteresis steps.
+	HysteresisQuotient uint64 `yaml:"HYSTERESIS_QUOTIENT"`
+	// HysteresisDownwardMultiplier is the number of steps balance must drop below effective balance to update it.
+	HysteresisDownwardMultiplier uint64 `yaml:"HYSTERESIS_DOWNWARD_MULTIPLIER"`
+	// HysteresisUpwardMultiplier is the number of steps balance must exceed effective balance to update it.
+	HysteresisUpwardMultiplier uint64 `yaml:"HYSTERESIS_UPWARD_MULTIPLIER"`
+
+	// MinPerEpochChurnLimit is the lower bound of validator churn limit.
+	MinPerEpochChurnLimit uint64 `yaml:"MIN_PER_EPOCH_CHURN_LIMIT"`
+	// ChurnLimitQuotient divides active validator count (or balance) to obtain churn limit.
+	ChurnLimitQuotient uint64 `yaml:"CHURN_LIMIT_QUOTIENT"`
+	// MaxPerEpochActivationChurnLimit caps the number of validators activated per epoch (Deneb).
+	MaxPerEpochActivationChurnLimit uint64 `yaml:"MAX_PER_EPOCH_ACTIVATION_CHURN_LIMIT"`
+	// MinPerEpochChurnLimitElectra is the lower bound of balance churn limit (Electra).
+	MinPerEpochChurnLimitElectra Gwei `yaml:"MIN_PER_EPOCH_CHURN_LIMIT_ELECTRA"`
+	// MaxPerEpochActivationExitChurnLimit caps the balance activated or exited per epoch (Electra).
+	MaxPerEpochActivationExitChurnLimit Gwei `yaml:"MAX_PER_EPOCH_ACTIVATION_EXIT_CHURN_LIMIT"`
+
+	// SyncCommitteeSize is the number of validators in the sync committee.
+	SyncCommitteeSize uint64 `yaml:"SYNC_COMMITTEE_SIZE"`
+	// SyncCommitteeSubnetCount is the number of sync committee gossip subnets.
+	SyncCommitteeSubnetCount uint64 `yaml:"SYNC_COMMITTEE_SUBNET_COUNT"`
+	// EpochsPerSyncCommitteePeriod is the number of epochs a sync committee serves for.
+	EpochsPerSyncCommitteePeriod Epoch `yaml:"EPOCHS_PER_SYNC_COMMITTEE_PERIOD"`
+
+	// MinValidatorWithdrawabilityDelay is the number of epochs between validator exit and withdrawability.
+	MinValidatorWithdrawabilityDelay Epoch `yaml:"MIN_VALIDATOR_WITHDRAWABILITY_DELAY"`
+	// MaxDeposits is the maximum number of deposits per block.
+	MaxDeposits uint64 `yaml:"MAX_DEPOSITS"`
+	// SafetyDecay is the maximum tolerated loss of safety (in percent) of weak subjectivity period.
+	SafetyDecay uint64 `yaml:"SAFETY_DECAY"`
+
+	// MinSeedLookahead is the number of epochs seed is known in advance of its use.
+	MinSeedLookahead Epoch `yaml:"MIN_SEED_LOOKAHEAD"`
+	// MaxSeedLookahead is the number of epochs activations and exits are delayed by.
+	MaxSeedLookahead Epoch `yaml:"MAX_SEED_LOOKAHEAD"`
+
+	// EpochsPerHistoricalVector is the length of the randao mixes vector.
+	EpochsPerHistoricalVector Epoch `yaml:"EPOCHS_PER_HISTORICAL_VECTOR"`
+
+	// SlotsPerHistoricalRoot is the length of the block_roots and state_roots vectors.
+	SlotsPerHistoricalRoot Slot `yaml:"SLOTS_PER_HISTORICAL_ROOT"`
+
+	// NumberOfColumns is the number of data columns in the extended blob matrix (PeerDAS).
+	NumberOfColumns uint64 `yaml:"NUMBER_OF_COLUMNS"`
+	// NumberOfCustodyGroups is the number of groups data columns are partitioned into for custody.
+	NumberOfCustodyGroups uint64 `yaml:"NUMBER_OF_CUSTODY_GROUPS"`
+	// BlobSidecarSubnetCount is the number of blob sidecar gossip subnets (Deneb).
+	BlobSidecarSubnetCount uint64 `yaml:"BLOB_SIDECAR_SUBNET_COUNT"`
+	// BlobSidecarSubnetCountElectra is the number of blob sidecar gossip subnets (Electra).
+	BlobSidecarSubnetCountElectra uint64 `yaml:"BLOB_SIDECAR_SUBNET_COUNT_ELECTRA"`
+	// DataColumnSidecarSubnetCount is the number of data column sidecar gossip subnets.
+	DataColumnSidecarSubnetCount uint64 `yaml:"DATA_COLUMN_SIDECAR_SUBNET_COUNT"`
+
+	// errorHook receives arithmetic errors of spec dependent helpers, nil means the process-wide
+	// mode of mathutil, see Spec.WithErrorHook. It is a pointer to keep the spec comparable.
+	errorHook *func(err error)
+}
+
+// Validate checks that values spec dependent helpers divide by are set, and that quotients of them
+// are non-zero too (so that e.g. SyncSubnet never divides by zero). Specs built for a single helper
+// may leave unrelated fields unset, but such specs are rejected by NewSpec, SpecLoader and
+// SpecSnapshot, see MainnetChainSpec and MinimalChainSpec for complete ones.
+func (s *ChainSpec) Validate() error {
+	divisors := []struct {
+		name  string
+		value uint64
+	}{
+		{"SLOTS_PER_EPOCH", uint64(s.SlotsPerEpoch)},
+		{"SECONDS_PER_SLOT", s.SecondsPerSlot},
+		{"EFFECTIVE_BALANCE_INCREMENT", uint64(s.EffectiveBalanceIncrement)},
+		{"HYSTERESIS_QUOTIENT", s.HysteresisQuotient},
+		{"CHURN_LIMIT_QUOTIENT", s.ChurnLimitQuotient},
+		{"MIN_PER_EPOCH_CHURN_LIMIT", s.MinPerEpochChurnLimit},
+		{"MAX_DEPOSITS", s.MaxDeposits},
+		{"SYNC_COMMITTEE_SIZE", s.SyncCommitteeSize},
+		{"SYNC_COMMITTEE_SUBNET_COUNT", s.SyncCommitteeSubnetCount},
+		{"EPOCHS_PER_SYNC_COMMITTEE_PERIOD", uint64(s.EpochsPerSyncCommitteePeriod)},
+		{"EPOCHS_PER_HISTORICAL_VECTOR", uint64(s.EpochsPerHistoricalVector)},
+		{"SLOTS_PER_HISTORICAL_ROOT", uint64(s.SlotsPerHistoricalRoot)},
+		{"NUMBER_OF_COLUMNS", s.NumberOfColumns},
+		{"NUMBER_OF_CUSTODY_GROUPS", s.NumberOfCustodyGroups},
+		{"BLOB_SIDECAR_SUBNET_COUNT", s.BlobSidecarSubnetCount},
+		{"BLOB_SIDECAR_SUBNET_COUNT_ELECTRA", s.BlobSidecarSubnetCountElectra},
+		{"DATA_COLUMN_SIDECAR_SUBNET_COUNT", s.DataColumnSidecarSubnetCount},
+	}
+	for _, d := range divisors {
+		if d.value == 0 {
+			return fmt.Errorf("%w: zero %s", ErrInvalidSpec, d.name)
+		}
+	}
+	if s.SyncCommitteeSubnetCount > s.SyncCommitteeSize {
+		return fmt.Errorf("%w: more sync committee subnets than members", ErrInvalidSpec)
+	}
+	if s.NumberOfCustodyGroups > s.NumberOfColumns {
+		return fmt.Errorf("%w: more custody groups than columns", ErrInvalidSpec)
+	}
+	if s.EpochsPerHistoricalVector <= s.MinSeedLookahead {
+		return fmt.Errorf("%w: historical vector is shorter than seed lookahead", ErrInvalidSpec)
+	}
+	return nil
+}
+
+// specMul returns `a * b`, overflow is handled with the error hook of the spec (see
+// Spec.WithErrorHook): it either panics, or is reported and the result saturates.
+func specMul[T Uint64Like](spec *ChainSpec, a, b T) T {
+	v, err := mathutil.SafeMul(a, b)
+	return mathutil.MustWith(spec.hook(), v, err)
+}
+
+// specDiv returns `a / b`, division by zero is handled with the error hook of the spec.
+func specDiv[T Uint64Like](spec *ChainSpec, a, b T) T {
+	v, err := mathutil.SafeDiv(a, b)
+	return mathutil.MustWith(spec.hook(), v, err)
+}
+
+// specMod returns `a % b`, division by zero is handled with the error hook of the spec.
+func specMod[T Uint64Like](spec *ChainSpec, a, b T) T {
+	v, err := mathutil.SafeMod(a, b)
+	return mathutil.MustWith(spec.hook(), v, err)
+}
+
+// hook returns error hook of the spec, nil if it is not set.
+func (s *ChainSpec) hook() func(err error) {
+	if s.errorHook == nil {
+		return nil
+	}
+	return *s.errorHook
+}
@@ -0,0 +1,127 @@
+package types
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestApplySpecOverrides(t *testing.T) {
+	base := MainnetChainSpec()
+	yamlConfig := []byte("PRESET_BASE: minimal\nSLOTS_PER_EPOCH: 8\nSECONDS_PER_SLOT: 6\n")
+	environ := []string{"PATH=/bin", "DEVNET_SECONDS_PER_SLOT=3", "DEVNET_MAX_SEED_LOOKAHEAD=2", "DEVNET_UNKNOWN=1"}
+
+	spec, err := ApplySpecOverrides(base, YAMLOverride(yamlConfig), EnvOverride("DEVNET_", environ))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := *MainnetChainSpec()
+	want.SlotsPerEpoch, want.SecondsPerSlot, want.MaxSeedLookahead = 8, 3, 2
+	if *spec != want {
+		t.Errorf("Unexpected spec: %+v", spec)
+	}
+	if base.SlotsPerEpoch != 32 {
+		t.Error("Base spec must not be modified")
+	}
+
+	tests := []struct {
+		name     string
+		override SpecOverride
+	}{
+		{"invalid yaml", YAMLOverride([]byte("SLOTS_PER_EPOCH: [1"))},
+		{"invalid env", EnvOverride("DEVNET_", []string{"DEVNET_SLOTS_PER_EPOCH=-1"})},
+		{"invalid spec", YAMLOverride([]byte("SECONDS_PER_SLOT: 0"))},
+		{"zero divisor", YAMLOverride([]byte("CHURN_LIMIT_QUOTIENT: 0"))},
+		{"too many subnets", YAMLOverride([]byte("SYNC_COMMITTEE_SUBNET_COUNT: 1024"))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ApplySpecOverrides(base, tt.override); !errors.Is(err, ErrInvalidSpec) {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestSpecLoader(t *testing.T) {
+	l, err := NewSpecLoader(MainnetChainSpec())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var notified []Slot
+	unsubscribe := l.Subscribe(func(spec *ChainSpec) {
+		notified = append(notified, spec.SlotsPerEpoch)
+	})
+
+	if _, err := l.Reload(YAMLOverride([]byte("SLOTS_PER_EPOCH: 8"))); err != nil {
+		t.Fatal(err)
+	}
+	if l.Active().SlotsPerEpoch != 8 {
+		t.Errorf("Unexpected active spec: %+v", l.Active())
+	}
+	if _, err := l.Reload(YAMLOverride([]byte("SLOTS_PER_EPOCH: 0"))); err == nil {
+		t.Error("Expected error on invalid override")
+	}
+	if l.Active().SlotsPerEpoch != 8 {
+		t.Error("Failed reload must keep active spec")
+	}
+	if _, err := l.Reload(EnvOverride("X_", []string{"X_SECONDS_PER_SLOT=6"})); err != nil {
+		t.Fatal(err)
+	}
+	want := *MainnetChainSpec()
+	want.SecondsPerSlot = 6
+	if got := *l.Active(); got != want {
+		t.Errorf("Reload must start from the base spec, got: %+v", got)
+	}
+
+	unsubscribe()
+	if _, err := l.Reload(); err != nil {
+		t.Fatal(err)
+	}
+	if len(notified) != 2 || notified[0] != 8 || notified[1] != 32 {
+		t.Errorf("Unexpected notifications: %v", notified)
+	}
+
+	if _, err := NewSpecLoader(&ChainSpec{}); !errors.Is(err, ErrInvalidSpec) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestSpecSnapshot(t *testing.T) {
+	spec := MainnetChainSpec()
+	s, err := NewSpecSnapshot(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec.SecondsPerSlot = 1
+	if s.Load().SecondsPerSlot != 12 {
+		t.Error("Snapshot must not be affected by modifications of the original spec")
+	}
+
+	var slotDuration uint64
+	unsubscribe := s.Subscribe(func(spec *ChainSpec) {
+		slotDuration = spec.SecondsPerSlot
+	})
+	prev, err := s.Swap(MinimalChainSpec())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if prev.SecondsPerSlot != 12 || s.Load().SecondsPerSlot != 6 || slotDuration != 6 {
+		t.Errorf("Unexpected swap result: previous %+v, current %+v, cached %d", prev, s.Load(), slotDuration)
+	}
+
+	if _, err := s.Swap(&ChainSpec{SlotsPerEpoch: 8}); !errors.Is(err, ErrInvalidSpec) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	unsubscribe()
+	next := MinimalChainSpec()
+	next.SecondsPerSlot = 4
+	if _, err := s.Swap(next); err != nil {
+		t.Fatal(err)
+	}
+	if s.Load().SecondsPerSlot != 4 || slotDuration != 6 {
+		t.Errorf("Unexpected spec after unsubscribe: current %+v, cached %d", s.Load(), slotDuration)
+	}
+	if _, err := NewSpecSnapshot(&ChainSpec{}); !errors.Is(err, ErrInvalidSpec) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
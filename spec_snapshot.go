@@ -0,0 +1,65 @@
+package types
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// SpecSnapshot holds a frozen ChainSpec, which hot paths can read without locking, while fork
+// transitions or overrides swap it atomically. Components caching values derived from the spec (e.g.
+// slot duration) subscribe to be notified of swaps. It is safe for concurrent use.
+type SpecSnapshot struct {
+	spec atomic.Value // *ChainSpec
+
+	lock        sync.Mutex
+	subscribers map[int]func(*ChainSpec)
+	nextID      int
+}
+
+// NewSpecSnapshot freezes a copy of the spec.
+func NewSpecSnapshot(spec *ChainSpec) (*SpecSnapshot, error) {
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+	s := &SpecSnapshot{subscribers: make(map[int]func(*ChainSpec))}
+	frozen := *spec
+	s.spec.Store(&frozen)
+	return s, nil
+}
+
+// Load returns the current spec, which must not be modified.
+func (s *SpecSnapshot) Load() *ChainSpec {
+	return s.spec.Load().(*ChainSpec)
+}
+
+// Swap freezes a copy of the spec, makes it current and notifies subscribers. Previously current spec
+// is returned. On error, the current spec is left intact.
+func (s *SpecSnapshot) Swap(spec *ChainSpec) (*ChainSpec, error) {
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+	frozen := *spec
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	prev := s.spec.Load().(*ChainSpec)
+	s.spec.Store(&frozen)
+	for _, fn := range s.subscribers {
+		fn(&frozen)
+	}
+	return prev, nil
+}
+
+// Subscribe registers fn to be called (synchronously, from Swap) with every newly current spec.
+// Returned function cancels the subscription.
+func (s *SpecSnapshot) Subscribe(fn func(*ChainSpec)) (unsubscribe func()) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	id := s.nextID
+	s.nextID++
+	s.subscribers[id] = fn
+	return func() {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		delete(s.subscribers, id)
+	}
+}
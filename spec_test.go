@@ -0,0 +1,90 @@
+package types
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestSlot_EpochBoundaries(t *testing.T) {
+	spec := &ChainSpec{SlotsPerEpoch: 32}
+	tests := []struct {
+		slot       Slot
+		start, end bool
+		since      Slot
+	}{
+		{slot: 0, start: true, end: false, since: 0},
+		{slot: 1, start: false, end: false, since: 1},
+		{slot: 31, start: false, end: true, since: 31},
+		{slot: 32, start: true, end: false, since: 0},
+		{slot: 95, start: false, end: true, since: 31},
+	}
+	for _, tt := range tests {
+		if got := tt.slot.IsEpochStart(spec); got != tt.start {
+			t.Errorf("IsEpochStart(%d) = %v, want %v", tt.slot, got, tt.start)
+		}
+		if got := tt.slot.IsEpochEnd(spec); got != tt.end {
+			t.Errorf("IsEpochEnd(%d) = %v, want %v", tt.slot, got, tt.end)
+		}
+		if got := tt.slot.SinceEpochStart(spec); got != tt.since {
+			t.Errorf("SinceEpochStart(%d) = %v, want %v", tt.slot, got, tt.since)
+		}
+	}
+}
+
+func TestSlot_ToEpoch(t *testing.T) {
+	spec := &ChainSpec{SlotsPerEpoch: 32}
+	for slot, want := range map[Slot]Epoch{0: 0, 31: 0, 32: 1, 95: 2, 96: 3} {
+		if got := slot.ToEpoch(spec); got != want {
+			t.Errorf("ToEpoch(%d) = %d, want %d", slot, got, want)
+		}
+	}
+	if got := Epoch(3).StartSlot(spec); got != 96 {
+		t.Errorf("Unexpected start slot: %d", got)
+	}
+}
+
+func TestSlot_Progress(t *testing.T) {
+	spec := &ChainSpec{SlotsPerEpoch: 32}
+	tests := []struct {
+		slot    Slot
+		want    EpochProgress
+		percent float64
+	}{
+		{slot: 0, want: EpochProgress{Epoch: 0, Elapsed: 1, Remaining: 31}, percent: 3.125},
+		{slot: 47, want: EpochProgress{Epoch: 1, Elapsed: 16, Remaining: 16}, percent: 50},
+		{slot: 95, want: EpochProgress{Epoch: 2, Elapsed: 32, Remaining: 0}, percent: 100},
+	}
+	for _, tt := range tests {
+		got := tt.slot.Progress(spec)
+		if got != tt.want {
+			t.Errorf("Progress(%d) = %+v, want %+v", tt.slot, got, tt.want)
+		}
+		if got.Percent() != tt.percent {
+			t.Errorf("Percent(%d) = %v, want %v", tt.slot, got.Percent(), tt.percent)
+		}
+	}
+	if (EpochProgress{}).Percent() != 0 {
+		t.Error("Empty progress should be zero percent")
+	}
+}
+
+func TestChainSpec_Validate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(spec *ChainSpec)
+	}{
+		{name: "SLOTS_PER_EPOCH", modify: func(spec *ChainSpec) { spec.SlotsPerEpoch = 0 }},
+		{name: "CHURN_LIMIT_QUOTIENT", modify: func(spec *ChainSpec) { spec.ChurnLimitQuotient = 0 }},
+		{name: "MIN_PER_EPOCH_CHURN_LIMIT", modify: func(spec *ChainSpec) { spec.MinPerEpochChurnLimit = 0 }},
+		{name: "MAX_DEPOSITS", modify: func(spec *ChainSpec) { spec.MaxDeposits = 0 }},
+	}
+	for _, tt := range tests {
+		spec := MainnetChainSpec()
+		tt.modify(spec)
+		err := spec.Validate()
+		if !errors.Is(err, ErrInvalidSpec) || !strings.Contains(err.Error(), "zero "+tt.name) {
+			t.Errorf("Unexpected error with zero %s: %v", tt.name, err)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package spectest
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+var errCorruptSnappy = errors.New("corrupt snappy input")
+
+// decodeSnappy decodes snappy block format (as used by `*.ssz_snappy` files of consensus-spec-tests).
+// Only decompression is needed, so a minimal implementation is used instead of a dependency.
+func decodeSnappy(src []byte) ([]byte, error) {
+	length, n := binary.Uvarint(src)
+	if n <= 0 || length > uint64(len(src))*256 {
+		return nil, errCorruptSnappy
+	}
+	src = src[n:]
+	dst := make([]byte, 0, length)
+	for len(src) > 0 {
+		tag := src[0]
+		switch tag & 0x03 {
+		case 0x00: // literal
+			litLen := int(tag >> 2)
+			src = src[1:]
+			if litLen >= 60 {
+				extra := litLen - 59
+				if len(src) < extra {
+					return nil, errCorruptSnappy
+				}
+				litLen = 0
+				for i := extra - 1; i >= 0; i-- {
+					litLen = litLen<<8 | int(src[i])
+				}
+				src = src[extra:]
+			}
+			litLen++
+			if litLen <= 0 || len(src) < litLen {
+				return nil, errCorruptSnappy
+			}
+			dst = append(dst, src[:litLen]...)
+			src = src[litLen:]
+			continue
+		case 0x01: // copy with 1-byte offset
+			if len(src) < 2 {
+				return nil, errCorruptSnappy
+			}
+			copyLen := int(tag>>2&0x07) + 4
+			offset := int(tag&0xe0)<<3 | int(src[1])
+			src = src[2:]
+			if err := appendCopy(&dst, offset, copyLen); err != nil {
+				return nil, err
+			}
+		case 0x02: // copy with 2-byte offset
+			if len(src) < 3 {
+				return nil, errCorruptSnappy
+			}
+			copyLen := int(tag>>2) + 1
+			offset := int(binary.LittleEndian.Uint16(src[1:]))
+			src = src[3:]
+			if err := appendCopy(&dst, offset, copyLen); err != nil {
+				return nil, err
+			}
+		case 0x03: // copy with 4-byte offset
+			if len(src) < 5 {
+				return nil, errCorruptSnappy
+			}
+			copyLen := int(tag>>2) + 1
+			offset := int(binary.LittleEndian.Uint32(src[1:]))
+			src = src[5:]
+			if err := appendCopy(&dst, offset, copyLen); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if uint64(len(dst)) != length {
+		return nil, errCorruptSnappy
+	}
+	return dst, nil
+}
+
+// appendCopy appends copyLen bytes starting offset bytes back from the end of dst (ranges may overlap).
+func appendCopy(dst *[]byte, offset, copyLen int) error {
+	if offset <= 0 || offset > len(*dst) {
+		return errCorruptSnappy
+	}
+	start := len(*dst) - offset
+	for i := 0; i < copyLen; i++ {
+		*dst = append(*dst, (*dst)[start+i])
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+// Package spectest runs conformance checks of uint64-backed types against the official
+// consensus-spec-tests SSZ generic vectors (tests/general/phase0/ssz_generic/uints).
+//
+// The harness is exported, so that downstream forks of the types can be checked as well:
+//
+//	cases, err := spectest.LoadUint64Cases("consensus-spec-tests/tests/general/phase0/ssz_generic/uints")
+//	...
+//	spectest.RunUint64[types.Slot](t, cases)
+package spectest
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v2"
+)
+
+// uint64CasePrefix is the name prefix of test cases for 64-bit unsigned integers.
+const uint64CasePrefix = "uint_64_"
+
+// Case is a single SSZ generic test case.
+type Case struct {
+	// Name is the name of the test case directory.
+	Name string
+	// Valid is true for cases under `valid` directory, invalid serializations must fail to decode.
+	Valid bool
+	// Serialized holds SSZ serialized value.
+	Serialized []byte
+	// Value is the expected decoded value (valid cases only).
+	Value uint64
+	// Root is the expected hash tree root (valid cases only).
+	Root [32]byte
+}
+
+// LoadUint64Cases loads all uint64 test cases from the `uints` directory of SSZ generic tests.
+func LoadUint64Cases(dir string) ([]Case, error) {
+	var cases []Case
+	for _, kind := range []string{"valid", "invalid"} {
+		entries, err := os.ReadDir(filepath.Join(dir, kind))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if !entry.IsDir() || !strings.HasPrefix(entry.Name(), uint64CasePrefix) {
+				continue
+			}
+			c, err := loadCase(filepath.Join(dir, kind, entry.Name()), kind == "valid")
+			if err != nil {
+				return nil, fmt.Errorf("could not load case %s/%s: %w", kind, entry.Name(), err)
+			}
+			cases = append(cases, c)
+		}
+	}
+	sort.Slice(cases, func(i, j int) bool {
+		return cases[i].Name < cases[j].Name
+	})
+	return cases, nil
+}
+
+// loadCase loads single test case from its directory.
+func loadCase(dir string, valid bool) (Case, error) {
+	c := Case{Name: filepath.Base(dir), Valid: valid}
+	serialized, err := readSerialized(dir)
+	if err != nil {
+		return c, err
+	}
+	c.Serialized = serialized
+	if !valid {
+		return c, nil
+	}
+
+	var value string
+	if err := readYAML(filepath.Join(dir, "value.yaml"), &value); err != nil {
+		return c, err
+	}
+	if c.Value, err = strconv.ParseUint(value, 10, 64); err != nil {
+		return c, fmt.Errorf("invalid value: %w", err)
+	}
+
+	var meta struct {
+		Root string `yaml:"root"`
+	}
+	if err := readYAML(filepath.Join(dir, "meta.yaml"), &meta); err != nil {
+		return c, err
+	}
+	root, err := hex.DecodeString(strings.TrimPrefix(meta.Root, "0x"))
+	if err != nil || len(root) != 32 {
+		return c, fmt.Errorf("invalid root %q", meta.Root)
+	}
+	copy(c.Root[:], root)
+	return c, nil
+}
+
+// readSerialized reads `serialized.ssz_snappy` (or uncompressed `serialized.ssz`) from dir.
+func readSerialized(dir string) ([]byte, error) {
+	compressed, err := os.ReadFile(filepath.Join(dir, "serialized.ssz_snappy"))
+	if err == nil {
+		return decodeSnappy(compressed)
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	return os.ReadFile(filepath.Join(dir, "serialized.ssz"))
+}
+
+// readYAML decodes YAML file into v.
+func readYAML(path string, v interface{}) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return yaml.Unmarshal(data, v)
+}
+
+// Uint64Type is the set of methods type under test must implement.
+type Uint64Type[T any] interface {
+	*T
+	MarshalSSZ() ([]byte, error)
+	UnmarshalSSZ(buf []byte) error
+	HashTreeRoot() ([32]byte, error)
+}
+
+// RunUint64 runs every case as a subtest against type T: valid cases must decode into expected value,
+// re-encode into the same bytes and produce the expected root; invalid cases must fail to decode.
+func RunUint64[T ~uint64, PT Uint64Type[T]](t *testing.T, cases []Case) {
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			var v T
+			err := PT(&v).UnmarshalSSZ(c.Serialized)
+			if !c.Valid {
+				if err == nil {
+					t.Fatalf("Expected error decoding %#x", c.Serialized)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if uint64(v) != c.Value {
+				t.Errorf("Unexpected value: %d, want %d", uint64(v), c.Value)
+			}
+			enc, err := PT(&v).MarshalSSZ()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(enc) != string(c.Serialized) {
+				t.Errorf("Unexpected serialization: %#x, want %#x", enc, c.Serialized)
+			}
+			root, err := PT(&v).HashTreeRoot()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if root != c.Root {
+				t.Errorf("Unexpected root: %#x, want %#x", root, c.Root)
+			}
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package spectest
+
+import (
+	"bytes"
+	"testing"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+func TestLoadUint64Cases(t *testing.T) {
+	cases, err := LoadUint64Cases("testdata/uints")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cases) != 5 {
+		t.Fatalf("Unexpected number of cases: %d", len(cases))
+	}
+	for _, c := range cases {
+		if c.Name == "uint_64_max" && c.Value != 1<<64-1 {
+			t.Errorf("Unexpected value: %d", c.Value)
+		}
+	}
+}
+
+func TestConformance(t *testing.T) {
+	cases, err := LoadUint64Cases("testdata/uints")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Run("Slot", func(t *testing.T) { RunUint64[types.Slot](t, cases) })
+	t.Run("Epoch", func(t *testing.T) { RunUint64[types.Epoch](t, cases) })
+	t.Run("Gwei", func(t *testing.T) { RunUint64[types.Gwei](t, cases) })
+}
+
+func TestDecodeSnappy(t *testing.T) {
+	// "abcd" literal followed by a copy of 8 bytes at offset 4.
+	got, err := decodeSnappy([]byte{0x0c, 0x0c, 'a', 'b', 'c', 'd', 0x11, 0x04})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, []byte("abcdabcdabcd")) {
+		t.Errorf("Unexpected output: %q", got)
+	}
+	if _, err := decodeSnappy([]byte{0x05, 0x01, 0x04}); err == nil {
+		t.Error("Expected error on invalid copy offset")
+	}
+}
@@ -0,0 +1,182 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestSSZ_RoundTrip(t *testing.T) {
+	slot := Slot(0x0102030405060708)
+	enc, err := slot.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []byte{0x08, 0x07, 0x06, 0x05, 0x04, 0x03, 0x02, 0x01}
+	if !bytes.Equal(enc, want) {
+		t.Errorf("Unexpected encoding: %#x", enc)
+	}
+	if appended := slot.AppendSSZ([]byte{0xff}); !bytes.Equal(appended, append([]byte{0xff}, want...)) {
+		t.Errorf("Unexpected encoding: %#x", appended)
+	}
+	var decoded Slot
+	if err := decoded.UnmarshalSSZ(enc); err != nil {
+		t.Fatal(err)
+	}
+	if decoded != slot {
+		t.Errorf("Unexpected value: %v", decoded)
+	}
+	if err := decoded.UnmarshalSSZ(enc[:7]); err == nil {
+		t.Error("Expected error on short buffer")
+	}
+}
+
+func TestSSZ_LengthErrors(t *testing.T) {
+	for _, obj := range []interface{ UnmarshalSSZ([]byte) error }{
+		new(Slot), new(Gwei), new(Root), new(Checkpoint), new(AttestationData), new(Fork), new(Genesis),
+	} {
+		err := obj.UnmarshalSSZ(make([]byte, 3))
+		var lengthErr *SSZLengthError
+		if !errors.Is(err, ErrInvalidSSZLength) || !errors.As(err, &lengthErr) || lengthErr.Actual != 3 {
+			t.Errorf("%T: unexpected error: %v", obj, err)
+		}
+	}
+	if err := new(SlotList).UnmarshalSSZ(make([]byte, 3)); !errors.Is(err, ErrInvalidSSZLength) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	list, err := NewList[Slot](2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	short, long := make([]byte, 15), make([]byte, 24)
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = list.UnmarshalSSZ(short)
+		_ = list.UnmarshalSSZ(long)
+	})
+	if allocs != 0 {
+		t.Errorf("Expected no allocations rejecting lists, got %v", allocs)
+	}
+
+	var slot Slot
+	allocs = testing.AllocsPerRun(100, func() {
+		_ = slot.UnmarshalSSZ(nil)
+	})
+	if allocs > 1 {
+		t.Errorf("Expected at most one allocation, got %v", allocs)
+	}
+}
+
+// TestContainer_HashTreeRoot checks container roots against values computed with an independent
+// implementation of the SSZ merkleization spec, so that field order or padding bugs are caught.
+func TestContainer_HashTreeRoot(t *testing.T) {
+	tests := []struct {
+		obj  interface{ HashTreeRoot() ([32]byte, error) }
+		want string
+	}{
+		{&Genesis{GenesisTime: 1606824023, GenesisValidatorsRoot: Root{0x4b, 0x36}, GenesisForkVersion: ForkVersion{0, 0, 0, 1}}, "0x43765465f111471f31c0d5daf2613260b2ab75d374e95dc2b781909c839ce159"},
+		{&Fork{PreviousVersion: ForkVersion{1}, CurrentVersion: ForkVersion{2}, Epoch: 74240}, "0x0ab729baaf7d19210e24180fd95bcbeab8eff955e6a05ac64ca50a23205a704f"},
+		{testAttestationData(), "0x1509204c3f3541e88abe179f05e2cec6ca55949ba4041a897db44c55c65ec0c6"},
+		{&Checkpoint{Epoch: 3, Root: BlockRoot{Root{0x03}}}, "0xa8e9d684dceaef6e6a478c2130ee96a72d37aae54289bcb5972f31c027994f5f"},
+		{testStatus(), "0x774b99b2afe2d00ccf9099831289eea5524c1ddb77c851301cc31ce62f0208c0"},
+		{&MetaDataV1{SeqNumber: 42, Attnets: Bitvector64{0xff, 0x01}, Syncnets: Bitvector4{0x05}}, "0x066542a8c18521215670a536be163025b728a6f3b609dfbcf1d10bd78e998d2e"},
+		{testFinalityCheckpoints(), "0xc91e789bdfba234529a192a5b061cf40b363b44910721e656d569650c5487e8c"},
+		{testBlockHeader(), "0x051f924befea104c1a20f0a4dfd6b25c937032cef34ade0da8c4bfb920a31db2"},
+		{testIndexedAttestation(), "0x9de4f1971e19a8bcdf5599de0b54c0e80f60d99e299e5f15554e4cfa7ae82548"},
+		{testEth1Data(), "0x8c5274c97144e4143260f2157bef771bab44f8335ab98f393d885d8dc15582ad"},
+		{testDepositData(), "0x854a9647b99b581eeedab9b8c8cc80b42ee949d1d289183ec0213c121c3ec901"},
+		{&SignedVoluntaryExit{Message: VoluntaryExit{Epoch: 194048, ValidatorIndex: 42}, Signature: BLSSignature{0xaa}}, "0x846978bb13312a4ca52d8e27039a55e13e1c2103c66218227933fb9005342726"},
+		{&testBLSToExecutionChange().Message, "0xadb30ec5aa93361b51dd284347d8fb74766f4f2b3a3a0930fab5dcf6e73f50fa"},
+		{testWithdrawal(), "0x57a3708016ef71eedb35b3568b74ccf6dce8edcd080b698ed6064ab88776043a"},
+		{testPendingDeposit(), "0xb5118d72a0bbd34793443614406df6ed00a174fc1c9ff2f0e7479108c33de10e"},
+		{&PendingPartialWithdrawal{ValidatorIndex: 1, Amount: 2, WithdrawableEpoch: 3}, "0x66c419026fee8793be7fd0011b9db46b98a79f9c9b640e25317865c358f442db"},
+		{&PendingConsolidation{SourceIndex: 1, TargetIndex: 2}, "0xff55c97976a840b4ced964ed49e3794594ba3f675238b5fd25d282b60f70a194"},
+		{testSyncAggregate(), "0x7673b6f491589b703751eadce09b69de5a77e852e71a729d3e01b079c0745906"},
+		{&DepositTreeSnapshot{
+			Finalized:            []Root{{0x01}, {0x02}},
+			DepositRoot:          Root{0xd0},
+			DepositCount:         3,
+			ExecutionBlockHash:   Root{0xee},
+			ExecutionBlockHeight: 1234,
+		}, "0x317442985f3569d7bed15cf29ea43e76197464d9114cf2b0e4fffddda6c56c82"},
+		{&testValidatorRegistration().Message, "0xbec591dc1a57346e443ad149e5cbf33c5f4bd388965c511ea7db8d0993758cec"},
+	}
+	for _, tt := range tests {
+		got, err := tt.obj.HashTreeRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if hex := fmt.Sprintf("%#x", got); hex != tt.want {
+			t.Errorf("%T: unexpected root %s, want %s", tt.obj, hex, tt.want)
+		}
+	}
+}
+
+func TestSSZ_ZeroAllocations(t *testing.T) {
+	buf := make([]byte, 0, 64)
+	epoch := Epoch(42)
+	root := Root{0x01}
+	allocs := testing.AllocsPerRun(100, func() {
+		buf = buf[:0]
+		buf = epoch.AppendSSZ(buf)
+		buf, _ = epoch.MarshalSSZTo(buf)
+		buf = root.AppendSSZ(buf)
+	})
+	if allocs != 0 {
+		t.Errorf("Expected no allocations, got %v", allocs)
+	}
+}
+
+func BenchmarkSlot_MarshalSSZTo(b *testing.B) {
+	buf := make([]byte, 0, 8)
+	slot := Slot(42)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf, _ = slot.MarshalSSZTo(buf[:0])
+	}
+}
+
+func BenchmarkSlot_HashTreeRoot(b *testing.B) {
+	slot := Slot(42)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := slot.HashTreeRoot(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestHashTreeRootInto(t *testing.T) {
+	for _, obj := range []interface {
+		HashTreeRoot() ([32]byte, error)
+		HashTreeRootInto(*[32]byte) error
+	}{
+		Slot(42), Epoch(1 << 40), Gwei(32e9), ValidatorIndex(7), Root{0x01, 31: 0x02}, ForkVersion{1, 2, 3, 4},
+		ExecutionAddress{0xff}, BalanceDelta(-1), testAttestationData(), &Checkpoint{Epoch: 3, Root: BlockRoot{Root{0x03}}},
+		&Fork{Epoch: 5}, &Genesis{GenesisTime: 1}, testBlockHeader(), testIndexedAttestation(), testSyncAggregate(),
+		&AttesterSlashing{Attestation1: *testIndexedAttestation()}, BLSSignature{0xaa}, BLSPubkey{0xbb},
+		Bytes96{0x01}, Bytes48{0x02}, ProposerLookahead{1, 2, 3},
+	} {
+		want, err := obj.HashTreeRoot()
+		if err != nil {
+			t.Fatal(err)
+		}
+		// Destination is dirty, it must be fully overwritten.
+		dst := [32]byte{0xee, 31: 0xee}
+		if err := obj.HashTreeRootInto(&dst); err != nil {
+			t.Fatal(err)
+		}
+		if dst != want {
+			t.Errorf("%T: unexpected root %#x, want %#x", obj, dst, want)
+		}
+	}
+
+	var dst [32]byte
+	slot := Slot(42)
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = slot.HashTreeRootInto(&dst)
+	})
+	if allocs != 0 {
+		t.Errorf("Expected no allocations, got %v", allocs)
+	}
+}
@@ -0,0 +1,108 @@
+// Package sszutil contains SSZ encoding and decoding helpers shared by hand-written and generated types.
+package sszutil
+
+import (
+	"errors"
+	"strconv"
+)
+
+// ErrInvalidLength is returned (wrapped in LengthError) when SSZ buffer has unexpected length.
+var ErrInvalidLength = errors.New("invalid ssz buffer length")
+
+// ErrListTooLong is returned when SSZ list exceeds its length limit.
+var ErrListTooLong = errors.New("list exceeds length limit")
+
+// ErrListElementSize is returned when buffer of SSZ list doesn't hold a whole number of fixed-size
+// elements, it wraps ErrInvalidLength. The error is preallocated, so that rejecting adversarial
+// input doesn't allocate.
+var ErrListElementSize error = elementSizeError{}
+
+// ErrInvalidOffset is returned when offset of a variable-size field points outside its expected position.
+var ErrInvalidOffset = errors.New("invalid ssz offset")
+
+// LengthError reports expected and actual length of SSZ buffer, it wraps ErrInvalidLength.
+// The message is only formatted when requested, so that rejecting adversarial input is cheap.
+type LengthError struct {
+	Expected int
+	Actual   int
+}
+
+// Error returns the error message.
+func (e *LengthError) Error() string {
+	return "expected buffer of length " + strconv.Itoa(e.Expected) + " received " + strconv.Itoa(e.Actual)
+}
+
+// Unwrap returns ErrInvalidLength.
+func (e *LengthError) Unwrap() error {
+	return ErrInvalidLength
+}
+
+// CheckLength returns LengthError if actual buffer length differs from the expected one.
+func CheckLength(actual, expected int) error {
+	if actual != expected {
+		return &LengthError{Expected: expected, Actual: actual}
+	}
+	return nil
+}
+
+// CheckMinLength returns LengthError if buffer is shorter than fixed part of a variable-size object.
+func CheckMinLength(actual, min int) error {
+	if actual < min {
+		return &LengthError{Expected: min, Actual: actual}
+	}
+	return nil
+}
+
+// OffsetError reports actual and expected offset of a variable-size field, it wraps ErrInvalidOffset.
+type OffsetError struct {
+	Expected int
+	Actual   uint32
+}
+
+// Error returns the error message.
+func (e *OffsetError) Error() string {
+	return "expected offset " + strconv.Itoa(e.Expected) + " received " + strconv.FormatUint(uint64(e.Actual), 10)
+}
+
+// Unwrap returns ErrInvalidOffset.
+func (e *OffsetError) Unwrap() error {
+	return ErrInvalidOffset
+}
+
+// CheckList returns ErrListElementSize unless buffer of the given length holds a whole number of
+// elements of the given size, and ErrListTooLong if number of elements exceeds the limit.
+func CheckList(length, elementSize int, limit uint64) error {
+	if length%elementSize != 0 {
+		return ErrListElementSize
+	}
+	if uint64(length/elementSize) > limit {
+		return ErrListTooLong
+	}
+	return nil
+}
+
+type elementSizeError struct{}
+
+func (elementSizeError) Error() string {
+	return "invalid ssz buffer length: not a multiple of list element size"
+}
+
+func (elementSizeError) Unwrap() error {
+	return ErrInvalidLength
+}
+
+// CheckOffset returns OffsetError unless offset of the first variable-size field points right past
+// the fixed part of the object.
+func CheckOffset(offset uint32, fixedSize int) error {
+	if uint64(offset) != uint64(fixedSize) {
+		return &OffsetError{Expected: fixedSize, Actual: offset}
+	}
+	return nil
+}
+
+// AppendUint64 appends SSZ (little-endian) encoding of v to dst, allocating only if dst has no
+// spare capacity.
+func AppendUint64(dst []byte, v uint64) []byte {
+	return append(dst, byte(v), byte(v>>8), byte(v>>16), byte(v>>24),
+		byte(v>>32), byte(v>>40), byte(v>>48), byte(v>>56))
+}
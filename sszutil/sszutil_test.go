@@ -0,0 +1,86 @@
+package sszutil
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestCheckLength(t *testing.T) {
+	if err := CheckLength(8, 8); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	err := CheckLength(7, 8)
+	if !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("Expected ErrInvalidLength, got: %v", err)
+	}
+	var lengthErr *LengthError
+	if !errors.As(err, &lengthErr) || lengthErr.Expected != 8 || lengthErr.Actual != 7 {
+		t.Errorf("Unexpected error: %#v", err)
+	}
+	if err.Error() != "expected buffer of length 8 received 7" {
+		t.Errorf("Unexpected message: %s", err)
+	}
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = CheckLength(7, 8)
+	})
+	if allocs > 1 {
+		t.Errorf("Expected at most one allocation, got %v", allocs)
+	}
+}
+
+func TestCheckOffset(t *testing.T) {
+	if err := CheckMinLength(10, 8); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if err := CheckMinLength(7, 8); !errors.Is(err, ErrInvalidLength) {
+		t.Errorf("Expected ErrInvalidLength, got: %v", err)
+	}
+	if err := CheckOffset(228, 228); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	err := CheckOffset(4, 228)
+	if !errors.Is(err, ErrInvalidOffset) {
+		t.Errorf("Expected ErrInvalidOffset, got: %v", err)
+	}
+	if err.Error() != "expected offset 228 received 4" {
+		t.Errorf("Unexpected message: %s", err)
+	}
+}
+
+func TestCheckList(t *testing.T) {
+	tests := []struct {
+		length  int
+		limit   uint64
+		wantErr error
+	}{
+		{0, 0, nil},
+		{64, 8, nil},
+		{72, 8, ErrListTooLong},
+		{63, 8, ErrListElementSize},
+	}
+	for _, tt := range tests {
+		if err := CheckList(tt.length, 8, tt.limit); err != tt.wantErr {
+			t.Errorf("Unexpected error for length %d (limit %d): %v", tt.length, tt.limit, err)
+		}
+	}
+	if !errors.Is(ErrListElementSize, ErrInvalidLength) {
+		t.Error("Expected ErrListElementSize to wrap ErrInvalidLength")
+	}
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = CheckList(63, 8, 8)
+		_ = CheckList(72, 8, 8)
+	})
+	if allocs != 0 {
+		t.Errorf("Expected no allocations, got %v", allocs)
+	}
+}
+
+func TestAppendUint64(t *testing.T) {
+	got := AppendUint64([]byte{0xff}, 0x0102030405060708)
+	want := []byte{0xff, 0x08, 0x07, 0x06, 0x05, 0x04, 0x03, 0x02, 0x01}
+	if !bytes.Equal(got, want) {
+		t.Errorf("Unexpected encoding: %x", got)
+	}
+}
@@ -0,0 +1,119 @@
+package types
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+var (
+	// ErrForkDigestMismatch is returned when peer is on a different fork (or chain).
+	ErrForkDigestMismatch = errors.New("fork digest mismatch")
+	// ErrInvalidStatus is returned when peer status is inconsistent with the wall clock.
+	ErrInvalidStatus = errors.New("invalid peer status")
+	// ErrFinalizedRootMismatch is returned when peer has finalized a different checkpoint.
+	ErrFinalizedRootMismatch = errors.New("finalized root mismatch")
+)
+
+// Status is the req/resp Status message, exchanged by peers upon connection.
+type Status struct {
+	ForkDigest     ForkDigest `json:"fork_digest"`
+	FinalizedRoot  BlockRoot  `json:"finalized_root"`
+	FinalizedEpoch Epoch      `json:"finalized_epoch"`
+	HeadRoot       BlockRoot  `json:"head_root"`
+	HeadSlot       Slot       `json:"head_slot"`
+}
+
+// CheckRelevance verifies that the peer with remote status is relevant to the local node, i.e. it is
+// on the same fork, its status is not ahead of the current wall-clock slot, and it has not finalized
+// a checkpoint conflicting with the local one at the same epoch. Conflicts at different epochs can
+// only be detected by looking up the local chain, which is left to the caller.
+func (s *Status) CheckRelevance(local *Status, currentSlot Slot, spec *ChainSpec) error {
+	if s.ForkDigest != local.ForkDigest {
+		return fmt.Errorf("%w: remote %v, local %v", ErrForkDigestMismatch, s.ForkDigest, local.ForkDigest)
+	}
+	if s.HeadSlot > currentSlot {
+		return fmt.Errorf("%w: head slot %d is ahead of current slot %d", ErrInvalidStatus, s.HeadSlot, currentSlot)
+	}
+	if s.FinalizedEpoch > s.HeadSlot.ToEpoch(spec) {
+		return fmt.Errorf("%w: finalized epoch %d is ahead of head slot %d", ErrInvalidStatus, s.FinalizedEpoch, s.HeadSlot)
+	}
+	// Genesis root is reported as zero hash, so it is not compared.
+	if s.FinalizedEpoch == local.FinalizedEpoch && !s.FinalizedEpoch.IsGenesis() && s.FinalizedRoot != local.FinalizedRoot {
+		return fmt.Errorf("%w: epoch %d, remote %v, local %v", ErrFinalizedRootMismatch, s.FinalizedEpoch, s.FinalizedRoot, local.FinalizedRoot)
+	}
+	return nil
+}
+
+// HashTreeRoot returns calculated hash root.
+func (s *Status) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	err := s.HashTreeRootInto(&root)
+	return root, err
+}
+
+// AppendFieldRoots appends hash roots of status fields to dst.
+func (s *Status) AppendFieldRoots(dst [][32]byte) ([][32]byte, error) {
+	var chunks [5][32]byte
+	copy(chunks[0][:], s.ForkDigest[:])
+	chunks[1] = s.FinalizedRoot.Root
+	_ = s.FinalizedEpoch.HashTreeRootInto(&chunks[2])
+	chunks[3] = s.HeadRoot.Root
+	_ = s.HeadSlot.HashTreeRootInto(&chunks[4])
+	return append(dst, chunks[:]...), nil
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (s *Status) HashTreeRootInto(dst *[32]byte) error {
+	var buf [5][32]byte
+	chunks, err := s.AppendFieldRoots(buf[:0])
+	if err != nil {
+		return err
+	}
+	return merkleizeInto(dst, chunks, 0)
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the status object.
+func (s *Status) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), s.SizeSSZ()); err != nil {
+		return err
+	}
+	copy(s.ForkDigest[:], buf[:4])
+	copy(s.FinalizedRoot.Root[:], buf[4:36])
+	s.FinalizedEpoch = Epoch(binary.LittleEndian.Uint64(buf[36:44]))
+	copy(s.HeadRoot.Root[:], buf[44:76])
+	s.HeadSlot = Slot(binary.LittleEndian.Uint64(buf[76:84]))
+	return nil
+}
+
+// MarshalSSZTo marshals status with the provided byte slice.
+func (s *Status) MarshalSSZTo(dst []byte) ([]byte, error) {
+	dst = append(dst, s.ForkDigest[:]...)
+	dst = append(dst, s.FinalizedRoot.Root[:]...)
+	dst = s.FinalizedEpoch.AppendSSZ(dst)
+	dst = append(dst, s.HeadRoot.Root[:]...)
+	return s.HeadSlot.AppendSSZ(dst), nil
+}
+
+// MarshalSSZ marshals status into a serialized object.
+func (s *Status) MarshalSSZ() ([]byte, error) {
+	return s.MarshalSSZTo(make([]byte, 0, s.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (s *Status) SizeSSZ() int {
+	return 84
+}
+
+// WriteTo writes SSZ serialized status to w.
+func (s *Status) WriteTo(w io.Writer) (int64, error) {
+	return writeSSZTo(w, s)
+}
+
+// ReadFrom reads SSZ serialized status from reader (exactly SizeSSZ bytes are consumed).
+func (s *Status) ReadFrom(reader io.Reader) (int64, error) {
+	return readSSZFrom(reader, s)
+}
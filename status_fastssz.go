@@ -0,0 +1,19 @@
+//go:build !nofastssz
+
+package types
+
+import fssz "github.com/ferranbt/fastssz"
+
+var _ fssz.HashRoot = (*Status)(nil)
+var _ fssz.Marshaler = (*Status)(nil)
+var _ fssz.Unmarshaler = (*Status)(nil)
+
+// HashTreeRootWith appends status root to the provided hasher.
+func (s *Status) HashTreeRootWith(hh *fssz.Hasher) error {
+	root, err := s.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	hh.PutBytes(root[:])
+	return nil
+}
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestStatus_SSZ(t *testing.T) {
+	s := testStatus()
+	enc, err := s.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(enc) != 84 {
+		t.Errorf("Unexpected length: %d", len(enc))
+	}
+	decoded := &Status{}
+	if err := decoded.UnmarshalSSZ(enc); err != nil {
+		t.Fatal(err)
+	}
+	if *decoded != *s {
+		t.Errorf("Unexpected status: %+v", decoded)
+	}
+	if err := decoded.UnmarshalSSZ(enc[1:]); !errors.Is(err, ErrInvalidSSZLength) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestStatus_CheckRelevance(t *testing.T) {
+	spec := &ChainSpec{SlotsPerEpoch: 32}
+	local := testStatus()
+	tests := []struct {
+		name   string
+		modify func(s *Status)
+		want   error
+	}{
+		{name: "same status", modify: func(s *Status) {}},
+		{name: "different finalized epoch", modify: func(s *Status) { s.FinalizedEpoch, s.FinalizedRoot = 99, BlockRoot{Root{0xee}} }},
+		{name: "fork digest", modify: func(s *Status) { s.ForkDigest[0]++ }, want: ErrForkDigestMismatch},
+		{name: "head in future", modify: func(s *Status) { s.HeadSlot = 3300 }, want: ErrInvalidStatus},
+		{name: "finalized ahead of head", modify: func(s *Status) { s.FinalizedEpoch = 102 }, want: ErrInvalidStatus},
+		{name: "finalized root", modify: func(s *Status) { s.FinalizedRoot = BlockRoot{Root{0xee}} }, want: ErrFinalizedRootMismatch},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			remote := testStatus()
+			tt.modify(remote)
+			if err := remote.CheckRelevance(local, 3260, spec); !errors.Is(err, tt.want) {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestComputeForkDigest(t *testing.T) {
+	// Mainnet phase0 digest.
+	gvr := Root{
+		0x4b, 0x36, 0x3d, 0xb9, 0x4e, 0x28, 0x61, 0x20, 0xd7, 0x6e, 0xb9, 0x05, 0x34, 0x0f, 0xdd, 0x4e,
+		0x54, 0xbf, 0xe9, 0xf0, 0x6b, 0xf3, 0x3f, 0xf6, 0xcf, 0x5a, 0xd2, 0x7f, 0x51, 0x1b, 0xfe, 0x95,
+	}
+	digest, err := ComputeForkDigest(ForkVersion{}, gvr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if digest.String() != "0xb5303f2a" {
+		t.Errorf("Unexpected fork digest: %v", digest)
+	}
+
+	// Mainnet Fulu digests, before and after blob-parameter-only forks.
+	for blobs, want := range map[BlobScheduleEntry]string{
+		{Epoch: 364032, MaxBlobsPerBlock: 9}:  "0xcc2c5cdb",
+		{Epoch: 412672, MaxBlobsPerBlock: 15}: "0xcb0d1acc",
+		{Epoch: 419072, MaxBlobsPerBlock: 21}: "0x8c9f62fe",
+	} {
+		digest, err := ComputeForkDigestWithBlobs(ForkVersion{0x06}, gvr, blobs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if digest.String() != want {
+			t.Errorf("Unexpected Fulu fork digest with %v: %v, want %s", blobs, digest, want)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package types
+
+import "io"
+
+// fixedSSZObject is implemented by fixed-size SSZ containers.
+type fixedSSZObject interface {
+	MarshalSSZTo(dst []byte) ([]byte, error)
+	UnmarshalSSZ(buf []byte) error
+	SizeSSZ() int
+}
+
+// writeSSZTo writes SSZ serialized fixed-size object to w.
+func writeSSZTo(w io.Writer, obj fixedSSZObject) (int64, error) {
+	buf, err := obj.MarshalSSZTo(make([]byte, 0, obj.SizeSSZ()))
+	if err != nil {
+		return 0, err
+	}
+	n, err := w.Write(buf)
+	return int64(n), err
+}
+
+// readSSZFrom reads SSZ serialized fixed-size object from r. Exactly SizeSSZ bytes are consumed, so
+// objects can be read one after another from the same stream.
+func readSSZFrom(r io.Reader, obj fixedSSZObject) (int64, error) {
+	buf := make([]byte, obj.SizeSSZ())
+	n, err := io.ReadFull(r, buf)
+	if err != nil {
+		return int64(n), err
+	}
+	return int64(n), obj.UnmarshalSSZ(buf)
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+)
+
+func TestStreaming(t *testing.T) {
+	var buf bytes.Buffer
+	slot, epoch, root := Slot(42), Epoch(7), Root{0x01, 0x02}
+	for _, w := range []io.WriterTo{slot, epoch, root} {
+		if _, err := w.WriteTo(&buf); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if buf.Len() != 8+8+32 {
+		t.Fatalf("Unexpected stream length: %d", buf.Len())
+	}
+
+	var (
+		gotSlot  Slot
+		gotEpoch Epoch
+		gotRoot  Root
+	)
+	for _, r := range []io.ReaderFrom{&gotSlot, &gotEpoch, &gotRoot} {
+		if _, err := r.ReadFrom(&buf); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if gotSlot != slot || gotEpoch != epoch || gotRoot != root {
+		t.Errorf("Unexpected values: %v, %v, %#x", gotSlot, gotEpoch, gotRoot)
+	}
+
+	if _, err := gotSlot.ReadFrom(bytes.NewReader([]byte{0x01, 0x02})); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestStreaming_Containers(t *testing.T) {
+	type streamable interface {
+		io.WriterTo
+		io.ReaderFrom
+		SizeSSZ() int
+	}
+	tests := []struct {
+		value, decoded streamable
+	}{
+		{value: &Checkpoint{Epoch: 3, Root: BlockRoot{Root: Root{0x01}}}, decoded: &Checkpoint{}},
+		{value: &AttestationData{Slot: 100, Index: 2, Target: Checkpoint{Epoch: 3}}, decoded: &AttestationData{}},
+		{value: &Fork{PreviousVersion: ForkVersion{0x01}, CurrentVersion: ForkVersion{0x02}, Epoch: 5}, decoded: &Fork{}},
+		{value: &SignedVoluntaryExit{Message: VoluntaryExit{Epoch: 9, ValidatorIndex: 12}, Signature: BLSSignature{0xc0}}, decoded: &SignedVoluntaryExit{}},
+		{value: &Withdrawal{Index: 1, ValidatorIndex: 2, Amount: 3}, decoded: &Withdrawal{}},
+	}
+	var buf bytes.Buffer
+	for _, tt := range tests {
+		n, err := tt.value.WriteTo(&buf)
+		if err != nil || n != int64(tt.value.SizeSSZ()) {
+			t.Fatalf("Unexpected write of %T: %d, %v", tt.value, n, err)
+		}
+	}
+	for _, tt := range tests {
+		if n, err := tt.decoded.ReadFrom(&buf); err != nil || n != int64(tt.value.SizeSSZ()) {
+			t.Fatalf("Unexpected read of %T: %d, %v", tt.value, n, err)
+		}
+		if !reflect.DeepEqual(tt.decoded, tt.value) {
+			t.Errorf("Unexpected decoded value: %+v, want %+v", tt.decoded, tt.value)
+		}
+	}
+
+	var checkpoint Checkpoint
+	if _, err := checkpoint.ReadFrom(bytes.NewReader(make([]byte, 39))); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
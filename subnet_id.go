@@ -0,0 +1,5 @@
+package types
+
+// SubnetID represents index of a gossip subnet (attestation, sync committee or blob sidecar).
+// Common methods (arithmetic, encoding) are generated, see subnet_id_gen.go.
+type SubnetID uint64
@@ -0,0 +1,17 @@
+// Code generated by typegen. DO NOT EDIT.
+
+//go:build !nofastssz
+
+package types
+
+import fssz "github.com/ferranbt/fastssz"
+
+var _ fssz.HashRoot = (SubnetID)(0)
+var _ fssz.Marshaler = (*SubnetID)(nil)
+var _ fssz.Unmarshaler = (*SubnetID)(nil)
+
+// HashTreeRootWith appends subnet id to the provided hasher.
+func (s SubnetID) HashTreeRootWith(hh *fssz.Hasher) error {
+	hh.PutUint64(uint64(s))
+	return nil
+}
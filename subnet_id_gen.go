@@ -0,0 +1,244 @@
+// Code generated by typegen. DO NOT EDIT.
+
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/farazdagi/prysm-shared-types/mathutil"
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// IsZero returns true if subnet id has zero value.
+func (s SubnetID) IsZero() bool {
+	return s == 0
+}
+
+// Add increases subnet id by x, panics on overflow.
+func (s SubnetID) Add(x uint64) SubnetID {
+	return s.AddSubnetID(SubnetID(x))
+}
+
+// AddSubnetID increases subnet id by another subnet id, panics on overflow.
+func (s SubnetID) AddSubnetID(x SubnetID) SubnetID {
+	return mathutil.Add(s, x)
+}
+
+// SafeAdd increases subnet id by x, returns an error on overflow.
+func (s SubnetID) SafeAdd(x uint64) (SubnetID, error) {
+	return mathutil.SafeAdd(s, SubnetID(x))
+}
+
+// Sub subtracts x from the subnet id, panics on underflow.
+func (s SubnetID) Sub(x uint64) SubnetID {
+	return s.SubSubnetID(SubnetID(x))
+}
+
+// SubSubnetID finds difference between two subnet id values, panics on underflow.
+func (s SubnetID) SubSubnetID(x SubnetID) SubnetID {
+	return mathutil.Sub(s, x)
+}
+
+// SafeSub subtracts x from the subnet id, returns an error on underflow.
+func (s SubnetID) SafeSub(x uint64) (SubnetID, error) {
+	return mathutil.SafeSub(s, SubnetID(x))
+}
+
+// AddInt shifts subnet id by signed offset x, returns an error if result overflows or goes below zero.
+func (s SubnetID) AddInt(x int64) (SubnetID, error) {
+	return mathutil.SafeAddInt(s, x)
+}
+
+// Mul multiplies subnet id by x, panics on overflow.
+func (s SubnetID) Mul(x uint64) SubnetID {
+	return s.MulSubnetID(SubnetID(x))
+}
+
+// MulSubnetID multiplies subnet id by another subnet id, panics on overflow.
+func (s SubnetID) MulSubnetID(x SubnetID) SubnetID {
+	return mathutil.Mul(s, x)
+}
+
+// SafeMul multiplies subnet id by x, returns an error on overflow.
+func (s SubnetID) SafeMul(x uint64) (SubnetID, error) {
+	return mathutil.SafeMul(s, SubnetID(x))
+}
+
+// Div divides subnet id by x, panics if x is zero.
+func (s SubnetID) Div(x uint64) SubnetID {
+	return s.DivSubnetID(SubnetID(x))
+}
+
+// DivSubnetID divides subnet id by another subnet id, panics if x is zero.
+func (s SubnetID) DivSubnetID(x SubnetID) SubnetID {
+	return mathutil.Div(s, x)
+}
+
+// SafeDiv divides subnet id by x, returns an error if x is zero.
+func (s SubnetID) SafeDiv(x uint64) (SubnetID, error) {
+	return mathutil.SafeDiv(s, SubnetID(x))
+}
+
+// Mod returns result of `subnet id % x`, panics if x is zero.
+func (s SubnetID) Mod(x uint64) SubnetID {
+	return s.ModSubnetID(SubnetID(x))
+}
+
+// ModSubnetID returns result of `subnet id % subnet id`, panics if x is zero.
+func (s SubnetID) ModSubnetID(x SubnetID) SubnetID {
+	return mathutil.Mod(s, x)
+}
+
+// SafeMod returns result of `subnet id % x`, returns an error if x is zero.
+func (s SubnetID) SafeMod(x uint64) (SubnetID, error) {
+	return mathutil.SafeMod(s, SubnetID(x))
+}
+
+// Compare returns an integer comparing two subnet id values (-1, 0 or +1).
+func (s SubnetID) Compare(x SubnetID) int {
+	switch {
+	case s < x:
+		return -1
+	case s > x:
+		return 1
+	}
+	return 0
+}
+
+// IsAfter returns true if subnet id is strictly greater than x.
+func (s SubnetID) IsAfter(x SubnetID) bool {
+	return s > x
+}
+
+// IsBefore returns true if subnet id is strictly less than x.
+func (s SubnetID) IsBefore(x SubnetID) bool {
+	return s < x
+}
+
+// WithinN returns true if subnet id is at most n away from x (in either direction).
+func (s SubnetID) WithinN(x SubnetID, n uint64) bool {
+	if s > x {
+		return uint64(s-x) <= n
+	}
+	return uint64(x-s) <= n
+}
+
+// String returns decimal representation of the subnet id.
+func (s SubnetID) String() string {
+	return strconv.FormatUint(uint64(s), 10)
+}
+
+// PaddedString returns decimal representation of the subnet id, left-padded with zeros to width digits,
+// so that values sort lexicographically (e.g. in file names and object keys). Wider values are not truncated.
+func (s SubnetID) PaddedString(width int) string {
+	return string(s.AppendPadded(nil, width))
+}
+
+// AppendPadded appends zero-padded decimal representation of the subnet id to dst, see PaddedString.
+func (s SubnetID) AppendPadded(dst []byte, width int) []byte {
+	var digits [20]byte
+	b := strconv.AppendUint(digits[:0], uint64(s), 10)
+	for i := len(b); i < width; i++ {
+		dst = append(dst, '0')
+	}
+	return append(dst, b...)
+}
+
+// MarshalText encodes subnet id as a decimal string.
+func (s SubnetID) MarshalText() ([]byte, error) {
+	return strconv.AppendUint(nil, uint64(s), 10), nil
+}
+
+// UnmarshalText decodes subnet id from a decimal string.
+func (s *SubnetID) UnmarshalText(data []byte) error {
+	parsed, err := strconv.ParseUint(string(data), 10, 64)
+	if err != nil {
+		return fmt.Errorf("could not parse subnet id: %w", err)
+	}
+	*s = SubnetID(parsed)
+	return nil
+}
+
+// MarshalJSON encodes subnet id as a quoted decimal string (as expected by the Beacon API).
+func (s SubnetID) MarshalJSON() ([]byte, error) {
+	data := make([]byte, 0, 22)
+	data = append(data, '"')
+	data = strconv.AppendUint(data, uint64(s), 10)
+	return append(data, '"'), nil
+}
+
+// UnmarshalJSON decodes subnet id from either a quoted decimal string or a JSON number.
+func (s *SubnetID) UnmarshalJSON(data []byte) error {
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		data = data[1 : len(data)-1]
+	}
+	return s.UnmarshalText(data)
+}
+
+// HashTreeRoot returns calculated hash root.
+// Root of a basic uint64 value is its little-endian encoding padded to 32 bytes, so no hashing is involved.
+func (s SubnetID) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	binary.LittleEndian.PutUint64(root[:8], uint64(s))
+	return root, nil
+}
+
+// HashTreeRootInto writes hash root into dst, avoiding copying of the returned array.
+func (s SubnetID) HashTreeRootInto(dst *[32]byte) error {
+	*dst = [32]byte{}
+	binary.LittleEndian.PutUint64(dst[:8], uint64(s))
+	return nil
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the subnet id object.
+func (s *SubnetID) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), s.SizeSSZ()); err != nil {
+		return err
+	}
+	*s = SubnetID(binary.LittleEndian.Uint64(buf))
+	return nil
+}
+
+// MarshalSSZTo marshals subnet id with the provided byte slice.
+func (s *SubnetID) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return s.AppendSSZ(dst), nil
+}
+
+// MarshalSSZ marshals subnet id into a serialized object.
+func (s *SubnetID) MarshalSSZ() ([]byte, error) {
+	return s.AppendSSZ(make([]byte, 0, 8)), nil
+}
+
+// AppendSSZ appends serialized subnet id to dst, allocating only if dst has no spare capacity.
+func (s SubnetID) AppendSSZ(dst []byte) []byte {
+	return append(dst, byte(s), byte(s>>8), byte(s>>16), byte(s>>24),
+		byte(s>>32), byte(s>>40), byte(s>>48), byte(s>>56))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (s *SubnetID) SizeSSZ() int {
+	return 8
+}
+
+// WriteTo writes SSZ serialized subnet id to w.
+func (s SubnetID) WriteTo(w io.Writer) (int64, error) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], uint64(s))
+	n, err := w.Write(buf[:])
+	return int64(n), err
+}
+
+// ReadFrom reads SSZ serialized subnet id from r.
+// Exactly 8 bytes are consumed, so values can be read one after another from the same stream.
+func (s *SubnetID) ReadFrom(r io.Reader) (int64, error) {
+	var buf [8]byte
+	n, err := io.ReadFull(r, buf[:])
+	if err != nil {
+		return int64(n), err
+	}
+	*s = SubnetID(binary.LittleEndian.Uint64(buf[:]))
+	return int64(n), nil
+}
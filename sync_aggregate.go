@@ -0,0 +1,117 @@
+package types
+
+import (
+	"io"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// SyncAggregate is the spec (Altair) SyncAggregate container: participation bits of the current sync
+// committee and their aggregate signature over the previous slot's block root.
+type SyncAggregate struct {
+	SyncCommitteeBits      Bitvector512 `json:"sync_committee_bits"`
+	SyncCommitteeSignature BLSSignature `json:"sync_committee_signature"`
+}
+
+// NewEmptySyncAggregate returns aggregate with no participants, signed with the infinite signature
+// (as required when no sync committee member participated).
+func NewEmptySyncAggregate() *SyncAggregate {
+	return &SyncAggregate{SyncCommitteeSignature: InfiniteSignature}
+}
+
+// Participated returns true if sync committee member at the given index participated.
+func (a *SyncAggregate) Participated(index SyncCommitteeIndex) bool {
+	return a.SyncCommitteeBits.BitAt(uint64(index))
+}
+
+// ParticipantCount returns number of participating sync committee members.
+func (a *SyncAggregate) ParticipantCount() uint64 {
+	return uint64(a.SyncCommitteeBits.Count())
+}
+
+// ParticipantIndices returns sorted sync committee indices of participants.
+func (a *SyncAggregate) ParticipantIndices() []SyncCommitteeIndex {
+	indices := make([]SyncCommitteeIndex, 0, a.ParticipantCount())
+	for i := uint64(0); i < a.SyncCommitteeBits.Len(); i++ {
+		if a.SyncCommitteeBits.BitAt(i) {
+			indices = append(indices, SyncCommitteeIndex(i))
+		}
+	}
+	return indices
+}
+
+// NonParticipantCount returns number of sync committee members which did not participate.
+func (a *SyncAggregate) NonParticipantCount() uint64 {
+	return a.SyncCommitteeBits.Len() - a.ParticipantCount()
+}
+
+// HasSupermajority returns true if at least 2/3 of the sync committee participated (the threshold
+// light clients use to apply updates).
+func (a *SyncAggregate) HasSupermajority() bool {
+	return a.ParticipantCount()*3 >= a.SyncCommitteeBits.Len()*2
+}
+
+// HashTreeRoot returns calculated hash root.
+func (a *SyncAggregate) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	err := a.HashTreeRootInto(&root)
+	return root, err
+}
+
+// AppendFieldRoots appends hash roots of sync aggregate fields to dst.
+func (a *SyncAggregate) AppendFieldRoots(dst [][32]byte) ([][32]byte, error) {
+	var chunks [2][32]byte
+	if err := a.SyncCommitteeBits.HashTreeRootInto(&chunks[0]); err != nil {
+		return dst, err
+	}
+	if err := a.SyncCommitteeSignature.HashTreeRootInto(&chunks[1]); err != nil {
+		return dst, err
+	}
+	return append(dst, chunks[:]...), nil
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (a *SyncAggregate) HashTreeRootInto(dst *[32]byte) error {
+	var buf [2][32]byte
+	chunks, err := a.AppendFieldRoots(buf[:0])
+	if err != nil {
+		return err
+	}
+	return merkleizeInto(dst, chunks, 0)
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the sync aggregate object.
+func (a *SyncAggregate) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), a.SizeSSZ()); err != nil {
+		return err
+	}
+	copy(a.SyncCommitteeBits[:], buf[:64])
+	copy(a.SyncCommitteeSignature[:], buf[64:160])
+	return nil
+}
+
+// MarshalSSZTo marshals sync aggregate with the provided byte slice.
+func (a *SyncAggregate) MarshalSSZTo(dst []byte) ([]byte, error) {
+	dst = append(dst, a.SyncCommitteeBits[:]...)
+	return append(dst, a.SyncCommitteeSignature[:]...), nil
+}
+
+// MarshalSSZ marshals sync aggregate into a serialized object.
+func (a *SyncAggregate) MarshalSSZ() ([]byte, error) {
+	return a.MarshalSSZTo(make([]byte, 0, a.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (a *SyncAggregate) SizeSSZ() int {
+	return 160
+}
+
+// WriteTo writes SSZ serialized sync aggregate to w.
+func (a *SyncAggregate) WriteTo(w io.Writer) (int64, error) {
+	return writeSSZTo(w, a)
+}
+
+// ReadFrom reads SSZ serialized sync aggregate from reader (exactly SizeSSZ bytes are consumed).
+func (a *SyncAggregate) ReadFrom(reader io.Reader) (int64, error) {
+	return readSSZFrom(reader, a)
+}
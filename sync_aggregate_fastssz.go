@@ -0,0 +1,19 @@
+//go:build !nofastssz
+
+package types
+
+import fssz "github.com/ferranbt/fastssz"
+
+var _ fssz.HashRoot = (*SyncAggregate)(nil)
+var _ fssz.Marshaler = (*SyncAggregate)(nil)
+var _ fssz.Unmarshaler = (*SyncAggregate)(nil)
+
+// HashTreeRootWith appends sync aggregate root to the provided hasher.
+func (a *SyncAggregate) HashTreeRootWith(hh *fssz.Hasher) error {
+	root, err := a.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	hh.PutBytes(root[:])
+	return nil
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestSyncAggregate_Participation(t *testing.T) {
+	a := testSyncAggregate()
+	if n := a.ParticipantCount(); n != 4 {
+		t.Errorf("Unexpected participant count: %d", n)
+	}
+	if n := a.NonParticipantCount(); n != 508 {
+		t.Errorf("Unexpected non-participant count: %d", n)
+	}
+	if !a.Participated(511) || a.Participated(1) || a.Participated(512) {
+		t.Error("Unexpected participation")
+	}
+	want := []SyncCommitteeIndex{0, 7, 8, 511}
+	if got := a.ParticipantIndices(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Unexpected participants: %v", got)
+	}
+	if a.HasSupermajority() {
+		t.Error("Unexpected supermajority")
+	}
+	for i := uint64(0); i < 340; i++ {
+		a.SyncCommitteeBits.SetBitAt(i, true)
+	}
+	if a.HasSupermajority() {
+		t.Errorf("Unexpected supermajority with %d participants", a.ParticipantCount())
+	}
+	a.SyncCommitteeBits.SetBitAt(340, true)
+	if !a.HasSupermajority() {
+		t.Errorf("Expected supermajority with %d participants", a.ParticipantCount())
+	}
+}
+
+func TestNewEmptySyncAggregate(t *testing.T) {
+	a := NewEmptySyncAggregate()
+	if a.ParticipantCount() != 0 || !a.SyncCommitteeSignature.IsInfinite() {
+		t.Errorf("Unexpected empty aggregate: %+v", a)
+	}
+	if (BLSSignature{}).IsInfinite() {
+		t.Error("Zero signature is not infinite")
+	}
+}
+
+func TestSyncAggregate_SSZ(t *testing.T) {
+	a := testSyncAggregate()
+	enc, err := a.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(enc) != 160 || enc[0] != 0x81 || enc[1] != 0x01 || enc[63] != 0x80 {
+		t.Errorf("Unexpected encoding: %x", enc)
+	}
+	decoded := &SyncAggregate{}
+	if err := decoded.UnmarshalSSZ(enc); err != nil {
+		t.Fatal(err)
+	}
+	if *decoded != *a {
+		t.Errorf("Unexpected aggregate: %+v", decoded)
+	}
+}
+
+func TestSyncAggregate_JSON(t *testing.T) {
+	a := testSyncAggregate()
+	enc, err := json.Marshal(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded SyncAggregate
+	if err := json.Unmarshal(enc, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded != *a {
+		t.Errorf("Unexpected aggregate: %+v", decoded)
+	}
+}
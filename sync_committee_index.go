@@ -0,0 +1,5 @@
+package types
+
+// SyncCommitteeIndex represents position of a validator within the sync committee.
+// Common methods (arithmetic, encoding) are generated, see sync_committee_index_gen.go.
+type SyncCommitteeIndex uint64
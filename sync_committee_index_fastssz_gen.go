@@ -0,0 +1,17 @@
+// Code generated by typegen. DO NOT EDIT.
+
+//go:build !nofastssz
+
+package types
+
+import fssz "github.com/ferranbt/fastssz"
+
+var _ fssz.HashRoot = (SyncCommitteeIndex)(0)
+var _ fssz.Marshaler = (*SyncCommitteeIndex)(nil)
+var _ fssz.Unmarshaler = (*SyncCommitteeIndex)(nil)
+
+// HashTreeRootWith appends sync committee index to the provided hasher.
+func (s SyncCommitteeIndex) HashTreeRootWith(hh *fssz.Hasher) error {
+	hh.PutUint64(uint64(s))
+	return nil
+}
@@ -0,0 +1,244 @@
+// Code generated by typegen. DO NOT EDIT.
+
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/farazdagi/prysm-shared-types/mathutil"
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// IsZero returns true if sync committee index has zero value.
+func (s SyncCommitteeIndex) IsZero() bool {
+	return s == 0
+}
+
+// Add increases sync committee index by x, panics on overflow.
+func (s SyncCommitteeIndex) Add(x uint64) SyncCommitteeIndex {
+	return s.AddSyncCommitteeIndex(SyncCommitteeIndex(x))
+}
+
+// AddSyncCommitteeIndex increases sync committee index by another sync committee index, panics on overflow.
+func (s SyncCommitteeIndex) AddSyncCommitteeIndex(x SyncCommitteeIndex) SyncCommitteeIndex {
+	return mathutil.Add(s, x)
+}
+
+// SafeAdd increases sync committee index by x, returns an error on overflow.
+func (s SyncCommitteeIndex) SafeAdd(x uint64) (SyncCommitteeIndex, error) {
+	return mathutil.SafeAdd(s, SyncCommitteeIndex(x))
+}
+
+// Sub subtracts x from the sync committee index, panics on underflow.
+func (s SyncCommitteeIndex) Sub(x uint64) SyncCommitteeIndex {
+	return s.SubSyncCommitteeIndex(SyncCommitteeIndex(x))
+}
+
+// SubSyncCommitteeIndex finds difference between two sync committee index values, panics on underflow.
+func (s SyncCommitteeIndex) SubSyncCommitteeIndex(x SyncCommitteeIndex) SyncCommitteeIndex {
+	return mathutil.Sub(s, x)
+}
+
+// SafeSub subtracts x from the sync committee index, returns an error on underflow.
+func (s SyncCommitteeIndex) SafeSub(x uint64) (SyncCommitteeIndex, error) {
+	return mathutil.SafeSub(s, SyncCommitteeIndex(x))
+}
+
+// AddInt shifts sync committee index by signed offset x, returns an error if result overflows or goes below zero.
+func (s SyncCommitteeIndex) AddInt(x int64) (SyncCommitteeIndex, error) {
+	return mathutil.SafeAddInt(s, x)
+}
+
+// Mul multiplies sync committee index by x, panics on overflow.
+func (s SyncCommitteeIndex) Mul(x uint64) SyncCommitteeIndex {
+	return s.MulSyncCommitteeIndex(SyncCommitteeIndex(x))
+}
+
+// MulSyncCommitteeIndex multiplies sync committee index by another sync committee index, panics on overflow.
+func (s SyncCommitteeIndex) MulSyncCommitteeIndex(x SyncCommitteeIndex) SyncCommitteeIndex {
+	return mathutil.Mul(s, x)
+}
+
+// SafeMul multiplies sync committee index by x, returns an error on overflow.
+func (s SyncCommitteeIndex) SafeMul(x uint64) (SyncCommitteeIndex, error) {
+	return mathutil.SafeMul(s, SyncCommitteeIndex(x))
+}
+
+// Div divides sync committee index by x, panics if x is zero.
+func (s SyncCommitteeIndex) Div(x uint64) SyncCommitteeIndex {
+	return s.DivSyncCommitteeIndex(SyncCommitteeIndex(x))
+}
+
+// DivSyncCommitteeIndex divides sync committee index by another sync committee index, panics if x is zero.
+func (s SyncCommitteeIndex) DivSyncCommitteeIndex(x SyncCommitteeIndex) SyncCommitteeIndex {
+	return mathutil.Div(s, x)
+}
+
+// SafeDiv divides sync committee index by x, returns an error if x is zero.
+func (s SyncCommitteeIndex) SafeDiv(x uint64) (SyncCommitteeIndex, error) {
+	return mathutil.SafeDiv(s, SyncCommitteeIndex(x))
+}
+
+// Mod returns result of `sync committee index % x`, panics if x is zero.
+func (s SyncCommitteeIndex) Mod(x uint64) SyncCommitteeIndex {
+	return s.ModSyncCommitteeIndex(SyncCommitteeIndex(x))
+}
+
+// ModSyncCommitteeIndex returns result of `sync committee index % sync committee index`, panics if x is zero.
+func (s SyncCommitteeIndex) ModSyncCommitteeIndex(x SyncCommitteeIndex) SyncCommitteeIndex {
+	return mathutil.Mod(s, x)
+}
+
+// SafeMod returns result of `sync committee index % x`, returns an error if x is zero.
+func (s SyncCommitteeIndex) SafeMod(x uint64) (SyncCommitteeIndex, error) {
+	return mathutil.SafeMod(s, SyncCommitteeIndex(x))
+}
+
+// Compare returns an integer comparing two sync committee index values (-1, 0 or +1).
+func (s SyncCommitteeIndex) Compare(x SyncCommitteeIndex) int {
+	switch {
+	case s < x:
+		return -1
+	case s > x:
+		return 1
+	}
+	return 0
+}
+
+// IsAfter returns true if sync committee index is strictly greater than x.
+func (s SyncCommitteeIndex) IsAfter(x SyncCommitteeIndex) bool {
+	return s > x
+}
+
+// IsBefore returns true if sync committee index is strictly less than x.
+func (s SyncCommitteeIndex) IsBefore(x SyncCommitteeIndex) bool {
+	return s < x
+}
+
+// WithinN returns true if sync committee index is at most n away from x (in either direction).
+func (s SyncCommitteeIndex) WithinN(x SyncCommitteeIndex, n uint64) bool {
+	if s > x {
+		return uint64(s-x) <= n
+	}
+	return uint64(x-s) <= n
+}
+
+// String returns decimal representation of the sync committee index.
+func (s SyncCommitteeIndex) String() string {
+	return strconv.FormatUint(uint64(s), 10)
+}
+
+// PaddedString returns decimal representation of the sync committee index, left-padded with zeros to width digits,
+// so that values sort lexicographically (e.g. in file names and object keys). Wider values are not truncated.
+func (s SyncCommitteeIndex) PaddedString(width int) string {
+	return string(s.AppendPadded(nil, width))
+}
+
+// AppendPadded appends zero-padded decimal representation of the sync committee index to dst, see PaddedString.
+func (s SyncCommitteeIndex) AppendPadded(dst []byte, width int) []byte {
+	var digits [20]byte
+	b := strconv.AppendUint(digits[:0], uint64(s), 10)
+	for i := len(b); i < width; i++ {
+		dst = append(dst, '0')
+	}
+	return append(dst, b...)
+}
+
+// MarshalText encodes sync committee index as a decimal string.
+func (s SyncCommitteeIndex) MarshalText() ([]byte, error) {
+	return strconv.AppendUint(nil, uint64(s), 10), nil
+}
+
+// UnmarshalText decodes sync committee index from a decimal string.
+func (s *SyncCommitteeIndex) UnmarshalText(data []byte) error {
+	parsed, err := strconv.ParseUint(string(data), 10, 64)
+	if err != nil {
+		return fmt.Errorf("could not parse sync committee index: %w", err)
+	}
+	*s = SyncCommitteeIndex(parsed)
+	return nil
+}
+
+// MarshalJSON encodes sync committee index as a quoted decimal string (as expected by the Beacon API).
+func (s SyncCommitteeIndex) MarshalJSON() ([]byte, error) {
+	data := make([]byte, 0, 22)
+	data = append(data, '"')
+	data = strconv.AppendUint(data, uint64(s), 10)
+	return append(data, '"'), nil
+}
+
+// UnmarshalJSON decodes sync committee index from either a quoted decimal string or a JSON number.
+func (s *SyncCommitteeIndex) UnmarshalJSON(data []byte) error {
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		data = data[1 : len(data)-1]
+	}
+	return s.UnmarshalText(data)
+}
+
+// HashTreeRoot returns calculated hash root.
+// Root of a basic uint64 value is its little-endian encoding padded to 32 bytes, so no hashing is involved.
+func (s SyncCommitteeIndex) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	binary.LittleEndian.PutUint64(root[:8], uint64(s))
+	return root, nil
+}
+
+// HashTreeRootInto writes hash root into dst, avoiding copying of the returned array.
+func (s SyncCommitteeIndex) HashTreeRootInto(dst *[32]byte) error {
+	*dst = [32]byte{}
+	binary.LittleEndian.PutUint64(dst[:8], uint64(s))
+	return nil
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the sync committee index object.
+func (s *SyncCommitteeIndex) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), s.SizeSSZ()); err != nil {
+		return err
+	}
+	*s = SyncCommitteeIndex(binary.LittleEndian.Uint64(buf))
+	return nil
+}
+
+// MarshalSSZTo marshals sync committee index with the provided byte slice.
+func (s *SyncCommitteeIndex) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return s.AppendSSZ(dst), nil
+}
+
+// MarshalSSZ marshals sync committee index into a serialized object.
+func (s *SyncCommitteeIndex) MarshalSSZ() ([]byte, error) {
+	return s.AppendSSZ(make([]byte, 0, 8)), nil
+}
+
+// AppendSSZ appends serialized sync committee index to dst, allocating only if dst has no spare capacity.
+func (s SyncCommitteeIndex) AppendSSZ(dst []byte) []byte {
+	return append(dst, byte(s), byte(s>>8), byte(s>>16), byte(s>>24),
+		byte(s>>32), byte(s>>40), byte(s>>48), byte(s>>56))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (s *SyncCommitteeIndex) SizeSSZ() int {
+	return 8
+}
+
+// WriteTo writes SSZ serialized sync committee index to w.
+func (s SyncCommitteeIndex) WriteTo(w io.Writer) (int64, error) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], uint64(s))
+	n, err := w.Write(buf[:])
+	return int64(n), err
+}
+
+// ReadFrom reads SSZ serialized sync committee index from r.
+// Exactly 8 bytes are consumed, so values can be read one after another from the same stream.
+func (s *SyncCommitteeIndex) ReadFrom(r io.Reader) (int64, error) {
+	var buf [8]byte
+	n, err := io.ReadFull(r, buf[:])
+	if err != nil {
+		return int64(n), err
+	}
+	*s = SyncCommitteeIndex(binary.LittleEndian.Uint64(buf[:]))
+	return int64(n), nil
+}
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// ErrInvalidLightClientUpdate is returned when slots of light client update are inconsistent.
+var ErrInvalidLightClientUpdate = errors.New("invalid light client update")
+
+// SyncCommitteePeriod is the index of the sync committee period, see compute_sync_committee_period.
+type SyncCommitteePeriod uint64
+
+// SyncCommitteePeriod returns sync committee period the epoch belongs to.
+func (e Epoch) SyncCommitteePeriod(spec *ChainSpec) SyncCommitteePeriod {
+	return SyncCommitteePeriod(e.DivEpoch(spec.EpochsPerSyncCommitteePeriod))
+}
+
+// SyncCommitteePeriod returns sync committee period the slot belongs to, see
+// compute_sync_committee_period_at_slot.
+func (s Slot) SyncCommitteePeriod(spec *ChainSpec) SyncCommitteePeriod {
+	return s.ToEpoch(spec).SyncCommitteePeriod(spec)
+}
+
+// IsSyncCommitteePeriodStart returns true if epoch is the first epoch of its sync committee period.
+func (e Epoch) IsSyncCommitteePeriodStart(spec *ChainSpec) bool {
+	return e.ModEpoch(spec.EpochsPerSyncCommitteePeriod) == 0
+}
+
+// StartEpoch returns the first epoch of the period, panics on overflow.
+func (p SyncCommitteePeriod) StartEpoch(spec *ChainSpec) Epoch {
+	return spec.EpochsPerSyncCommitteePeriod.MulEpoch(Epoch(p))
+}
+
+// EndEpoch returns the last epoch of the period, panics on overflow.
+func (p SyncCommitteePeriod) EndEpoch(spec *ChainSpec) Epoch {
+	return p.Next().StartEpoch(spec) - 1
+}
+
+// StartSlot returns the first slot of the period, panics on overflow.
+func (p SyncCommitteePeriod) StartSlot(spec *ChainSpec) Slot {
+	return p.StartEpoch(spec).StartSlot(spec)
+}
+
+// Epochs returns range of epochs covered by the period.
+func (p SyncCommitteePeriod) Epochs(spec *ChainSpec) EpochRange {
+	return EpochRange{Start: p.StartEpoch(spec), End: p.EndEpoch(spec)}
+}
+
+// Next returns the following period.
+func (p SyncCommitteePeriod) Next() SyncCommitteePeriod {
+	return p + 1
+}
+
+// String returns decimal representation of the period.
+func (p SyncCommitteePeriod) String() string {
+	return strconv.FormatUint(uint64(p), 10)
+}
+
+// MarshalText encodes period as a decimal string.
+func (p SyncCommitteePeriod) MarshalText() ([]byte, error) {
+	return strconv.AppendUint(nil, uint64(p), 10), nil
+}
+
+// UnmarshalText decodes period from a decimal string.
+func (p *SyncCommitteePeriod) UnmarshalText(text []byte) error {
+	v, err := strconv.ParseUint(string(text), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid sync committee period %q", text)
+	}
+	*p = SyncCommitteePeriod(v)
+	return nil
+}
+
+// SpansPeriodBoundary returns true if slots belong to different sync committee periods.
+func SpansPeriodBoundary(a, b Slot, spec *ChainSpec) bool {
+	return a.SyncCommitteePeriod(spec) != b.SyncCommitteePeriod(spec)
+}
+
+// LightClientUpdatePeriods returns sync committee periods of the attested header and signature slots
+// of light client update. Update is signed either by the current sync committee of the attested
+// period, or (at the period boundary) by the next one, so an error is returned if signature slot
+// doesn't follow attested slot or falls beyond the next period.
+func LightClientUpdatePeriods(attested, signature Slot, spec *ChainSpec) (attestedPeriod, signaturePeriod SyncCommitteePeriod, err error) {
+	if signature <= attested {
+		return 0, 0, fmt.Errorf("%w: signature slot %d does not follow attested slot %d", ErrInvalidLightClientUpdate, signature, attested)
+	}
+	attestedPeriod, signaturePeriod = attested.SyncCommitteePeriod(spec), signature.SyncCommitteePeriod(spec)
+	if signaturePeriod > attestedPeriod.Next() {
+		return 0, 0, fmt.Errorf("%w: signature period %d is beyond period %d following the attested one",
+			ErrInvalidLightClientUpdate, signaturePeriod, attestedPeriod.Next())
+	}
+	return attestedPeriod, signaturePeriod, nil
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSyncCommitteePeriod(t *testing.T) {
+	spec := &ChainSpec{SlotsPerEpoch: 32, EpochsPerSyncCommitteePeriod: 256}
+	tests := []struct {
+		slot   Slot
+		period SyncCommitteePeriod
+	}{
+		{slot: 0, period: 0},
+		{slot: 8191, period: 0},
+		{slot: 8192, period: 1},
+		{slot: 9_000_000, period: 1098},
+	}
+	for _, tt := range tests {
+		if got := tt.slot.SyncCommitteePeriod(spec); got != tt.period {
+			t.Errorf("Unexpected period of slot %d: %v, want %v", tt.slot, got, tt.period)
+		}
+	}
+
+	p := SyncCommitteePeriod(1098)
+	if p.StartEpoch(spec) != 281088 || p.EndEpoch(spec) != 281343 || p.StartSlot(spec) != 8994816 {
+		t.Errorf("Unexpected period boundaries: %v", p.Epochs(spec))
+	}
+	if !Epoch(281088).IsSyncCommitteePeriodStart(spec) || Epoch(281089).IsSyncCommitteePeriodStart(spec) {
+		t.Error("Unexpected period start")
+	}
+	if !SpansPeriodBoundary(8191, 8192, spec) || SpansPeriodBoundary(8192, 16383, spec) {
+		t.Error("Unexpected period boundary result")
+	}
+
+	var decoded SyncCommitteePeriod
+	if err := decoded.UnmarshalText([]byte("1098")); err != nil || decoded != p || p.String() != "1098" {
+		t.Errorf("Unexpected decoded period: %v, %v", decoded, err)
+	}
+	if err := decoded.UnmarshalText([]byte("x")); err == nil {
+		t.Error("Expected error on malformed period")
+	}
+}
+
+func TestLightClientUpdatePeriods(t *testing.T) {
+	spec := &ChainSpec{SlotsPerEpoch: 32, EpochsPerSyncCommitteePeriod: 256}
+	tests := []struct {
+		attested, signature Slot
+		attestedPeriod      SyncCommitteePeriod
+		signaturePeriod     SyncCommitteePeriod
+		wantErr             bool
+	}{
+		{attested: 100, signature: 101, attestedPeriod: 0, signaturePeriod: 0},
+		{attested: 8191, signature: 8192, attestedPeriod: 0, signaturePeriod: 1},
+		{attested: 8191, signature: 16384, wantErr: true},
+		{attested: 100, signature: 100, wantErr: true},
+	}
+	for _, tt := range tests {
+		attested, signature, err := LightClientUpdatePeriods(tt.attested, tt.signature, spec)
+		if tt.wantErr {
+			if !errors.Is(err, ErrInvalidLightClientUpdate) {
+				t.Errorf("Unexpected error for (%d, %d): %v", tt.attested, tt.signature, err)
+			}
+			continue
+		}
+		if err != nil || attested != tt.attestedPeriod || signature != tt.signaturePeriod {
+			t.Errorf("Unexpected periods for (%d, %d): %v, %v, %v", tt.attested, tt.signature, attested, signature, err)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package types
+
+// DefaultSyncTolerance is the number of slots node may lag behind the wall clock without being
+// considered syncing: the block of the current slot may not have arrived yet.
+const DefaultSyncTolerance = Slot(1)
+
+// SyncStatus is the node sync status, as returned by the Beacon API syncing endpoint.
+type SyncStatus struct {
+	HeadSlot     Slot `json:"head_slot"`
+	SyncDistance Slot `json:"sync_distance"`
+	IsSyncing    bool `json:"is_syncing"`
+	IsOptimistic bool `json:"is_optimistic"`
+	ElOffline    bool `json:"el_offline"`
+}
+
+// NewSyncStatus returns sync status of the node with the given head at the current wall-clock slot,
+// using DefaultSyncTolerance. Optimistic and EL flags are left for the caller to set.
+func NewSyncStatus(head, current Slot) SyncStatus {
+	return NewSyncStatusWithTolerance(head, current, DefaultSyncTolerance)
+}
+
+// NewSyncStatusWithTolerance returns sync status of the node with the given head at the current
+// wall-clock slot, considering node syncing once it lags behind by more than tolerance slots.
+// Head ahead of the current slot (e.g. due to clock skew) yields zero distance.
+func NewSyncStatusWithTolerance(head, current, tolerance Slot) SyncStatus {
+	distance, err := current.SafeSub(uint64(head))
+	if err != nil {
+		distance = 0
+	}
+	return SyncStatus{
+		HeadSlot:     head,
+		SyncDistance: distance,
+		IsSyncing:    distance > tolerance,
+	}
+}
+
+// IsSynced returns true if node is neither syncing nor optimistic, i.e. it can safely serve duties.
+func (s SyncStatus) IsSynced() bool {
+	return !s.IsSyncing && !s.IsOptimistic
+}
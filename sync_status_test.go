@@ -0,0 +1,46 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNewSyncStatus(t *testing.T) {
+	tests := []struct {
+		head, current Slot
+		distance      Slot
+		syncing       bool
+	}{
+		{head: 100, current: 100, distance: 0, syncing: false},
+		{head: 99, current: 100, distance: 1, syncing: false},
+		{head: 98, current: 100, distance: 2, syncing: true},
+		{head: 105, current: 100, distance: 0, syncing: false},
+	}
+	for _, tt := range tests {
+		s := NewSyncStatus(tt.head, tt.current)
+		if s.HeadSlot != tt.head || s.SyncDistance != tt.distance || s.IsSyncing != tt.syncing {
+			t.Errorf("Unexpected sync status for head %d at %d: %+v", tt.head, tt.current, s)
+		}
+	}
+	if s := NewSyncStatusWithTolerance(90, 100, 32); s.IsSyncing || s.SyncDistance != 10 || !s.IsSynced() {
+		t.Errorf("Unexpected sync status: %+v", s)
+	}
+	if s := (SyncStatus{IsOptimistic: true}); s.IsSynced() {
+		t.Error("Optimistic node should not be considered synced")
+	}
+}
+
+func TestSyncStatus_JSON(t *testing.T) {
+	input := `{"head_slot":"1","sync_distance":"1","is_syncing":true,"is_optimistic":true,"el_offline":false}`
+	var s SyncStatus
+	if err := json.Unmarshal([]byte(input), &s); err != nil {
+		t.Fatal(err)
+	}
+	enc, err := json.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(enc) != input {
+		t.Errorf("Unexpected JSON: %s", enc)
+	}
+}
@@ -0,0 +1,33 @@
+package types
+
+import "sort"
+
+// SyncSubnet returns sync committee subnet the committee member at index belongs to.
+// The committee is partitioned into SyncCommitteeSubnetCount equal consecutive ranges.
+func SyncSubnet(index SyncCommitteeIndex, spec *ChainSpec) SubnetID {
+	perSubnet := specDiv(spec, spec.SyncCommitteeSize, spec.SyncCommitteeSubnetCount)
+	return SubnetID(specDiv(spec, uint64(index), perSubnet))
+}
+
+// SyncSubnets returns sorted distinct sync committee subnets of the given committee indices
+// (a validator may occupy several positions in the committee).
+func SyncSubnets(indices []SyncCommitteeIndex, spec *ChainSpec) []SubnetID {
+	subnets := make([]SubnetID, 0, len(indices))
+	seen := make(map[SubnetID]bool, len(indices))
+	for _, index := range indices {
+		subnet := SyncSubnet(index, spec)
+		if !seen[subnet] {
+			seen[subnet] = true
+			subnets = append(subnets, subnet)
+		}
+	}
+	sort.Slice(subnets, func(i, j int) bool {
+		return subnets[i] < subnets[j]
+	})
+	return subnets
+}
+
+// Subnets returns sync committee subnets the validator must participate in.
+func (d *SyncCommitteeDuty) Subnets(spec *ChainSpec) []SubnetID {
+	return SyncSubnets(d.ValidatorSyncCommitteeIndices, spec)
+}
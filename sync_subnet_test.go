@@ -0,0 +1,23 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSyncSubnets(t *testing.T) {
+	spec := &ChainSpec{SyncCommitteeSize: 512, SyncCommitteeSubnetCount: 4}
+	for index, want := range map[SyncCommitteeIndex]SubnetID{0: 0, 127: 0, 128: 1, 300: 2, 511: 3} {
+		if got := SyncSubnet(index, spec); got != want {
+			t.Errorf("SyncSubnet(%d) = %d, want %d", index, got, want)
+		}
+	}
+
+	duty := &SyncCommitteeDuty{ValidatorSyncCommitteeIndices: []SyncCommitteeIndex{400, 5, 100, 130}}
+	if got := duty.Subnets(spec); !reflect.DeepEqual(got, []SubnetID{0, 1, 3}) {
+		t.Errorf("Unexpected subnets: %v", got)
+	}
+	if got := SyncSubnets(nil, spec); len(got) != 0 {
+		t.Errorf("Unexpected subnets: %v", got)
+	}
+}
@@ -0,0 +1,25 @@
+package types
+
+// AttestationTarget returns target epoch of attestation made at the slot, and the epoch boundary
+// slot whose block root is the target root (see get_block_root in the spec).
+func AttestationTarget(slot Slot, spec *ChainSpec) (Epoch, Slot) {
+	epoch := slot.ToEpoch(spec)
+	return epoch, epoch.StartSlot(spec)
+}
+
+// TargetCheckpoint returns target checkpoint of attestation made at the slot, given the head block
+// (which must not be after the slot). If the head is at or before the epoch boundary (e.g. attesting
+// at the first slot of the epoch, or boundary slots were skipped), head root is the target root.
+// Otherwise, ancestorRoot is queried for the root of the head's ancestor at the boundary slot (i.e.
+// the root of the latest block with slot <= boundary).
+func TargetCheckpoint(slot, headSlot Slot, headRoot BlockRoot, ancestorRoot func(Slot) (BlockRoot, error), spec *ChainSpec) (Checkpoint, error) {
+	epoch, boundary := AttestationTarget(slot, spec)
+	if headSlot <= boundary {
+		return Checkpoint{Epoch: epoch, Root: headRoot}, nil
+	}
+	root, err := ancestorRoot(boundary)
+	if err != nil {
+		return Checkpoint{}, err
+	}
+	return Checkpoint{Epoch: epoch, Root: root}, nil
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAttestationTarget(t *testing.T) {
+	spec := &ChainSpec{SlotsPerEpoch: 32}
+	for slot, want := range map[Slot]struct {
+		epoch    Epoch
+		boundary Slot
+	}{0: {0, 0}, 31: {0, 0}, 32: {1, 32}, 70: {2, 64}} {
+		epoch, boundary := AttestationTarget(slot, spec)
+		if epoch != want.epoch || boundary != want.boundary {
+			t.Errorf("AttestationTarget(%d) = (%d, %d), want (%d, %d)", slot, epoch, boundary, want.epoch, want.boundary)
+		}
+	}
+}
+
+func TestTargetCheckpoint(t *testing.T) {
+	spec := &ChainSpec{SlotsPerEpoch: 32}
+	headRoot := BlockRoot{Root{0xaa}}
+	ancestors := func(slot Slot) (BlockRoot, error) {
+		if slot != 64 {
+			t.Fatalf("Unexpected ancestor lookup: %d", slot)
+		}
+		return BlockRoot{Root{0xbb}}, nil
+	}
+	tests := []struct {
+		name           string
+		slot, headSlot Slot
+		want           Checkpoint
+	}{
+		{name: "boundary slot", slot: 64, headSlot: 64, want: Checkpoint{Epoch: 2, Root: headRoot}},
+		{name: "skipped boundary", slot: 66, headSlot: 60, want: Checkpoint{Epoch: 2, Root: headRoot}},
+		{name: "head after boundary", slot: 70, headSlot: 69, want: Checkpoint{Epoch: 2, Root: BlockRoot{Root{0xbb}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TargetCheckpoint(tt.slot, tt.headSlot, headRoot, ancestors, spec)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("TargetCheckpoint() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	errNotFound := errors.New("not found")
+	_, err := TargetCheckpoint(70, 69, headRoot, func(Slot) (BlockRoot, error) { return BlockRoot{}, errNotFound }, spec)
+	if !errors.Is(err, errNotFound) {
+		t.Errorf("Expected lookup error, got: %v", err)
+	}
+}
@@ -0,0 +1,66 @@
+package testutil
+
+import (
+	"math/rand"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+// maxRandomEpoch bounds generated epochs, keeping derived slots far from overflow.
+const maxRandomEpoch = types.Epoch(1 << 32)
+
+// RandomForkVersion returns random fork version.
+func RandomForkVersion(r *rand.Rand) types.ForkVersion {
+	var v types.ForkVersion
+	r.Read(v[:])
+	return v
+}
+
+// RandomCheckpoint returns checkpoint with random epoch and root.
+func RandomCheckpoint(r *rand.Rand) types.Checkpoint {
+	return NewCheckpoint().
+		WithEpoch(types.RandomEpoch(r, maxRandomEpoch)).
+		WithRoot(types.BlockRoot{Root: types.RandomRoot(r)}).
+		Build()
+}
+
+// RandomFork returns fork with distinct random previous and current versions and random epoch.
+func RandomFork(r *rand.Rand) types.Fork {
+	previous := RandomForkVersion(r)
+	current := RandomForkVersion(r)
+	for current == previous {
+		current = RandomForkVersion(r)
+	}
+	return NewFork().
+		WithVersions(previous, current).
+		WithEpoch(types.RandomEpoch(r, maxRandomEpoch)).
+		Build()
+}
+
+// RandomGenesis returns genesis with random validators root and fork version, and genesis time
+// within four years past the mainnet genesis.
+func RandomGenesis(r *rand.Rand) types.Genesis {
+	return NewGenesis().
+		WithTime(1606824023 + uint64(r.Int63n(4*365*24*3600))).
+		WithValidatorsRoot(types.RandomRoot(r)).
+		WithForkVersion(RandomForkVersion(r)).
+		Build()
+}
+
+// RandomAttestationData returns attestation data consistent with the spec: target epoch is the
+// epoch of the attestation slot and source epoch does not exceed the target one.
+func RandomAttestationData(r *rand.Rand, spec *types.ChainSpec) types.AttestationData {
+	target := RandomCheckpoint(r)
+	slot := target.Epoch.StartSlot(spec) + types.RandomSlot(r, spec.SlotsPerEpoch)
+	source := NewCheckpoint().
+		WithEpoch(types.RandomEpochInRange(r, 0, target.Epoch)).
+		WithRoot(types.BlockRoot{Root: types.RandomRoot(r)}).
+		Build()
+	return NewAttestationData().
+		WithSlot(slot).
+		WithIndex(types.CommitteeIndex(r.Intn(64))).
+		WithBeaconBlockRoot(types.BlockRoot{Root: types.RandomRoot(r)}).
+		WithSource(source).
+		WithTarget(target).
+		Build()
+}
@@ -0,0 +1,142 @@
+package testutil
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+// SlotTicker is notified at the start of every simulated slot.
+type SlotTicker interface {
+	OnSlot(ctx context.Context, slot types.Slot) error
+}
+
+// EpochTicker is notified at the start of every simulated epoch.
+type EpochTicker interface {
+	OnEpoch(ctx context.Context, epoch types.Epoch) error
+}
+
+// SlotTickerFunc adapts function to SlotTicker.
+type SlotTickerFunc func(ctx context.Context, slot types.Slot) error
+
+// OnSlot calls f(ctx, slot).
+func (f SlotTickerFunc) OnSlot(ctx context.Context, slot types.Slot) error {
+	return f(ctx, slot)
+}
+
+// EpochTickerFunc adapts function to EpochTicker.
+type EpochTickerFunc func(ctx context.Context, epoch types.Epoch) error
+
+// OnEpoch calls f(ctx, epoch).
+func (f EpochTickerFunc) OnEpoch(ctx context.Context, epoch types.Epoch) error {
+	return f(ctx, epoch)
+}
+
+// Simulator drives a mock clock slot by slot, so that multi-component integration tests can run
+// thousands of slots in milliseconds. At the start of each slot, epoch tickers (on epoch boundaries)
+// and then slot tickers are called sequentially in registration order, which keeps runs
+// deterministic. Components should read time from Clock. Registration must not happen concurrently
+// with Run, while Clock can be read from any goroutine.
+type Simulator struct {
+	spec         *types.ChainSpec
+	clock        *types.Clock
+	compression  uint64
+	slotTickers  []SlotTicker
+	epochTickers []EpochTicker
+
+	lock sync.Mutex
+	now  time.Time
+	next types.Slot
+}
+
+// NewSimulator creates simulator of the chain started at genesis time. Simulated time is set to
+// genesis, and the first run starts with GenesisSlot.
+func NewSimulator(genesis time.Time, spec *types.ChainSpec) *Simulator {
+	s := &Simulator{spec: spec, now: genesis, next: types.GenesisSlot}
+	s.clock = types.NewClock(genesis, spec).WithNow(s.Now)
+	return s
+}
+
+// WithCompression makes each simulated slot last `SecondsPerSlot / factor` of wall-clock time (e.g.
+// factor 12 makes mainnet slots last one second), for components relying on real timers. Zero factor
+// (the default) runs slots back to back without waiting.
+func (s *Simulator) WithCompression(factor uint64) *Simulator {
+	s.compression = factor
+	return s
+}
+
+// OnSlot registers slot ticker.
+func (s *Simulator) OnSlot(t SlotTicker) {
+	s.slotTickers = append(s.slotTickers, t)
+}
+
+// OnEpoch registers epoch ticker.
+func (s *Simulator) OnEpoch(t EpochTicker) {
+	s.epochTickers = append(s.epochTickers, t)
+}
+
+// Clock returns clock reading the simulated time.
+func (s *Simulator) Clock() *types.Clock {
+	return s.clock
+}
+
+// Now returns the simulated time.
+func (s *Simulator) Now() time.Time {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.now
+}
+
+// NextSlot returns slot the next run starts with.
+func (s *Simulator) NextSlot() types.Slot {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.next
+}
+
+// Run simulates the given number of slots, continuing from the previous run. It stops on the first
+// ticker error (returned wrapped with the slot it occurred at), or when ctx is done.
+func (s *Simulator) Run(ctx context.Context, slots uint64) error {
+	var wait time.Duration
+	if s.compression > 0 {
+		wait = time.Duration(s.spec.SecondsPerSlot) * time.Second / time.Duration(s.compression)
+	}
+	for i := uint64(0); i < slots; i++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		s.lock.Lock()
+		slot := s.next
+		s.now = s.clock.SlotStart(slot)
+		s.next++
+		s.lock.Unlock()
+
+		if slot.IsEpochStart(s.spec) {
+			epoch := slot.ToEpoch(s.spec)
+			for _, t := range s.epochTickers {
+				if err := t.OnEpoch(ctx, epoch); err != nil {
+					return fmt.Errorf("epoch %d: %w", epoch, err)
+				}
+			}
+		}
+		for _, t := range s.slotTickers {
+			if err := t.OnSlot(ctx, slot); err != nil {
+				return fmt.Errorf("slot %d: %w", slot, err)
+			}
+		}
+
+		if wait > 0 {
+			timer := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return ctx.Err()
+			case <-timer.C:
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package testutil
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+func TestSimulator(t *testing.T) {
+	genesis := time.Unix(1606824023, 0)
+	spec := &types.ChainSpec{SlotsPerEpoch: 32, SecondsPerSlot: 12}
+	sim := NewSimulator(genesis, spec)
+
+	var events []string
+	var epochs []types.Epoch
+	sim.OnEpoch(EpochTickerFunc(func(_ context.Context, epoch types.Epoch) error {
+		epochs = append(epochs, epoch)
+		events = append(events, "epoch")
+		return nil
+	}))
+	var slots int
+	sim.OnSlot(SlotTickerFunc(func(_ context.Context, slot types.Slot) error {
+		if got := sim.Clock().CurrentSlot(); got != slot {
+			t.Errorf("Unexpected clock slot: %v, want %v", got, slot)
+		}
+		if slots < 2 {
+			events = append(events, "slot")
+		}
+		slots++
+		return nil
+	}))
+
+	start := time.Now()
+	if err := sim.Run(context.Background(), 10_000); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Simulation is too slow: %v", elapsed)
+	}
+	if slots != 10_000 || len(epochs) != 313 || epochs[312] != 312 {
+		t.Errorf("Unexpected ticks: %d slots, %d epochs", slots, len(epochs))
+	}
+	if events[0] != "epoch" || events[1] != "slot" {
+		t.Errorf("Unexpected order of ticks: %v", events[:3])
+	}
+	if sim.NextSlot() != 10_000 || !sim.Now().Equal(sim.Clock().SlotStart(9_999)) {
+		t.Errorf("Unexpected simulated time: %v", sim.Now())
+	}
+
+	errStop := errors.New("stop")
+	sim.OnSlot(SlotTickerFunc(func(_ context.Context, slot types.Slot) error {
+		if slot == 10_005 {
+			return errStop
+		}
+		return nil
+	}))
+	if err := sim.Run(context.Background(), 100); !errors.Is(err, errStop) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if sim.NextSlot() != 10_006 {
+		t.Errorf("Unexpected next slot: %v", sim.NextSlot())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := sim.Run(ctx, 1); !errors.Is(err, context.Canceled) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestSimulator_WithCompression(t *testing.T) {
+	spec := &types.ChainSpec{SlotsPerEpoch: 8, SecondsPerSlot: 1}
+	sim := NewSimulator(time.Unix(0, 0), spec).WithCompression(100)
+	start := time.Now()
+	if err := sim.Run(context.Background(), 3); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < 30*time.Millisecond {
+		t.Errorf("Compressed slots passed too fast: %v", elapsed)
+	}
+}
@@ -0,0 +1,151 @@
+// Package testutil contains fluent builders and random-but-valid generators of container types, so
+// that unit tests do not have to construct them via struct literals with magic values. Simulator
+// drives components of integration tests through simulated slots.
+package testutil
+
+import (
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+// CheckpointBuilder builds Checkpoint, zero valued fields are used unless set.
+type CheckpointBuilder struct {
+	c types.Checkpoint
+}
+
+// NewCheckpoint returns checkpoint builder.
+func NewCheckpoint() *CheckpointBuilder {
+	return &CheckpointBuilder{}
+}
+
+// WithEpoch sets checkpoint epoch.
+func (b *CheckpointBuilder) WithEpoch(epoch types.Epoch) *CheckpointBuilder {
+	b.c.Epoch = epoch
+	return b
+}
+
+// WithRoot sets checkpoint root.
+func (b *CheckpointBuilder) WithRoot(root types.BlockRoot) *CheckpointBuilder {
+	b.c.Root = root
+	return b
+}
+
+// Build returns the checkpoint.
+func (b *CheckpointBuilder) Build() types.Checkpoint {
+	return b.c
+}
+
+// ForkBuilder builds Fork, zero valued fields are used unless set.
+type ForkBuilder struct {
+	f types.Fork
+}
+
+// NewFork returns fork builder.
+func NewFork() *ForkBuilder {
+	return &ForkBuilder{}
+}
+
+// WithPreviousVersion sets previous fork version.
+func (b *ForkBuilder) WithPreviousVersion(v types.ForkVersion) *ForkBuilder {
+	b.f.PreviousVersion = v
+	return b
+}
+
+// WithCurrentVersion sets current fork version.
+func (b *ForkBuilder) WithCurrentVersion(v types.ForkVersion) *ForkBuilder {
+	b.f.CurrentVersion = v
+	return b
+}
+
+// WithVersions sets both previous and current fork versions.
+func (b *ForkBuilder) WithVersions(previous, current types.ForkVersion) *ForkBuilder {
+	return b.WithPreviousVersion(previous).WithCurrentVersion(current)
+}
+
+// WithEpoch sets fork epoch.
+func (b *ForkBuilder) WithEpoch(epoch types.Epoch) *ForkBuilder {
+	b.f.Epoch = epoch
+	return b
+}
+
+// Build returns the fork.
+func (b *ForkBuilder) Build() types.Fork {
+	return b.f
+}
+
+// GenesisBuilder builds Genesis, zero valued fields are used unless set.
+type GenesisBuilder struct {
+	g types.Genesis
+}
+
+// NewGenesis returns genesis builder.
+func NewGenesis() *GenesisBuilder {
+	return &GenesisBuilder{}
+}
+
+// WithTime sets genesis time (in seconds since Unix epoch).
+func (b *GenesisBuilder) WithTime(t uint64) *GenesisBuilder {
+	b.g.GenesisTime = t
+	return b
+}
+
+// WithValidatorsRoot sets genesis validators root.
+func (b *GenesisBuilder) WithValidatorsRoot(root types.Root) *GenesisBuilder {
+	b.g.GenesisValidatorsRoot = root
+	return b
+}
+
+// WithForkVersion sets genesis fork version.
+func (b *GenesisBuilder) WithForkVersion(v types.ForkVersion) *GenesisBuilder {
+	b.g.GenesisForkVersion = v
+	return b
+}
+
+// Build returns the genesis.
+func (b *GenesisBuilder) Build() types.Genesis {
+	return b.g
+}
+
+// AttestationDataBuilder builds AttestationData, zero valued fields are used unless set.
+type AttestationDataBuilder struct {
+	d types.AttestationData
+}
+
+// NewAttestationData returns attestation data builder.
+func NewAttestationData() *AttestationDataBuilder {
+	return &AttestationDataBuilder{}
+}
+
+// WithSlot sets attestation slot.
+func (b *AttestationDataBuilder) WithSlot(slot types.Slot) *AttestationDataBuilder {
+	b.d.Slot = slot
+	return b
+}
+
+// WithIndex sets committee index.
+func (b *AttestationDataBuilder) WithIndex(index types.CommitteeIndex) *AttestationDataBuilder {
+	b.d.Index = index
+	return b
+}
+
+// WithBeaconBlockRoot sets root of the attested block.
+func (b *AttestationDataBuilder) WithBeaconBlockRoot(root types.BlockRoot) *AttestationDataBuilder {
+	b.d.BeaconBlockRoot = root
+	return b
+}
+
+// WithSource sets source checkpoint.
+func (b *AttestationDataBuilder) WithSource(c types.Checkpoint) *AttestationDataBuilder {
+	b.d.Source = c
+	return b
+}
+
+// WithTarget sets target checkpoint.
+func (b *AttestationDataBuilder) WithTarget(c types.Checkpoint) *AttestationDataBuilder {
+	b.d.Target = c
+	return b
+}
+
+// Build returns the attestation data.
+func (b *AttestationDataBuilder) Build() types.AttestationData {
+	return b.d
+}
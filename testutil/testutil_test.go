@@ -0,0 +1,46 @@
+package testutil
+
+import (
+	"math/rand"
+	"testing"
+
+	types "github.com/farazdagi/prysm-shared-types"
+)
+
+func TestBuilders(t *testing.T) {
+	cp := NewCheckpoint().WithEpoch(5).WithRoot(types.BlockRoot{Root: types.Root{0x01}}).Build()
+	if cp != (types.Checkpoint{Epoch: 5, Root: types.BlockRoot{Root: types.Root{0x01}}}) {
+		t.Errorf("Unexpected checkpoint: %+v", cp)
+	}
+	fork := NewFork().WithVersions(types.ForkVersion{0x01}, types.ForkVersion{0x02}).WithEpoch(10).Build()
+	if fork != (types.Fork{PreviousVersion: types.ForkVersion{0x01}, CurrentVersion: types.ForkVersion{0x02}, Epoch: 10}) {
+		t.Errorf("Unexpected fork: %+v", fork)
+	}
+	genesis := NewGenesis().WithTime(100).WithValidatorsRoot(types.Root{0x02}).WithForkVersion(types.ForkVersion{0x03}).Build()
+	if genesis != (types.Genesis{GenesisTime: 100, GenesisValidatorsRoot: types.Root{0x02}, GenesisForkVersion: types.ForkVersion{0x03}}) {
+		t.Errorf("Unexpected genesis: %+v", genesis)
+	}
+	data := NewAttestationData().WithSlot(33).WithIndex(2).WithBeaconBlockRoot(types.BlockRoot{Root: types.Root{0x04}}).
+		WithSource(NewCheckpoint().WithEpoch(0).Build()).WithTarget(cp).Build()
+	want := types.AttestationData{Slot: 33, Index: 2, BeaconBlockRoot: types.BlockRoot{Root: types.Root{0x04}}, Target: cp}
+	if data != want {
+		t.Errorf("Unexpected attestation data: %+v", data)
+	}
+}
+
+func TestRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	spec := &types.ChainSpec{SlotsPerEpoch: 32}
+	for i := 0; i < 100; i++ {
+		if fork := RandomFork(r); fork.PreviousVersion == fork.CurrentVersion {
+			t.Errorf("Fork versions must differ: %+v", fork)
+		}
+		if genesis := RandomGenesis(r); genesis.GenesisValidatorsRoot.IsZero() || genesis.GenesisTime < 1606824023 {
+			t.Errorf("Unexpected genesis: %+v", genesis)
+		}
+		data := RandomAttestationData(r, spec)
+		if data.Slot.ToEpoch(spec) != data.Target.Epoch || data.Source.Epoch > data.Target.Epoch {
+			t.Errorf("Inconsistent attestation data: %+v", data)
+		}
+	}
+}
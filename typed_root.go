@@ -0,0 +1,34 @@
+package types
+
+// TypedRoot is a Root tagged with the kind of object it is the root of, so that roots of different
+// kinds (block, state, body) are distinct types and cannot be passed one for another. All methods of
+// Root (encoding, SSZ, comparison) are available through the embedded field, while the untyped root
+// is accessible as r.Root.
+type TypedRoot[K rootKind] struct {
+	Root
+}
+
+// Kinds of typed roots.
+type (
+	blockRootKind struct{}
+	stateRootKind struct{}
+	bodyRootKind  struct{}
+)
+
+type rootKind interface {
+	blockRootKind | stateRootKind | bodyRootKind
+}
+
+// BlockRoot is the hash tree root of a beacon block (equivalently, of its header).
+type BlockRoot = TypedRoot[blockRootKind]
+
+// StateRoot is the hash tree root of a beacon state.
+type StateRoot = TypedRoot[stateRootKind]
+
+// BodyRoot is the hash tree root of a beacon block body.
+type BodyRoot = TypedRoot[bodyRootKind]
+
+// Equal returns true if both roots are equal.
+func (r TypedRoot[K]) Equal(x TypedRoot[K]) bool {
+	return r == x
+}
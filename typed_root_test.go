@@ -0,0 +1,53 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestTypedRoot_JSON(t *testing.T) {
+	root := StateRoot{Root{0xab, 0xcd}}
+	enc, err := json.Marshal(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(enc) != `"`+root.Root.String()+`"` {
+		t.Errorf("Unexpected JSON: %s", enc)
+	}
+	var decoded StateRoot
+	if err := json.Unmarshal(enc, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !decoded.Equal(root) || decoded.IsZero() {
+		t.Errorf("Unexpected root: %v", decoded)
+	}
+}
+
+func TestTypedRoot_SSZ(t *testing.T) {
+	root := BodyRoot{Root{0x01}}
+	enc, err := root.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded BodyRoot
+	if err := decoded.UnmarshalSSZ(enc); err != nil {
+		t.Fatal(err)
+	}
+	if decoded != root {
+		t.Errorf("Unexpected root: %v", decoded)
+	}
+	if htr, _ := root.HashTreeRoot(); htr != root.Root {
+		t.Errorf("Unexpected hash root: %#x", htr)
+	}
+}
+
+func TestBeaconBlockHeader_BlockRoot(t *testing.T) {
+	h := &testBlockHeader().Message
+	want, err := h.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := h.BlockRoot(); err != nil || got.Root != want {
+		t.Errorf("Unexpected block root: %v, %v", got, err)
+	}
+}
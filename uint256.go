@@ -0,0 +1,143 @@
+package types
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// ErrInvalidUint256 is returned when value cannot be represented as an unsigned 256-bit integer.
+var ErrInvalidUint256 = errors.New("invalid uint256 value")
+
+// Uint256 is an unsigned 256-bit integer stored as four little-endian 64-bit limbs (the least
+// significant limb first). Arithmetic is left to math/big, see Big and Uint256FromBig.
+type Uint256 [4]uint64
+
+// NewUint256 returns value holding x.
+func NewUint256(x uint64) Uint256 {
+	return Uint256{x}
+}
+
+// Uint256FromBig converts x, returns an error if x is negative or doesn't fit into 256 bits.
+func Uint256FromBig(x *big.Int) (Uint256, error) {
+	if x.Sign() < 0 || x.BitLen() > 256 {
+		return Uint256{}, fmt.Errorf("%w: %s", ErrInvalidUint256, x)
+	}
+	var buf [32]byte
+	x.FillBytes(buf[:])
+	var v Uint256
+	for i := range v {
+		v[i] = binary.BigEndian.Uint64(buf[24-i*8:])
+	}
+	return v, nil
+}
+
+// ParseUint256 parses decimal representation of the value.
+func ParseUint256(s string) (Uint256, error) {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return Uint256{}, fmt.Errorf("%w: %q", ErrInvalidUint256, s)
+		}
+	}
+	x, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return Uint256{}, fmt.Errorf("%w: %q", ErrInvalidUint256, s)
+	}
+	return Uint256FromBig(x)
+}
+
+// Big returns value as a big integer.
+func (v Uint256) Big() *big.Int {
+	var buf [32]byte
+	for i := range v {
+		binary.BigEndian.PutUint64(buf[24-i*8:], v[i])
+	}
+	return new(big.Int).SetBytes(buf[:])
+}
+
+// IsZero returns true if value is zero.
+func (v Uint256) IsZero() bool {
+	return v == Uint256{}
+}
+
+// IsUint64 returns true if value fits into uint64.
+func (v Uint256) IsUint64() bool {
+	return v[1] == 0 && v[2] == 0 && v[3] == 0
+}
+
+// Compare returns an integer comparing two values (-1, 0 or +1).
+func (v Uint256) Compare(x Uint256) int {
+	for i := len(v) - 1; i >= 0; i-- {
+		switch {
+		case v[i] < x[i]:
+			return -1
+		case v[i] > x[i]:
+			return 1
+		}
+	}
+	return 0
+}
+
+// String returns decimal representation of the value.
+func (v Uint256) String() string {
+	return v.Big().String()
+}
+
+// MarshalText encodes value as a decimal string.
+func (v Uint256) MarshalText() ([]byte, error) {
+	return v.Big().Append(nil, 10), nil
+}
+
+// UnmarshalText decodes value from a decimal string.
+func (v *Uint256) UnmarshalText(text []byte) error {
+	x, err := ParseUint256(string(text))
+	if err != nil {
+		return err
+	}
+	*v = x
+	return nil
+}
+
+// HashTreeRoot returns calculated hash root (which is the serialized value itself).
+func (v Uint256) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	v.AppendSSZ(root[:0])
+	return root, nil
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the value.
+func (v *Uint256) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), v.SizeSSZ()); err != nil {
+		return err
+	}
+	for i := range v {
+		v[i] = binary.LittleEndian.Uint64(buf[i*8:])
+	}
+	return nil
+}
+
+// MarshalSSZTo marshals value with the provided byte slice.
+func (v *Uint256) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return v.AppendSSZ(dst), nil
+}
+
+// MarshalSSZ marshals value into a serialized object.
+func (v *Uint256) MarshalSSZ() ([]byte, error) {
+	return v.AppendSSZ(make([]byte, 0, v.SizeSSZ())), nil
+}
+
+// AppendSSZ appends little-endian serialized value to dst.
+func (v Uint256) AppendSSZ(dst []byte) []byte {
+	for i := range v {
+		dst = sszutil.AppendUint64(dst, v[i])
+	}
+	return dst
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (v *Uint256) SizeSSZ() int {
+	return 32
+}
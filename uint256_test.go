@@ -0,0 +1,64 @@
+package types
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestUint256_Big(t *testing.T) {
+	max := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	for _, s := range []string{"0", "1", "18446744073709551616", max.String()} {
+		v, err := ParseUint256(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v.String() != s {
+			t.Errorf("Unexpected value: %v, want %s", v, s)
+		}
+	}
+	for _, s := range []string{"", "-1", "+1", "0x10", "1.5", new(big.Int).Add(max, big.NewInt(1)).String()} {
+		if _, err := ParseUint256(s); !errors.Is(err, ErrInvalidUint256) {
+			t.Errorf("Expected error for %q, got %v", s, err)
+		}
+	}
+	if v, err := ParseUint256("18446744073709551616"); err != nil || v != (Uint256{0, 1}) || v.IsUint64() {
+		t.Errorf("Unexpected value: %v, %v", v, err)
+	}
+}
+
+func TestUint256_Compare(t *testing.T) {
+	tests := []struct {
+		a, b Uint256
+		want int
+	}{
+		{Uint256{}, Uint256{}, 0},
+		{Uint256{1}, Uint256{2}, -1},
+		{Uint256{0, 1}, Uint256{^uint64(0)}, 1},
+		{Uint256{5, 0, 0, 1}, Uint256{5, 0, 1, 1}, -1},
+	}
+	for _, tt := range tests {
+		if got := tt.a.Compare(tt.b); got != tt.want {
+			t.Errorf("Unexpected comparison of %v and %v: %d", tt.a, tt.b, got)
+		}
+	}
+}
+
+func TestUint256_SSZ(t *testing.T) {
+	v := Uint256{1, 2, 3, 4}
+	enc, err := v.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(enc) != 32 || enc[0] != 1 || enc[8] != 2 || enc[24] != 4 {
+		t.Errorf("Unexpected encoding: %x", enc)
+	}
+	var decoded Uint256
+	if err := decoded.UnmarshalSSZ(enc); err != nil || decoded != v {
+		t.Errorf("Unexpected value: %v, %v", decoded, err)
+	}
+	if root, _ := v.HashTreeRoot(); !bytes.Equal(root[:], enc) {
+		t.Errorf("Unexpected root: %#x", root)
+	}
+}
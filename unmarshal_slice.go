@@ -0,0 +1,40 @@
+package types
+
+import "unsafe"
+
+// UnmarshalSlotSlice decodes contiguous SSZ buffer of slots (e.g. a serialized list) in one pass.
+func UnmarshalSlotSlice(buf []byte) ([]Slot, error) {
+	return unmarshalUint64List[Slot](buf)
+}
+
+// UnmarshalEpochSlice decodes contiguous SSZ buffer of epochs in one pass.
+func UnmarshalEpochSlice(buf []byte) ([]Epoch, error) {
+	return unmarshalUint64List[Epoch](buf)
+}
+
+// UnmarshalValidatorIndexSlice decodes contiguous SSZ buffer of validator indices in one pass.
+func UnmarshalValidatorIndexSlice(buf []byte) ([]ValidatorIndex, error) {
+	return unmarshalUint64List[ValidatorIndex](buf)
+}
+
+// UnmarshalGweiSlice decodes contiguous SSZ buffer of balances in one pass.
+func UnmarshalGweiSlice(buf []byte) ([]Gwei, error) {
+	return unmarshalUint64List[Gwei](buf)
+}
+
+// AliasUint64Slice returns values of contiguous SSZ buffer without copying, whenever platform is
+// little-endian and buffer is 8-byte aligned (otherwise values are decoded into a new slice).
+// If aliased, the result shares memory with buf: modifying either is visible through the other,
+// and buf must not be reused while the result is in use.
+func AliasUint64Slice[T Uint64Like](buf []byte) ([]T, error) {
+	if len(buf)%8 != 0 || len(buf) == 0 || !littleEndian || uintptr(unsafe.Pointer(&buf[0]))%8 != 0 {
+		return unmarshalUint64List[T](buf)
+	}
+	return unsafe.Slice((*T)(unsafe.Pointer(&buf[0])), len(buf)/8), nil
+}
+
+// littleEndian is true if the platform stores integers in little-endian byte order (as SSZ does).
+var littleEndian = func() bool {
+	x := uint16(1)
+	return *(*byte)(unsafe.Pointer(&x)) == 1
+}()
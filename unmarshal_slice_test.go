@@ -0,0 +1,68 @@
+package types
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestUnmarshalSlotSlice(t *testing.T) {
+	buf := marshalUint64List(nil, []Slot{1, 2, 1 << 40})
+	slots, err := UnmarshalSlotSlice(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(slots, []Slot{1, 2, 1 << 40}) {
+		t.Errorf("Unexpected slots: %v", slots)
+	}
+	indices, err := UnmarshalValidatorIndexSlice(buf)
+	if err != nil || len(indices) != 3 || indices[2] != 1<<40 {
+		t.Errorf("Unexpected indices: %v (%v)", indices, err)
+	}
+	if _, err := UnmarshalEpochSlice(buf[:7]); err == nil {
+		t.Error("Expected error for truncated buffer")
+	}
+}
+
+func TestAliasUint64Slice(t *testing.T) {
+	buf := marshalUint64List(nil, []Gwei{10, 20, 30})
+	values, err := AliasUint64Slice[Gwei](buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(values, []Gwei{10, 20, 30}) {
+		t.Errorf("Unexpected values: %v", values)
+	}
+
+	// Misaligned buffers are decoded by copying.
+	raw := make([]byte, 9)
+	copy(raw[1:], marshalUint64List(nil, []Gwei{7}))
+	values, err = AliasUint64Slice[Gwei](raw[1:])
+	if err != nil || len(values) != 1 || values[0] != 7 {
+		t.Errorf("Unexpected values: %v (%v)", values, err)
+	}
+
+	if values, err := AliasUint64Slice[Gwei](nil); err != nil || len(values) != 0 {
+		t.Errorf("Unexpected values: %v (%v)", values, err)
+	}
+	if _, err := AliasUint64Slice[Gwei](buf[:5]); err == nil {
+		t.Error("Expected error for truncated buffer")
+	}
+}
+
+func BenchmarkUnmarshalGweiSlice(b *testing.B) {
+	buf := marshalUint64List(nil, make([]Gwei, 1<<16))
+	b.Run("copy", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := UnmarshalGweiSlice(buf); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+	b.Run("alias", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if _, err := AliasUint64Slice[Gwei](buf); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// ErrValidatorIndexOutOfRange is returned when validator index is beyond the registry bounds.
+var ErrValidatorIndexOutOfRange = errors.New("validator index out of range")
+
+// ValidatorIndex represents index of a validator in the registry.
+// Common methods (arithmetic, encoding) are generated, see validator_index_gen.go.
+type ValidatorIndex uint64
+
+// NumValidators is the size of the validator registry, valid indices are in [0, NumValidators) range.
+type NumValidators uint64
+
+// NewValidatorIndex returns index i, or an error if it is beyond the registry bounds.
+func NewValidatorIndex(i uint64, bounds NumValidators) (ValidatorIndex, error) {
+	index := ValidatorIndex(i)
+	if err := bounds.Check(index); err != nil {
+		return 0, err
+	}
+	return index, nil
+}
+
+// ParseValidatorIndex parses decimal validator index (as found in API paths and queries), checking
+// it against the registry bounds.
+func ParseValidatorIndex(s string, bounds NumValidators) (ValidatorIndex, error) {
+	i, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid validator index %q", s)
+	}
+	return NewValidatorIndex(i, bounds)
+}
+
+// Contains returns true if index is within the registry bounds.
+func (n NumValidators) Contains(index ValidatorIndex) bool {
+	return uint64(index) < uint64(n)
+}
+
+// Check returns an error if index is beyond the registry bounds.
+func (n NumValidators) Check(index ValidatorIndex) error {
+	if !n.Contains(index) {
+		return fmt.Errorf("%w: %d, registry size %d", ErrValidatorIndexOutOfRange, index, n)
+	}
+	return nil
+}
+
+// CheckAll returns an error for the first of the indices beyond the registry bounds.
+func (n NumValidators) CheckAll(indices []ValidatorIndex) error {
+	for _, index := range indices {
+		if err := n.Check(index); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,17 @@
+// Code generated by typegen. DO NOT EDIT.
+
+//go:build !nofastssz
+
+package types
+
+import fssz "github.com/ferranbt/fastssz"
+
+var _ fssz.HashRoot = (ValidatorIndex)(0)
+var _ fssz.Marshaler = (*ValidatorIndex)(nil)
+var _ fssz.Unmarshaler = (*ValidatorIndex)(nil)
+
+// HashTreeRootWith appends validator index to the provided hasher.
+func (v ValidatorIndex) HashTreeRootWith(hh *fssz.Hasher) error {
+	hh.PutUint64(uint64(v))
+	return nil
+}
@@ -0,0 +1,244 @@
+// Code generated by typegen. DO NOT EDIT.
+
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/farazdagi/prysm-shared-types/mathutil"
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// IsZero returns true if validator index has zero value.
+func (v ValidatorIndex) IsZero() bool {
+	return v == 0
+}
+
+// Add increases validator index by x, panics on overflow.
+func (v ValidatorIndex) Add(x uint64) ValidatorIndex {
+	return v.AddValidatorIndex(ValidatorIndex(x))
+}
+
+// AddValidatorIndex increases validator index by another validator index, panics on overflow.
+func (v ValidatorIndex) AddValidatorIndex(x ValidatorIndex) ValidatorIndex {
+	return mathutil.Add(v, x)
+}
+
+// SafeAdd increases validator index by x, returns an error on overflow.
+func (v ValidatorIndex) SafeAdd(x uint64) (ValidatorIndex, error) {
+	return mathutil.SafeAdd(v, ValidatorIndex(x))
+}
+
+// Sub subtracts x from the validator index, panics on underflow.
+func (v ValidatorIndex) Sub(x uint64) ValidatorIndex {
+	return v.SubValidatorIndex(ValidatorIndex(x))
+}
+
+// SubValidatorIndex finds difference between two validator index values, panics on underflow.
+func (v ValidatorIndex) SubValidatorIndex(x ValidatorIndex) ValidatorIndex {
+	return mathutil.Sub(v, x)
+}
+
+// SafeSub subtracts x from the validator index, returns an error on underflow.
+func (v ValidatorIndex) SafeSub(x uint64) (ValidatorIndex, error) {
+	return mathutil.SafeSub(v, ValidatorIndex(x))
+}
+
+// AddInt shifts validator index by signed offset x, returns an error if result overflows or goes below zero.
+func (v ValidatorIndex) AddInt(x int64) (ValidatorIndex, error) {
+	return mathutil.SafeAddInt(v, x)
+}
+
+// Mul multiplies validator index by x, panics on overflow.
+func (v ValidatorIndex) Mul(x uint64) ValidatorIndex {
+	return v.MulValidatorIndex(ValidatorIndex(x))
+}
+
+// MulValidatorIndex multiplies validator index by another validator index, panics on overflow.
+func (v ValidatorIndex) MulValidatorIndex(x ValidatorIndex) ValidatorIndex {
+	return mathutil.Mul(v, x)
+}
+
+// SafeMul multiplies validator index by x, returns an error on overflow.
+func (v ValidatorIndex) SafeMul(x uint64) (ValidatorIndex, error) {
+	return mathutil.SafeMul(v, ValidatorIndex(x))
+}
+
+// Div divides validator index by x, panics if x is zero.
+func (v ValidatorIndex) Div(x uint64) ValidatorIndex {
+	return v.DivValidatorIndex(ValidatorIndex(x))
+}
+
+// DivValidatorIndex divides validator index by another validator index, panics if x is zero.
+func (v ValidatorIndex) DivValidatorIndex(x ValidatorIndex) ValidatorIndex {
+	return mathutil.Div(v, x)
+}
+
+// SafeDiv divides validator index by x, returns an error if x is zero.
+func (v ValidatorIndex) SafeDiv(x uint64) (ValidatorIndex, error) {
+	return mathutil.SafeDiv(v, ValidatorIndex(x))
+}
+
+// Mod returns result of `validator index % x`, panics if x is zero.
+func (v ValidatorIndex) Mod(x uint64) ValidatorIndex {
+	return v.ModValidatorIndex(ValidatorIndex(x))
+}
+
+// ModValidatorIndex returns result of `validator index % validator index`, panics if x is zero.
+func (v ValidatorIndex) ModValidatorIndex(x ValidatorIndex) ValidatorIndex {
+	return mathutil.Mod(v, x)
+}
+
+// SafeMod returns result of `validator index % x`, returns an error if x is zero.
+func (v ValidatorIndex) SafeMod(x uint64) (ValidatorIndex, error) {
+	return mathutil.SafeMod(v, ValidatorIndex(x))
+}
+
+// Compare returns an integer comparing two validator index values (-1, 0 or +1).
+func (v ValidatorIndex) Compare(x ValidatorIndex) int {
+	switch {
+	case v < x:
+		return -1
+	case v > x:
+		return 1
+	}
+	return 0
+}
+
+// IsAfter returns true if validator index is strictly greater than x.
+func (v ValidatorIndex) IsAfter(x ValidatorIndex) bool {
+	return v > x
+}
+
+// IsBefore returns true if validator index is strictly less than x.
+func (v ValidatorIndex) IsBefore(x ValidatorIndex) bool {
+	return v < x
+}
+
+// WithinN returns true if validator index is at most n away from x (in either direction).
+func (v ValidatorIndex) WithinN(x ValidatorIndex, n uint64) bool {
+	if v > x {
+		return uint64(v-x) <= n
+	}
+	return uint64(x-v) <= n
+}
+
+// String returns decimal representation of the validator index.
+func (v ValidatorIndex) String() string {
+	return strconv.FormatUint(uint64(v), 10)
+}
+
+// PaddedString returns decimal representation of the validator index, left-padded with zeros to width digits,
+// so that values sort lexicographically (e.g. in file names and object keys). Wider values are not truncated.
+func (v ValidatorIndex) PaddedString(width int) string {
+	return string(v.AppendPadded(nil, width))
+}
+
+// AppendPadded appends zero-padded decimal representation of the validator index to dst, see PaddedString.
+func (v ValidatorIndex) AppendPadded(dst []byte, width int) []byte {
+	var digits [20]byte
+	b := strconv.AppendUint(digits[:0], uint64(v), 10)
+	for i := len(b); i < width; i++ {
+		dst = append(dst, '0')
+	}
+	return append(dst, b...)
+}
+
+// MarshalText encodes validator index as a decimal string.
+func (v ValidatorIndex) MarshalText() ([]byte, error) {
+	return strconv.AppendUint(nil, uint64(v), 10), nil
+}
+
+// UnmarshalText decodes validator index from a decimal string.
+func (v *ValidatorIndex) UnmarshalText(data []byte) error {
+	parsed, err := strconv.ParseUint(string(data), 10, 64)
+	if err != nil {
+		return fmt.Errorf("could not parse validator index: %w", err)
+	}
+	*v = ValidatorIndex(parsed)
+	return nil
+}
+
+// MarshalJSON encodes validator index as a quoted decimal string (as expected by the Beacon API).
+func (v ValidatorIndex) MarshalJSON() ([]byte, error) {
+	data := make([]byte, 0, 22)
+	data = append(data, '"')
+	data = strconv.AppendUint(data, uint64(v), 10)
+	return append(data, '"'), nil
+}
+
+// UnmarshalJSON decodes validator index from either a quoted decimal string or a JSON number.
+func (v *ValidatorIndex) UnmarshalJSON(data []byte) error {
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		data = data[1 : len(data)-1]
+	}
+	return v.UnmarshalText(data)
+}
+
+// HashTreeRoot returns calculated hash root.
+// Root of a basic uint64 value is its little-endian encoding padded to 32 bytes, so no hashing is involved.
+func (v ValidatorIndex) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	binary.LittleEndian.PutUint64(root[:8], uint64(v))
+	return root, nil
+}
+
+// HashTreeRootInto writes hash root into dst, avoiding copying of the returned array.
+func (v ValidatorIndex) HashTreeRootInto(dst *[32]byte) error {
+	*dst = [32]byte{}
+	binary.LittleEndian.PutUint64(dst[:8], uint64(v))
+	return nil
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the validator index object.
+func (v *ValidatorIndex) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), v.SizeSSZ()); err != nil {
+		return err
+	}
+	*v = ValidatorIndex(binary.LittleEndian.Uint64(buf))
+	return nil
+}
+
+// MarshalSSZTo marshals validator index with the provided byte slice.
+func (v *ValidatorIndex) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return v.AppendSSZ(dst), nil
+}
+
+// MarshalSSZ marshals validator index into a serialized object.
+func (v *ValidatorIndex) MarshalSSZ() ([]byte, error) {
+	return v.AppendSSZ(make([]byte, 0, 8)), nil
+}
+
+// AppendSSZ appends serialized validator index to dst, allocating only if dst has no spare capacity.
+func (v ValidatorIndex) AppendSSZ(dst []byte) []byte {
+	return append(dst, byte(v), byte(v>>8), byte(v>>16), byte(v>>24),
+		byte(v>>32), byte(v>>40), byte(v>>48), byte(v>>56))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (v *ValidatorIndex) SizeSSZ() int {
+	return 8
+}
+
+// WriteTo writes SSZ serialized validator index to w.
+func (v ValidatorIndex) WriteTo(w io.Writer) (int64, error) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], uint64(v))
+	n, err := w.Write(buf[:])
+	return int64(n), err
+}
+
+// ReadFrom reads SSZ serialized validator index from r.
+// Exactly 8 bytes are consumed, so values can be read one after another from the same stream.
+func (v *ValidatorIndex) ReadFrom(r io.Reader) (int64, error) {
+	var buf [8]byte
+	n, err := io.ReadFull(r, buf[:])
+	if err != nil {
+		return int64(n), err
+	}
+	*v = ValidatorIndex(binary.LittleEndian.Uint64(buf[:]))
+	return int64(n), nil
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNewValidatorIndex(t *testing.T) {
+	bounds := NumValidators(10)
+	if i, err := NewValidatorIndex(9, bounds); err != nil || i != 9 {
+		t.Errorf("Unexpected index: %v, %v", i, err)
+	}
+	if _, err := NewValidatorIndex(10, bounds); !errors.Is(err, ErrValidatorIndexOutOfRange) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if _, err := NewValidatorIndex(0, 0); !errors.Is(err, ErrValidatorIndexOutOfRange) {
+		t.Errorf("Empty registry must contain no indices, got: %v", err)
+	}
+
+	tests := []struct {
+		input   string
+		want    ValidatorIndex
+		wantErr bool
+	}{
+		{input: "0", want: 0},
+		{input: "9", want: 9},
+		{input: "10", wantErr: true},
+		{input: "-1", wantErr: true},
+		{input: "0x01", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseValidatorIndex(tt.input, bounds)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("Unexpected result for %q: %v, %v", tt.input, got, err)
+		}
+	}
+
+	if err := bounds.CheckAll([]ValidatorIndex{0, 5, 9}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if err := bounds.CheckAll([]ValidatorIndex{0, 15, 9}); !errors.Is(err, ErrValidatorIndexOutOfRange) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
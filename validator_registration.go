@@ -0,0 +1,159 @@
+package types
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// ValidatorRegistration is the builder API ValidatorRegistrationV1 container: validator's preferences
+// for payloads built on its behalf.
+type ValidatorRegistration struct {
+	FeeRecipient ExecutionAddress `json:"fee_recipient"`
+	GasLimit     GasLimit         `json:"gas_limit"`
+	Timestamp    uint64           `json:"timestamp,string"`
+	Pubkey       BLSPubkey        `json:"pubkey"`
+}
+
+// SignedValidatorRegistration is the builder API SignedValidatorRegistrationV1 container.
+type SignedValidatorRegistration struct {
+	Message   ValidatorRegistration `json:"message"`
+	Signature BLSSignature          `json:"signature"`
+}
+
+// SigningRoot returns root signed by the validator. Registrations are signed under the application
+// builder domain, computed using the genesis fork version and zero genesis validators root.
+func (r *ValidatorRegistration) SigningRoot(genesisForkVersion ForkVersion) (Root, error) {
+	domain, err := ComputeDomain(DomainApplicationBuilder, genesisForkVersion, Root{})
+	if err != nil {
+		return Root{}, err
+	}
+	return ComputeSigningRoot(r, domain)
+}
+
+// HashTreeRoot returns calculated hash root.
+func (r *ValidatorRegistration) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	err := r.HashTreeRootInto(&root)
+	return root, err
+}
+
+// AppendFieldRoots appends hash roots of registration fields to dst.
+func (r *ValidatorRegistration) AppendFieldRoots(dst [][32]byte) ([][32]byte, error) {
+	var chunks [4][32]byte
+	_ = r.FeeRecipient.HashTreeRootInto(&chunks[0])
+	binary.LittleEndian.PutUint64(chunks[1][:8], uint64(r.GasLimit))
+	binary.LittleEndian.PutUint64(chunks[2][:8], r.Timestamp)
+	if err := r.Pubkey.HashTreeRootInto(&chunks[3]); err != nil {
+		return dst, err
+	}
+	return append(dst, chunks[:]...), nil
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (r *ValidatorRegistration) HashTreeRootInto(dst *[32]byte) error {
+	var buf [4][32]byte
+	chunks, err := r.AppendFieldRoots(buf[:0])
+	if err != nil {
+		return err
+	}
+	return merkleizeInto(dst, chunks, 0)
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the registration object.
+func (r *ValidatorRegistration) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), r.SizeSSZ()); err != nil {
+		return err
+	}
+	copy(r.FeeRecipient[:], buf[:20])
+	r.GasLimit = GasLimit(binary.LittleEndian.Uint64(buf[20:28]))
+	r.Timestamp = binary.LittleEndian.Uint64(buf[28:36])
+	copy(r.Pubkey[:], buf[36:84])
+	return nil
+}
+
+// MarshalSSZTo marshals registration with the provided byte slice.
+func (r *ValidatorRegistration) MarshalSSZTo(dst []byte) ([]byte, error) {
+	dst = append(dst, r.FeeRecipient[:]...)
+	dst = sszutil.AppendUint64(dst, uint64(r.GasLimit))
+	dst = sszutil.AppendUint64(dst, r.Timestamp)
+	return append(dst, r.Pubkey[:]...), nil
+}
+
+// MarshalSSZ marshals registration into a serialized object.
+func (r *ValidatorRegistration) MarshalSSZ() ([]byte, error) {
+	return r.MarshalSSZTo(make([]byte, 0, r.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (r *ValidatorRegistration) SizeSSZ() int {
+	return 84
+}
+
+// WriteTo writes SSZ serialized registration to w.
+func (r *ValidatorRegistration) WriteTo(w io.Writer) (int64, error) {
+	return writeSSZTo(w, r)
+}
+
+// ReadFrom reads SSZ serialized registration from reader (exactly SizeSSZ bytes are consumed).
+func (r *ValidatorRegistration) ReadFrom(reader io.Reader) (int64, error) {
+	return readSSZFrom(reader, r)
+}
+
+// HashTreeRoot returns calculated hash root.
+func (r *SignedValidatorRegistration) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	err := r.HashTreeRootInto(&root)
+	return root, err
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (r *SignedValidatorRegistration) HashTreeRootInto(dst *[32]byte) error {
+	var chunks [2][32]byte
+	if err := r.Message.HashTreeRootInto(&chunks[0]); err != nil {
+		return err
+	}
+	if err := r.Signature.HashTreeRootInto(&chunks[1]); err != nil {
+		return err
+	}
+	return merkleizeInto(dst, chunks[:], 0)
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the signed registration object.
+func (r *SignedValidatorRegistration) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), r.SizeSSZ()); err != nil {
+		return err
+	}
+	if err := r.Message.UnmarshalSSZ(buf[:84]); err != nil {
+		return err
+	}
+	copy(r.Signature[:], buf[84:180])
+	return nil
+}
+
+// MarshalSSZTo marshals signed registration with the provided byte slice.
+func (r *SignedValidatorRegistration) MarshalSSZTo(dst []byte) ([]byte, error) {
+	dst, _ = r.Message.MarshalSSZTo(dst)
+	return append(dst, r.Signature[:]...), nil
+}
+
+// MarshalSSZ marshals signed registration into a serialized object.
+func (r *SignedValidatorRegistration) MarshalSSZ() ([]byte, error) {
+	return r.MarshalSSZTo(make([]byte, 0, r.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (r *SignedValidatorRegistration) SizeSSZ() int {
+	return 180
+}
+
+// WriteTo writes SSZ serialized signed registration to w.
+func (r *SignedValidatorRegistration) WriteTo(w io.Writer) (int64, error) {
+	return writeSSZTo(w, r)
+}
+
+// ReadFrom reads SSZ serialized signed registration from reader (exactly SizeSSZ bytes are consumed).
+func (r *SignedValidatorRegistration) ReadFrom(reader io.Reader) (int64, error) {
+	return readSSZFrom(reader, r)
+}
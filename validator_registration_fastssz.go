@@ -0,0 +1,32 @@
+//go:build !nofastssz
+
+package types
+
+import fssz "github.com/ferranbt/fastssz"
+
+var _ fssz.HashRoot = (*ValidatorRegistration)(nil)
+var _ fssz.Marshaler = (*ValidatorRegistration)(nil)
+var _ fssz.Unmarshaler = (*ValidatorRegistration)(nil)
+var _ fssz.HashRoot = (*SignedValidatorRegistration)(nil)
+var _ fssz.Marshaler = (*SignedValidatorRegistration)(nil)
+var _ fssz.Unmarshaler = (*SignedValidatorRegistration)(nil)
+
+// HashTreeRootWith appends registration root to the provided hasher.
+func (r *ValidatorRegistration) HashTreeRootWith(hh *fssz.Hasher) error {
+	root, err := r.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	hh.PutBytes(root[:])
+	return nil
+}
+
+// HashTreeRootWith appends signed registration root to the provided hasher.
+func (r *SignedValidatorRegistration) HashTreeRootWith(hh *fssz.Hasher) error {
+	root, err := r.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	hh.PutBytes(root[:])
+	return nil
+}
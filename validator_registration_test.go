@@ -0,0 +1,66 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestValidatorRegistration_SSZ(t *testing.T) {
+	r := testValidatorRegistration()
+	enc, err := r.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(enc) != 180 {
+		t.Errorf("Unexpected length: %d", len(enc))
+	}
+	decoded := &SignedValidatorRegistration{}
+	if err := decoded.UnmarshalSSZ(enc); err != nil {
+		t.Fatal(err)
+	}
+	if *decoded != *r {
+		t.Errorf("Unexpected registration: %+v", decoded)
+	}
+	if err := decoded.UnmarshalSSZ(enc[:179]); err == nil {
+		t.Error("Expected error on short buffer")
+	}
+}
+
+func TestValidatorRegistration_JSON(t *testing.T) {
+	r := testValidatorRegistration()
+	enc, err := json.Marshal(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fields struct {
+		Message map[string]string `json:"message"`
+	}
+	if err := json.Unmarshal(enc, &fields); err != nil {
+		t.Fatal(err)
+	}
+	if fields.Message["gas_limit"] != "30000000" || fields.Message["timestamp"] != "1700000000" {
+		t.Errorf("Unexpected JSON: %s", enc)
+	}
+	var decoded SignedValidatorRegistration
+	if err := json.Unmarshal(enc, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded != *r {
+		t.Errorf("Unexpected registration: %+v", decoded)
+	}
+}
+
+func TestValidatorRegistration_SigningRoot(t *testing.T) {
+	r := &testValidatorRegistration().Message
+	domain, err := ComputeDomain(DomainApplicationBuilder, ForkVersion{}, Root{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := ComputeSigningRoot(r, domain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := r.SigningRoot(ForkVersion{}); err != nil || got != want {
+		t.Errorf("Unexpected signing root: %v, %v", got, err)
+	}
+}
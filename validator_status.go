@@ -0,0 +1,99 @@
+package types
+
+import "fmt"
+
+// ValidatorStatus represents validator status as defined by the Beacon API.
+type ValidatorStatus uint8
+
+// Validator (sub-)statuses, see the Beacon API validator status specification.
+const (
+	ValidatorStatusUnknown ValidatorStatus = iota
+	ValidatorStatusPendingInitialized
+	ValidatorStatusPendingQueued
+	ValidatorStatusActiveOngoing
+	ValidatorStatusActiveExiting
+	ValidatorStatusActiveSlashed
+	ValidatorStatusExitedUnslashed
+	ValidatorStatusExitedSlashed
+	ValidatorStatusWithdrawalPossible
+	ValidatorStatusWithdrawalDone
+	// Super statuses, sub-statuses map to them via Super.
+	ValidatorStatusPending
+	ValidatorStatusActive
+	ValidatorStatusExited
+	ValidatorStatusWithdrawal
+)
+
+var validatorStatusNames = [...]string{
+	ValidatorStatusUnknown:            "unknown",
+	ValidatorStatusPendingInitialized: "pending_initialized",
+	ValidatorStatusPendingQueued:      "pending_queued",
+	ValidatorStatusActiveOngoing:      "active_ongoing",
+	ValidatorStatusActiveExiting:      "active_exiting",
+	ValidatorStatusActiveSlashed:      "active_slashed",
+	ValidatorStatusExitedUnslashed:    "exited_unslashed",
+	ValidatorStatusExitedSlashed:      "exited_slashed",
+	ValidatorStatusWithdrawalPossible: "withdrawal_possible",
+	ValidatorStatusWithdrawalDone:     "withdrawal_done",
+	ValidatorStatusPending:            "pending",
+	ValidatorStatusActive:             "active",
+	ValidatorStatusExited:             "exited",
+	ValidatorStatusWithdrawal:         "withdrawal",
+}
+
+// ParseValidatorStatus parses status from its Beacon API string representation.
+func ParseValidatorStatus(s string) (ValidatorStatus, error) {
+	for status, name := range validatorStatusNames {
+		if name == s {
+			return ValidatorStatus(status), nil
+		}
+	}
+	return ValidatorStatusUnknown, fmt.Errorf("unknown validator status %q", s)
+}
+
+// String returns Beacon API string representation of the status.
+func (s ValidatorStatus) String() string {
+	if int(s) < len(validatorStatusNames) {
+		return validatorStatusNames[s]
+	}
+	return fmt.Sprintf("ValidatorStatus(%d)", uint8(s))
+}
+
+// IsSuper returns true if status is one of the super statuses (pending, active, exited, withdrawal).
+func (s ValidatorStatus) IsSuper() bool {
+	return s >= ValidatorStatusPending && s <= ValidatorStatusWithdrawal
+}
+
+// Super returns super status the sub-status belongs to, super statuses map to themselves.
+func (s ValidatorStatus) Super() ValidatorStatus {
+	switch s {
+	case ValidatorStatusPendingInitialized, ValidatorStatusPendingQueued:
+		return ValidatorStatusPending
+	case ValidatorStatusActiveOngoing, ValidatorStatusActiveExiting, ValidatorStatusActiveSlashed:
+		return ValidatorStatusActive
+	case ValidatorStatusExitedUnslashed, ValidatorStatusExitedSlashed:
+		return ValidatorStatusExited
+	case ValidatorStatusWithdrawalPossible, ValidatorStatusWithdrawalDone:
+		return ValidatorStatusWithdrawal
+	default:
+		return s
+	}
+}
+
+// MarshalText encodes status as its Beacon API string.
+func (s ValidatorStatus) MarshalText() ([]byte, error) {
+	if int(s) >= len(validatorStatusNames) {
+		return nil, fmt.Errorf("invalid validator status %d", uint8(s))
+	}
+	return []byte(validatorStatusNames[s]), nil
+}
+
+// UnmarshalText decodes status from its Beacon API string.
+func (s *ValidatorStatus) UnmarshalText(text []byte) error {
+	status, err := ParseValidatorStatus(string(text))
+	if err != nil {
+		return err
+	}
+	*s = status
+	return nil
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestValidatorStatus_JSON(t *testing.T) {
+	enc, err := json.Marshal([]ValidatorStatus{ValidatorStatusActiveOngoing, ValidatorStatusWithdrawalDone})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(enc) != `["active_ongoing","withdrawal_done"]` {
+		t.Errorf("Unexpected JSON: %s", enc)
+	}
+	var decoded []ValidatorStatus
+	if err := json.Unmarshal(enc, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if len(decoded) != 2 || decoded[0] != ValidatorStatusActiveOngoing || decoded[1] != ValidatorStatusWithdrawalDone {
+		t.Errorf("Unexpected statuses: %v", decoded)
+	}
+	if err := json.Unmarshal([]byte(`"active_sleeping"`), new(ValidatorStatus)); err == nil {
+		t.Error("Expected error for unknown status")
+	}
+}
+
+func TestValidatorStatus_Super(t *testing.T) {
+	tests := map[ValidatorStatus]ValidatorStatus{
+		ValidatorStatusPendingQueued:      ValidatorStatusPending,
+		ValidatorStatusActiveSlashed:      ValidatorStatusActive,
+		ValidatorStatusExitedUnslashed:    ValidatorStatusExited,
+		ValidatorStatusWithdrawalPossible: ValidatorStatusWithdrawal,
+		ValidatorStatusActive:             ValidatorStatusActive,
+	}
+	for status, want := range tests {
+		if got := status.Super(); got != want {
+			t.Errorf("%v.Super() = %v, want %v", status, got, want)
+		}
+		if !want.IsSuper() {
+			t.Errorf("%v expected to be super status", want)
+		}
+	}
+}
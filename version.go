@@ -0,0 +1,164 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Version identifies a consensus fork by name, ordered by activation.
+type Version uint8
+
+// Supported forks, in activation order.
+const (
+	Phase0 Version = iota
+	Altair
+	Bellatrix
+	Capella
+	Deneb
+	Electra
+	Fulu
+)
+
+var versionNames = [...]string{
+	Phase0:    "phase0",
+	Altair:    "altair",
+	Bellatrix: "bellatrix",
+	Capella:   "capella",
+	Deneb:     "deneb",
+	Electra:   "electra",
+	Fulu:      "fulu",
+}
+
+// ParseVersion parses fork name (as used by the Beacon API "version" fields), case-insensitively.
+func ParseVersion(s string) (Version, error) {
+	lower := strings.ToLower(s)
+	for v, name := range versionNames {
+		if name == lower {
+			return Version(v), nil
+		}
+	}
+	return Phase0, fmt.Errorf("unknown fork version %q", s)
+}
+
+// String returns lowercase fork name.
+func (v Version) String() string {
+	if int(v) < len(versionNames) {
+		return versionNames[v]
+	}
+	return fmt.Sprintf("Version(%d)", uint8(v))
+}
+
+// AtLeast returns true if the fork is x or any later one.
+func (v Version) AtLeast(x Version) bool {
+	return v >= x
+}
+
+// Before returns true if the fork precedes x.
+func (v Version) Before(x Version) bool {
+	return v < x
+}
+
+// MarshalText encodes version as lowercase fork name.
+func (v Version) MarshalText() ([]byte, error) {
+	if int(v) >= len(versionNames) {
+		return nil, fmt.Errorf("unknown fork version %d", uint8(v))
+	}
+	return []byte(v.String()), nil
+}
+
+// UnmarshalText decodes version from fork name.
+func (v *Version) UnmarshalText(text []byte) error {
+	parsed, err := ParseVersion(string(text))
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// ErrInvalidForkSchedule is returned when fork schedule entries are not properly ordered.
+var ErrInvalidForkSchedule = errors.New("invalid fork schedule")
+
+// ForkScheduleEntry holds fork version bytes and activation epoch of the fork.
+type ForkScheduleEntry struct {
+	Version     Version     `json:"version"`
+	ForkVersion ForkVersion `json:"fork_version"`
+	Epoch       Epoch       `json:"epoch"`
+}
+
+// ForkSchedule maps forks to their version bytes and activation epochs.
+// Forks which are not yet scheduled should have FarFutureEpoch as activation epoch.
+type ForkSchedule struct {
+	entries []ForkScheduleEntry // sorted by version
+}
+
+// NewForkSchedule creates schedule from entries, which must be sorted by version (with no gaps,
+// starting at Phase0) and have non-decreasing activation epochs.
+func NewForkSchedule(entries ...ForkScheduleEntry) (*ForkSchedule, error) {
+	for i, e := range entries {
+		if e.Version != Version(i) {
+			return nil, fmt.Errorf("%w: expected %v at position %d, got %v", ErrInvalidForkSchedule, Version(i), i, e.Version)
+		}
+		if i > 0 && e.Epoch < entries[i-1].Epoch {
+			return nil, fmt.Errorf("%w: %v activates before %v", ErrInvalidForkSchedule, e.Version, entries[i-1].Version)
+		}
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("%w: no entries", ErrInvalidForkSchedule)
+	}
+	return &ForkSchedule{entries: append([]ForkScheduleEntry(nil), entries...)}, nil
+}
+
+// ForkVersion returns version bytes of the fork, false if fork is not in the schedule.
+func (s *ForkSchedule) ForkVersion(v Version) (ForkVersion, bool) {
+	if int(v) >= len(s.entries) {
+		return ForkVersion{}, false
+	}
+	return s.entries[v].ForkVersion, true
+}
+
+// Epoch returns activation epoch of the fork, false if fork is not in the schedule.
+func (s *ForkSchedule) Epoch(v Version) (Epoch, bool) {
+	if int(v) >= len(s.entries) {
+		return FarFutureEpoch, false
+	}
+	return s.entries[v].Epoch, true
+}
+
+// VersionOf returns fork having the given version bytes, false if there's no such fork.
+func (s *ForkSchedule) VersionOf(fv ForkVersion) (Version, bool) {
+	for _, e := range s.entries {
+		if e.ForkVersion == fv {
+			return e.Version, true
+		}
+	}
+	return Phase0, false
+}
+
+// AtEpoch returns the fork active at the epoch (the latest one activated at or before it).
+func (s *ForkSchedule) AtEpoch(epoch Epoch) ForkScheduleEntry {
+	active := s.entries[0]
+	for _, e := range s.entries[1:] {
+		if e.Epoch > epoch {
+			break
+		}
+		active = e
+	}
+	return active
+}
+
+// Fork returns the spec Fork container as of the epoch.
+func (s *ForkSchedule) Fork(epoch Epoch) Fork {
+	current := s.AtEpoch(epoch)
+	previous := current
+	if current.Version > Phase0 {
+		previous = s.entries[current.Version-1]
+	}
+	return Fork{PreviousVersion: previous.ForkVersion, CurrentVersion: current.ForkVersion, Epoch: current.Epoch}
+}
+
+// Entries returns all schedule entries, ordered by version.
+func (s *ForkSchedule) Entries() []ForkScheduleEntry {
+	return append([]ForkScheduleEntry(nil), s.entries...)
+}
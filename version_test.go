@@ -0,0 +1,89 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestVersion(t *testing.T) {
+	for v := Phase0; v <= Fulu; v++ {
+		parsed, err := ParseVersion(v.String())
+		if err != nil || parsed != v {
+			t.Errorf("Unexpected version: %v, %v", parsed, err)
+		}
+	}
+	if v, err := ParseVersion("DENEB"); err != nil || v != Deneb {
+		t.Errorf("Unexpected version: %v, %v", v, err)
+	}
+	if _, err := ParseVersion("gloas"); err == nil {
+		t.Error("Expected error for unknown fork")
+	}
+	if !Electra.AtLeast(Deneb) || !Deneb.AtLeast(Deneb) || Capella.AtLeast(Deneb) || !Capella.Before(Deneb) {
+		t.Error("Unexpected version ordering")
+	}
+	if s := Version(100).String(); s != "Version(100)" {
+		t.Errorf("Unexpected string: %s", s)
+	}
+
+	enc, err := json.Marshal(struct {
+		Version Version `json:"version"`
+	}{Version: Bellatrix})
+	if err != nil || string(enc) != `{"version":"bellatrix"}` {
+		t.Errorf("Unexpected JSON: %s, %v", enc, err)
+	}
+	var v Version
+	if err := json.Unmarshal([]byte(`"altair"`), &v); err != nil || v != Altair {
+		t.Errorf("Unexpected version: %v, %v", v, err)
+	}
+}
+
+func TestForkSchedule(t *testing.T) {
+	s, err := NewForkSchedule(
+		ForkScheduleEntry{Version: Phase0, ForkVersion: ForkVersion{0, 0, 0, 0}, Epoch: 0},
+		ForkScheduleEntry{Version: Altair, ForkVersion: ForkVersion{1, 0, 0, 0}, Epoch: 74240},
+		ForkScheduleEntry{Version: Bellatrix, ForkVersion: ForkVersion{2, 0, 0, 0}, Epoch: 144896},
+		ForkScheduleEntry{Version: Capella, ForkVersion: ForkVersion{3, 0, 0, 0}, Epoch: FarFutureEpoch},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		epoch Epoch
+		want  Version
+		fork  Fork
+	}{
+		{epoch: 0, want: Phase0, fork: Fork{Epoch: 0}},
+		{epoch: 74239, want: Phase0, fork: Fork{Epoch: 0}},
+		{epoch: 74240, want: Altair, fork: Fork{CurrentVersion: ForkVersion{1}, Epoch: 74240}},
+		{epoch: 200000, want: Bellatrix, fork: Fork{PreviousVersion: ForkVersion{1}, CurrentVersion: ForkVersion{2}, Epoch: 144896}},
+	}
+	for _, tt := range tests {
+		if got := s.AtEpoch(tt.epoch).Version; got != tt.want {
+			t.Errorf("Unexpected fork at %d: %v", tt.epoch, got)
+		}
+		if got := s.Fork(tt.epoch); got != tt.fork {
+			t.Errorf("Unexpected fork container at %d: %+v", tt.epoch, got)
+		}
+	}
+	if fv, ok := s.ForkVersion(Bellatrix); !ok || fv != (ForkVersion{2}) {
+		t.Errorf("Unexpected fork version: %v", fv)
+	}
+	if _, ok := s.ForkVersion(Deneb); ok {
+		t.Error("Deneb is not in the schedule")
+	}
+	if v, ok := s.VersionOf(ForkVersion{3}); !ok || v != Capella {
+		t.Errorf("Unexpected version: %v", v)
+	}
+	if e, ok := s.Epoch(Capella); !ok || !e.IsFarFuture() {
+		t.Errorf("Unexpected epoch: %d", e)
+	}
+
+	if _, err := NewForkSchedule(ForkScheduleEntry{Version: Altair}); !errors.Is(err, ErrInvalidForkSchedule) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	_, err = NewForkSchedule(ForkScheduleEntry{Version: Phase0, Epoch: 10}, ForkScheduleEntry{Version: Altair, Epoch: 5})
+	if !errors.Is(err, ErrInvalidForkSchedule) {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,187 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// VoluntaryExit is the spec VoluntaryExit container.
+type VoluntaryExit struct {
+	Epoch          Epoch          `json:"epoch"`
+	ValidatorIndex ValidatorIndex `json:"validator_index"`
+}
+
+// SignedVoluntaryExit is the spec SignedVoluntaryExit container.
+type SignedVoluntaryExit struct {
+	Message   VoluntaryExit `json:"message"`
+	Signature BLSSignature  `json:"signature"`
+}
+
+// Domain returns signing domain of the exit verified in the state as of stateEpoch, see ForkVersion.
+func (e *VoluntaryExit) Domain(schedule *ForkSchedule, stateEpoch Epoch, genesisValidatorsRoot Root) (Domain, error) {
+	version, err := e.ForkVersion(schedule, stateEpoch)
+	if err != nil {
+		return Domain{}, err
+	}
+	return ComputeDomain(DomainVoluntaryExit, version, genesisValidatorsRoot)
+}
+
+// ForkVersion returns fork version the exit verified in the state as of stateEpoch is signed with.
+// Since Deneb (EIP-7044), exits are verified against the Capella fork version whatever epoch they
+// name, so that they remain valid perpetually. Before Deneb, the version is that of
+// get_domain(state, exit epoch), i.e. previous fork version of the state is used for exits predating
+// the state's fork.
+func (e *VoluntaryExit) ForkVersion(schedule *ForkSchedule, stateEpoch Epoch) (ForkVersion, error) {
+	if schedule.AtEpoch(stateEpoch).Version.AtLeast(Deneb) {
+		version, ok := schedule.ForkVersion(Capella)
+		if !ok {
+			return ForkVersion{}, fmt.Errorf("%w: %v is not scheduled", ErrInvalidForkSchedule, Capella)
+		}
+		return version, nil
+	}
+	fork := schedule.Fork(stateEpoch)
+	if e.Epoch < fork.Epoch {
+		return fork.PreviousVersion, nil
+	}
+	return fork.CurrentVersion, nil
+}
+
+// SigningRoot returns root signed by the exiting validator, see Domain.
+func (e *VoluntaryExit) SigningRoot(schedule *ForkSchedule, stateEpoch Epoch, genesisValidatorsRoot Root) (Root, error) {
+	domain, err := e.Domain(schedule, stateEpoch, genesisValidatorsRoot)
+	if err != nil {
+		return Root{}, err
+	}
+	return ComputeSigningRoot(e, domain)
+}
+
+// HashTreeRoot returns calculated hash root.
+func (e *VoluntaryExit) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	err := e.HashTreeRootInto(&root)
+	return root, err
+}
+
+// AppendFieldRoots appends hash roots of exit fields to dst.
+func (e *VoluntaryExit) AppendFieldRoots(dst [][32]byte) ([][32]byte, error) {
+	var chunks [2][32]byte
+	_ = e.Epoch.HashTreeRootInto(&chunks[0])
+	_ = e.ValidatorIndex.HashTreeRootInto(&chunks[1])
+	return append(dst, chunks[:]...), nil
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (e *VoluntaryExit) HashTreeRootInto(dst *[32]byte) error {
+	var buf [2][32]byte
+	chunks, err := e.AppendFieldRoots(buf[:0])
+	if err != nil {
+		return err
+	}
+	return merkleizeInto(dst, chunks, 0)
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the exit object.
+func (e *VoluntaryExit) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), e.SizeSSZ()); err != nil {
+		return err
+	}
+	e.Epoch = Epoch(binary.LittleEndian.Uint64(buf[:8]))
+	e.ValidatorIndex = ValidatorIndex(binary.LittleEndian.Uint64(buf[8:16]))
+	return nil
+}
+
+// MarshalSSZTo marshals exit with the provided byte slice.
+func (e *VoluntaryExit) MarshalSSZTo(dst []byte) ([]byte, error) {
+	dst = e.Epoch.AppendSSZ(dst)
+	return e.ValidatorIndex.AppendSSZ(dst), nil
+}
+
+// MarshalSSZ marshals exit into a serialized object.
+func (e *VoluntaryExit) MarshalSSZ() ([]byte, error) {
+	return e.MarshalSSZTo(make([]byte, 0, e.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (e *VoluntaryExit) SizeSSZ() int {
+	return 16
+}
+
+// WriteTo writes SSZ serialized exit to w.
+func (e *VoluntaryExit) WriteTo(w io.Writer) (int64, error) {
+	return writeSSZTo(w, e)
+}
+
+// ReadFrom reads SSZ serialized exit from reader (exactly SizeSSZ bytes are consumed).
+func (e *VoluntaryExit) ReadFrom(reader io.Reader) (int64, error) {
+	return readSSZFrom(reader, e)
+}
+
+// HashTreeRoot returns calculated hash root.
+func (e *SignedVoluntaryExit) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	err := e.HashTreeRootInto(&root)
+	return root, err
+}
+
+// AppendFieldRoots appends hash roots of signed exit fields to dst.
+func (e *SignedVoluntaryExit) AppendFieldRoots(dst [][32]byte) ([][32]byte, error) {
+	var chunks [2][32]byte
+	if err := e.Message.HashTreeRootInto(&chunks[0]); err != nil {
+		return dst, err
+	}
+	if err := e.Signature.HashTreeRootInto(&chunks[1]); err != nil {
+		return dst, err
+	}
+	return append(dst, chunks[:]...), nil
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (e *SignedVoluntaryExit) HashTreeRootInto(dst *[32]byte) error {
+	var buf [2][32]byte
+	chunks, err := e.AppendFieldRoots(buf[:0])
+	if err != nil {
+		return err
+	}
+	return merkleizeInto(dst, chunks, 0)
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the signed exit object.
+func (e *SignedVoluntaryExit) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), e.SizeSSZ()); err != nil {
+		return err
+	}
+	if err := e.Message.UnmarshalSSZ(buf[:16]); err != nil {
+		return err
+	}
+	copy(e.Signature[:], buf[16:112])
+	return nil
+}
+
+// MarshalSSZTo marshals signed exit with the provided byte slice.
+func (e *SignedVoluntaryExit) MarshalSSZTo(dst []byte) ([]byte, error) {
+	dst, _ = e.Message.MarshalSSZTo(dst)
+	return append(dst, e.Signature[:]...), nil
+}
+
+// MarshalSSZ marshals signed exit into a serialized object.
+func (e *SignedVoluntaryExit) MarshalSSZ() ([]byte, error) {
+	return e.MarshalSSZTo(make([]byte, 0, e.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (e *SignedVoluntaryExit) SizeSSZ() int {
+	return 112
+}
+
+// WriteTo writes SSZ serialized signed exit to w.
+func (e *SignedVoluntaryExit) WriteTo(w io.Writer) (int64, error) {
+	return writeSSZTo(w, e)
+}
+
+// ReadFrom reads SSZ serialized signed exit from reader (exactly SizeSSZ bytes are consumed).
+func (e *SignedVoluntaryExit) ReadFrom(reader io.Reader) (int64, error) {
+	return readSSZFrom(reader, e)
+}
@@ -0,0 +1,32 @@
+//go:build !nofastssz
+
+package types
+
+import fssz "github.com/ferranbt/fastssz"
+
+var _ fssz.HashRoot = (*VoluntaryExit)(nil)
+var _ fssz.Marshaler = (*VoluntaryExit)(nil)
+var _ fssz.Unmarshaler = (*VoluntaryExit)(nil)
+var _ fssz.HashRoot = (*SignedVoluntaryExit)(nil)
+var _ fssz.Marshaler = (*SignedVoluntaryExit)(nil)
+var _ fssz.Unmarshaler = (*SignedVoluntaryExit)(nil)
+
+// HashTreeRootWith appends exit root to the provided hasher.
+func (e *VoluntaryExit) HashTreeRootWith(hh *fssz.Hasher) error {
+	root, err := e.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	hh.PutBytes(root[:])
+	return nil
+}
+
+// HashTreeRootWith appends signed exit root to the provided hasher.
+func (e *SignedVoluntaryExit) HashTreeRootWith(hh *fssz.Hasher) error {
+	root, err := e.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	hh.PutBytes(root[:])
+	return nil
+}
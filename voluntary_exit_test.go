@@ -0,0 +1,93 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestVoluntaryExit_SSZ(t *testing.T) {
+	e := &SignedVoluntaryExit{Message: VoluntaryExit{Epoch: 194048, ValidatorIndex: 42}, Signature: BLSSignature{0xaa}}
+	enc, err := e.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded := &SignedVoluntaryExit{}
+	if err := decoded.UnmarshalSSZ(enc); err != nil {
+		t.Fatal(err)
+	}
+	if *decoded != *e {
+		t.Errorf("Unexpected exit: %+v", decoded)
+	}
+
+	enc, err = json.Marshal(e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"message":{"epoch":"194048","validator_index":"42"},"signature":"0xaa`
+	if string(enc[:len(want)]) != want {
+		t.Errorf("Unexpected JSON: %s", enc)
+	}
+}
+
+func TestVoluntaryExit_SigningRoot(t *testing.T) {
+	schedule, err := NewForkSchedule(
+		ForkScheduleEntry{Version: Phase0, ForkVersion: ForkVersion{0x00}, Epoch: 0},
+		ForkScheduleEntry{Version: Altair, ForkVersion: ForkVersion{0x01}, Epoch: 74240},
+		ForkScheduleEntry{Version: Bellatrix, ForkVersion: ForkVersion{0x02}, Epoch: 144896},
+		ForkScheduleEntry{Version: Capella, ForkVersion: ForkVersion{0x03}, Epoch: 194048},
+		ForkScheduleEntry{Version: Deneb, ForkVersion: ForkVersion{0x04}, Epoch: 269568},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var gvr Root
+	if err := gvr.UnmarshalText([]byte("0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95")); err != nil {
+		t.Fatal(err)
+	}
+	// Signing roots of mainnet exits of validator 42, the Capella domain starts with the mainnet
+	// Capella fork digest (0xbba4da96).
+	tests := []struct {
+		name       string
+		exitEpoch  Epoch
+		stateEpoch Epoch
+		wantDomain string
+		wantRoot   string
+	}{
+		{"capella exit in deneb state", 194048, 300000,
+			"0x04000000bba4da96354c9f25476cf1bc69bf583a7f9e0af049305b62de676640",
+			"0xec87e5e162fb27360e8dfaccce735c24ce8ee5cf6b1421e6967a3f8c541606e7"},
+		// EIP-7044: the exit epoch doesn't matter since Deneb.
+		{"bellatrix exit in deneb state", 144896, 300000,
+			"0x04000000bba4da96354c9f25476cf1bc69bf583a7f9e0af049305b62de676640",
+			"0x2cd6d56b9cb59c39d84869936a9cf6c54306c7f319a4505b29ce552da0e20619"},
+		{"capella exit in capella state", 194048, 200000,
+			"0x04000000bba4da96354c9f25476cf1bc69bf583a7f9e0af049305b62de676640",
+			"0xec87e5e162fb27360e8dfaccce735c24ce8ee5cf6b1421e6967a3f8c541606e7"},
+		{"bellatrix exit in capella state", 144896, 200000,
+			"0x040000004a26c58b08add8089b75caa540848881a8d4f0af0be83417a85c0f45",
+			"0x96770780c2f55c610548a0d9aa7fbd981bba6f5c1d141fab674a635bde709edb"},
+		// Before Deneb, exits predating the state fork use its previous version (see get_domain).
+		{"altair exit in capella state", 74240, 200000,
+			"0x040000004a26c58b08add8089b75caa540848881a8d4f0af0be83417a85c0f45",
+			"0xd4af37a5dc41367d72c475eee57eda9a2c0ea69956f3a4db456206d4a27fcab7"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &VoluntaryExit{Epoch: tt.exitEpoch, ValidatorIndex: 42}
+			domain, err := e.Domain(schedule, tt.stateEpoch, gvr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := domain.String(); got != tt.wantDomain {
+				t.Errorf("Unexpected domain: %s, want %s", got, tt.wantDomain)
+			}
+			root, err := e.SigningRoot(schedule, tt.stateEpoch, gvr)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if root.String() != tt.wantRoot {
+				t.Errorf("Unexpected signing root: %v, want %s", root, tt.wantRoot)
+			}
+		})
+	}
+}
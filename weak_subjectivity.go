@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// WeakSubjectivityCheckpoint is a trusted block root and epoch, conventionally written as
+// `<block_root>:<epoch>` (e.g. in --weak-subjectivity-checkpoint flags).
+type WeakSubjectivityCheckpoint struct {
+	Root  Root
+	Epoch Epoch
+}
+
+// ParseWeakSubjectivityCheckpoint parses checkpoint from `<block_root>:<epoch>` string.
+func ParseWeakSubjectivityCheckpoint(s string) (WeakSubjectivityCheckpoint, error) {
+	rootStr, epochStr, ok := strings.Cut(s, ":")
+	if !ok {
+		return WeakSubjectivityCheckpoint{}, fmt.Errorf("checkpoint %q is not in <block_root>:<epoch> format", s)
+	}
+	var cp WeakSubjectivityCheckpoint
+	if err := cp.Root.UnmarshalText([]byte(rootStr)); err != nil {
+		return WeakSubjectivityCheckpoint{}, fmt.Errorf("invalid checkpoint root: %w", err)
+	}
+	if cp.Root.IsZero() {
+		return WeakSubjectivityCheckpoint{}, fmt.Errorf("checkpoint root must not be zero")
+	}
+	epoch, err := strconv.ParseUint(epochStr, 10, 64)
+	if err != nil {
+		return WeakSubjectivityCheckpoint{}, fmt.Errorf("invalid checkpoint epoch %q", epochStr)
+	}
+	cp.Epoch = Epoch(epoch)
+	return cp, nil
+}
+
+// String returns checkpoint in `<block_root>:<epoch>` format.
+func (cp WeakSubjectivityCheckpoint) String() string {
+	return string(cp.appendText(make([]byte, 0, 87)))
+}
+
+// MarshalText encodes checkpoint in `<block_root>:<epoch>` format.
+func (cp WeakSubjectivityCheckpoint) MarshalText() ([]byte, error) {
+	return cp.appendText(make([]byte, 0, 87)), nil
+}
+
+// UnmarshalText decodes checkpoint from `<block_root>:<epoch>` format.
+func (cp *WeakSubjectivityCheckpoint) UnmarshalText(text []byte) error {
+	parsed, err := ParseWeakSubjectivityCheckpoint(string(text))
+	if err != nil {
+		return err
+	}
+	*cp = parsed
+	return nil
+}
+
+func (cp WeakSubjectivityCheckpoint) appendText(dst []byte) []byte {
+	dst = appendHex(dst, cp.Root[:])
+	dst = append(dst, ':')
+	return strconv.AppendUint(dst, uint64(cp.Epoch), 10)
+}
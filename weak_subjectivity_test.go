@@ -0,0 +1,49 @@
+package types
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestParseWeakSubjectivityCheckpoint(t *testing.T) {
+	input := "0x" + strings.Repeat("0f", 32) + ":1024"
+	cp, err := ParseWeakSubjectivityCheckpoint(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cp.Epoch != 1024 || cp.Root[31] != 0x0f {
+		t.Errorf("Unexpected checkpoint: %+v", cp)
+	}
+	if cp.String() != input {
+		t.Errorf("Unexpected string: %s", cp)
+	}
+
+	for _, input := range []string{
+		"",
+		"0x" + strings.Repeat("0f", 32),
+		"0x" + strings.Repeat("0f", 32) + ":",
+		"0x" + strings.Repeat("0f", 32) + ":-1",
+		"0x" + strings.Repeat("00", 32) + ":10",
+		"0x0f0f:10",
+	} {
+		if _, err := ParseWeakSubjectivityCheckpoint(input); err == nil {
+			t.Errorf("Expected error for %q", input)
+		}
+	}
+}
+
+func TestWeakSubjectivityCheckpoint_JSON(t *testing.T) {
+	cp := WeakSubjectivityCheckpoint{Root: Root{0x01}, Epoch: 7}
+	enc, err := json.Marshal(cp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded WeakSubjectivityCheckpoint
+	if err := json.Unmarshal(enc, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded != cp {
+		t.Errorf("Unexpected checkpoint: %+v", decoded)
+	}
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"encoding/binary"
+	"io"
+
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// Withdrawal is the spec (Capella) Withdrawal container: a balance transfer from the beacon chain to
+// an execution address, built into payloads by proposers and checked against the state by verifiers.
+type Withdrawal struct {
+	Index          WithdrawalIndex  `json:"index"`
+	ValidatorIndex ValidatorIndex   `json:"validator_index"`
+	Address        ExecutionAddress `json:"address"`
+	Amount         Gwei             `json:"amount"`
+}
+
+// HashTreeRoot returns calculated hash root.
+func (w *Withdrawal) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	err := w.HashTreeRootInto(&root)
+	return root, err
+}
+
+// AppendFieldRoots appends hash roots of withdrawal fields to dst.
+func (w *Withdrawal) AppendFieldRoots(dst [][32]byte) ([][32]byte, error) {
+	index, _ := w.Index.HashTreeRoot()
+	validator, _ := w.ValidatorIndex.HashTreeRoot()
+	address, _ := w.Address.HashTreeRoot()
+	amount, _ := w.Amount.HashTreeRoot()
+	return append(dst, index, validator, address, amount), nil
+}
+
+// HashTreeRootInto writes hash root into dst.
+func (w *Withdrawal) HashTreeRootInto(dst *[32]byte) error {
+	var buf [4][32]byte
+	chunks, _ := w.AppendFieldRoots(buf[:0])
+	return merkleizeInto(dst, chunks, 0)
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the withdrawal object.
+func (w *Withdrawal) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), w.SizeSSZ()); err != nil {
+		return err
+	}
+	w.Index = WithdrawalIndex(binary.LittleEndian.Uint64(buf[:8]))
+	w.ValidatorIndex = ValidatorIndex(binary.LittleEndian.Uint64(buf[8:16]))
+	copy(w.Address[:], buf[16:36])
+	w.Amount = Gwei(binary.LittleEndian.Uint64(buf[36:44]))
+	return nil
+}
+
+// MarshalSSZTo marshals withdrawal with the provided byte slice.
+func (w *Withdrawal) MarshalSSZTo(dst []byte) ([]byte, error) {
+	dst = w.Index.AppendSSZ(dst)
+	dst = w.ValidatorIndex.AppendSSZ(dst)
+	dst = append(dst, w.Address[:]...)
+	return w.Amount.AppendSSZ(dst), nil
+}
+
+// MarshalSSZ marshals withdrawal into a serialized object.
+func (w *Withdrawal) MarshalSSZ() ([]byte, error) {
+	return w.MarshalSSZTo(make([]byte, 0, w.SizeSSZ()))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (w *Withdrawal) SizeSSZ() int {
+	return 44
+}
+
+// WriteTo writes SSZ serialized withdrawal to writer.
+func (w *Withdrawal) WriteTo(writer io.Writer) (int64, error) {
+	return writeSSZTo(writer, w)
+}
+
+// ReadFrom reads SSZ serialized withdrawal from reader (exactly SizeSSZ bytes are consumed).
+func (w *Withdrawal) ReadFrom(reader io.Reader) (int64, error) {
+	return readSSZFrom(reader, w)
+}
@@ -0,0 +1,19 @@
+//go:build !nofastssz
+
+package types
+
+import fssz "github.com/ferranbt/fastssz"
+
+var _ fssz.HashRoot = (*Withdrawal)(nil)
+var _ fssz.Marshaler = (*Withdrawal)(nil)
+var _ fssz.Unmarshaler = (*Withdrawal)(nil)
+
+// HashTreeRootWith appends withdrawal root to the provided hasher.
+func (w *Withdrawal) HashTreeRootWith(hh *fssz.Hasher) error {
+	root, err := w.HashTreeRoot()
+	if err != nil {
+		return err
+	}
+	hh.PutBytes(root[:])
+	return nil
+}
@@ -0,0 +1,5 @@
+package types
+
+// WithdrawalIndex represents global index of a withdrawal (incremented on each processed withdrawal).
+// Common methods (arithmetic, encoding) are generated, see withdrawal_index_gen.go.
+type WithdrawalIndex uint64
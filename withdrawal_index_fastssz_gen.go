@@ -0,0 +1,17 @@
+// Code generated by typegen. DO NOT EDIT.
+
+//go:build !nofastssz
+
+package types
+
+import fssz "github.com/ferranbt/fastssz"
+
+var _ fssz.HashRoot = (WithdrawalIndex)(0)
+var _ fssz.Marshaler = (*WithdrawalIndex)(nil)
+var _ fssz.Unmarshaler = (*WithdrawalIndex)(nil)
+
+// HashTreeRootWith appends withdrawal index to the provided hasher.
+func (wi WithdrawalIndex) HashTreeRootWith(hh *fssz.Hasher) error {
+	hh.PutUint64(uint64(wi))
+	return nil
+}
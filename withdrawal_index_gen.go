@@ -0,0 +1,244 @@
+// Code generated by typegen. DO NOT EDIT.
+
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/farazdagi/prysm-shared-types/mathutil"
+	"github.com/farazdagi/prysm-shared-types/sszutil"
+)
+
+// IsZero returns true if withdrawal index has zero value.
+func (wi WithdrawalIndex) IsZero() bool {
+	return wi == 0
+}
+
+// Add increases withdrawal index by x, panics on overflow.
+func (wi WithdrawalIndex) Add(x uint64) WithdrawalIndex {
+	return wi.AddWithdrawalIndex(WithdrawalIndex(x))
+}
+
+// AddWithdrawalIndex increases withdrawal index by another withdrawal index, panics on overflow.
+func (wi WithdrawalIndex) AddWithdrawalIndex(x WithdrawalIndex) WithdrawalIndex {
+	return mathutil.Add(wi, x)
+}
+
+// SafeAdd increases withdrawal index by x, returns an error on overflow.
+func (wi WithdrawalIndex) SafeAdd(x uint64) (WithdrawalIndex, error) {
+	return mathutil.SafeAdd(wi, WithdrawalIndex(x))
+}
+
+// Sub subtracts x from the withdrawal index, panics on underflow.
+func (wi WithdrawalIndex) Sub(x uint64) WithdrawalIndex {
+	return wi.SubWithdrawalIndex(WithdrawalIndex(x))
+}
+
+// SubWithdrawalIndex finds difference between two withdrawal index values, panics on underflow.
+func (wi WithdrawalIndex) SubWithdrawalIndex(x WithdrawalIndex) WithdrawalIndex {
+	return mathutil.Sub(wi, x)
+}
+
+// SafeSub subtracts x from the withdrawal index, returns an error on underflow.
+func (wi WithdrawalIndex) SafeSub(x uint64) (WithdrawalIndex, error) {
+	return mathutil.SafeSub(wi, WithdrawalIndex(x))
+}
+
+// AddInt shifts withdrawal index by signed offset x, returns an error if result overflows or goes below zero.
+func (wi WithdrawalIndex) AddInt(x int64) (WithdrawalIndex, error) {
+	return mathutil.SafeAddInt(wi, x)
+}
+
+// Mul multiplies withdrawal index by x, panics on overflow.
+func (wi WithdrawalIndex) Mul(x uint64) WithdrawalIndex {
+	return wi.MulWithdrawalIndex(WithdrawalIndex(x))
+}
+
+// MulWithdrawalIndex multiplies withdrawal index by another withdrawal index, panics on overflow.
+func (wi WithdrawalIndex) MulWithdrawalIndex(x WithdrawalIndex) WithdrawalIndex {
+	return mathutil.Mul(wi, x)
+}
+
+// SafeMul multiplies withdrawal index by x, returns an error on overflow.
+func (wi WithdrawalIndex) SafeMul(x uint64) (WithdrawalIndex, error) {
+	return mathutil.SafeMul(wi, WithdrawalIndex(x))
+}
+
+// Div divides withdrawal index by x, panics if x is zero.
+func (wi WithdrawalIndex) Div(x uint64) WithdrawalIndex {
+	return wi.DivWithdrawalIndex(WithdrawalIndex(x))
+}
+
+// DivWithdrawalIndex divides withdrawal index by another withdrawal index, panics if x is zero.
+func (wi WithdrawalIndex) DivWithdrawalIndex(x WithdrawalIndex) WithdrawalIndex {
+	return mathutil.Div(wi, x)
+}
+
+// SafeDiv divides withdrawal index by x, returns an error if x is zero.
+func (wi WithdrawalIndex) SafeDiv(x uint64) (WithdrawalIndex, error) {
+	return mathutil.SafeDiv(wi, WithdrawalIndex(x))
+}
+
+// Mod returns result of `withdrawal index % x`, panics if x is zero.
+func (wi WithdrawalIndex) Mod(x uint64) WithdrawalIndex {
+	return wi.ModWithdrawalIndex(WithdrawalIndex(x))
+}
+
+// ModWithdrawalIndex returns result of `withdrawal index % withdrawal index`, panics if x is zero.
+func (wi WithdrawalIndex) ModWithdrawalIndex(x WithdrawalIndex) WithdrawalIndex {
+	return mathutil.Mod(wi, x)
+}
+
+// SafeMod returns result of `withdrawal index % x`, returns an error if x is zero.
+func (wi WithdrawalIndex) SafeMod(x uint64) (WithdrawalIndex, error) {
+	return mathutil.SafeMod(wi, WithdrawalIndex(x))
+}
+
+// Compare returns an integer comparing two withdrawal index values (-1, 0 or +1).
+func (wi WithdrawalIndex) Compare(x WithdrawalIndex) int {
+	switch {
+	case wi < x:
+		return -1
+	case wi > x:
+		return 1
+	}
+	return 0
+}
+
+// IsAfter returns true if withdrawal index is strictly greater than x.
+func (wi WithdrawalIndex) IsAfter(x WithdrawalIndex) bool {
+	return wi > x
+}
+
+// IsBefore returns true if withdrawal index is strictly less than x.
+func (wi WithdrawalIndex) IsBefore(x WithdrawalIndex) bool {
+	return wi < x
+}
+
+// WithinN returns true if withdrawal index is at most n away from x (in either direction).
+func (wi WithdrawalIndex) WithinN(x WithdrawalIndex, n uint64) bool {
+	if wi > x {
+		return uint64(wi-x) <= n
+	}
+	return uint64(x-wi) <= n
+}
+
+// String returns decimal representation of the withdrawal index.
+func (wi WithdrawalIndex) String() string {
+	return strconv.FormatUint(uint64(wi), 10)
+}
+
+// PaddedString returns decimal representation of the withdrawal index, left-padded with zeros to width digits,
+// so that values sort lexicographically (e.g. in file names and object keys). Wider values are not truncated.
+func (wi WithdrawalIndex) PaddedString(width int) string {
+	return string(wi.AppendPadded(nil, width))
+}
+
+// AppendPadded appends zero-padded decimal representation of the withdrawal index to dst, see PaddedString.
+func (wi WithdrawalIndex) AppendPadded(dst []byte, width int) []byte {
+	var digits [20]byte
+	b := strconv.AppendUint(digits[:0], uint64(wi), 10)
+	for i := len(b); i < width; i++ {
+		dst = append(dst, '0')
+	}
+	return append(dst, b...)
+}
+
+// MarshalText encodes withdrawal index as a decimal string.
+func (wi WithdrawalIndex) MarshalText() ([]byte, error) {
+	return strconv.AppendUint(nil, uint64(wi), 10), nil
+}
+
+// UnmarshalText decodes withdrawal index from a decimal string.
+func (wi *WithdrawalIndex) UnmarshalText(data []byte) error {
+	parsed, err := strconv.ParseUint(string(data), 10, 64)
+	if err != nil {
+		return fmt.Errorf("could not parse withdrawal index: %w", err)
+	}
+	*wi = WithdrawalIndex(parsed)
+	return nil
+}
+
+// MarshalJSON encodes withdrawal index as a quoted decimal string (as expected by the Beacon API).
+func (wi WithdrawalIndex) MarshalJSON() ([]byte, error) {
+	data := make([]byte, 0, 22)
+	data = append(data, '"')
+	data = strconv.AppendUint(data, uint64(wi), 10)
+	return append(data, '"'), nil
+}
+
+// UnmarshalJSON decodes withdrawal index from either a quoted decimal string or a JSON number.
+func (wi *WithdrawalIndex) UnmarshalJSON(data []byte) error {
+	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
+		data = data[1 : len(data)-1]
+	}
+	return wi.UnmarshalText(data)
+}
+
+// HashTreeRoot returns calculated hash root.
+// Root of a basic uint64 value is its little-endian encoding padded to 32 bytes, so no hashing is involved.
+func (wi WithdrawalIndex) HashTreeRoot() ([32]byte, error) {
+	var root [32]byte
+	binary.LittleEndian.PutUint64(root[:8], uint64(wi))
+	return root, nil
+}
+
+// HashTreeRootInto writes hash root into dst, avoiding copying of the returned array.
+func (wi WithdrawalIndex) HashTreeRootInto(dst *[32]byte) error {
+	*dst = [32]byte{}
+	binary.LittleEndian.PutUint64(dst[:8], uint64(wi))
+	return nil
+}
+
+// UnmarshalSSZ deserializes the provided bytes buffer into the withdrawal index object.
+func (wi *WithdrawalIndex) UnmarshalSSZ(buf []byte) error {
+	if err := sszutil.CheckLength(len(buf), wi.SizeSSZ()); err != nil {
+		return err
+	}
+	*wi = WithdrawalIndex(binary.LittleEndian.Uint64(buf))
+	return nil
+}
+
+// MarshalSSZTo marshals withdrawal index with the provided byte slice.
+func (wi *WithdrawalIndex) MarshalSSZTo(dst []byte) ([]byte, error) {
+	return wi.AppendSSZ(dst), nil
+}
+
+// MarshalSSZ marshals withdrawal index into a serialized object.
+func (wi *WithdrawalIndex) MarshalSSZ() ([]byte, error) {
+	return wi.AppendSSZ(make([]byte, 0, 8)), nil
+}
+
+// AppendSSZ appends serialized withdrawal index to dst, allocating only if dst has no spare capacity.
+func (wi WithdrawalIndex) AppendSSZ(dst []byte) []byte {
+	return append(dst, byte(wi), byte(wi>>8), byte(wi>>16), byte(wi>>24),
+		byte(wi>>32), byte(wi>>40), byte(wi>>48), byte(wi>>56))
+}
+
+// SizeSSZ returns the size of the serialized object.
+func (wi *WithdrawalIndex) SizeSSZ() int {
+	return 8
+}
+
+// WriteTo writes SSZ serialized withdrawal index to w.
+func (wi WithdrawalIndex) WriteTo(w io.Writer) (int64, error) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], uint64(wi))
+	n, err := w.Write(buf[:])
+	return int64(n), err
+}
+
+// ReadFrom reads SSZ serialized withdrawal index from r.
+// Exactly 8 bytes are consumed, so values can be read one after another from the same stream.
+func (wi *WithdrawalIndex) ReadFrom(r io.Reader) (int64, error) {
+	var buf [8]byte
+	n, err := io.ReadFull(r, buf[:])
+	if err != nil {
+		return int64(n), err
+	}
+	*wi = WithdrawalIndex(binary.LittleEndian.Uint64(buf[:]))
+	return int64(n), nil
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestWithdrawal_SSZ(t *testing.T) {
+	w := testWithdrawal()
+	enc, err := w.MarshalSSZ()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(enc) != 44 {
+		t.Errorf("Unexpected length: %d", len(enc))
+	}
+	decoded := &Withdrawal{}
+	if err := decoded.UnmarshalSSZ(enc); err != nil {
+		t.Fatal(err)
+	}
+	if *decoded != *w {
+		t.Errorf("Unexpected withdrawal: %+v", decoded)
+	}
+	if err := decoded.UnmarshalSSZ(enc[1:]); err == nil {
+		t.Error("Expected error on short buffer")
+	}
+}
+
+func TestWithdrawal_JSON(t *testing.T) {
+	w := testWithdrawal()
+	enc, err := json.Marshal(w)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"index":"5","validator_index":"10","address":"0xab00000000000000000000000000000000000000","amount":"15640"}`
+	if string(enc) != want {
+		t.Errorf("Unexpected JSON: %s", enc)
+	}
+	var decoded Withdrawal
+	if err := json.Unmarshal(enc, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded != *w {
+		t.Errorf("Unexpected withdrawal: %+v", decoded)
+	}
+}
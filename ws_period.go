@@ -0,0 +1,28 @@
+package types
+
+// ComputeWeakSubjectivityPeriod returns weak subjectivity period for the validator set of the
+// given size and average balance, see compute_weak_subjectivity_period in the spec. Division by zero
+// (zero MAX_DEPOSITS or churn limit) is handled with the error hook of the spec, see
+// Spec.WithErrorHook.
+func ComputeWeakSubjectivityPeriod(activeValidators uint64, avgBalance Gwei, spec *ChainSpec) Epoch {
+	wsPeriod := spec.MinValidatorWithdrawabilityDelay
+	if activeValidators == 0 {
+		return wsPeriod
+	}
+	n := activeValidators
+	t := uint64(avgBalance / GweiPerEth)
+	T := uint64(spec.MaxEffectiveBalance / GweiPerEth)
+	delta := ValidatorChurnLimit(n, spec)
+	Delta := spec.MaxDeposits * uint64(spec.SlotsPerEpoch)
+	D := spec.SafetyDecay
+
+	if T*(200+3*D) < t*(200+12*D) {
+		epochsForValidatorSetChurn := specDiv(spec, n*(t*(200+12*D)-T*(200+3*D)), 600*delta*(2*t+T))
+		epochsForBalanceTopUps := specDiv(spec, n*(200+3*D), 600*Delta)
+		return wsPeriod.Add(Max(epochsForValidatorSetChurn, epochsForBalanceTopUps))
+	}
+	if t >= T {
+		return wsPeriod
+	}
+	return wsPeriod.Add(specDiv(spec, 3*n*D*t, 200*Delta*(T-t)))
+}
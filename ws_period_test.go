@@ -0,0 +1,77 @@
+package types
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/farazdagi/prysm-shared-types/mathutil"
+)
+
+func TestComputeWeakSubjectivityPeriod(t *testing.T) {
+	spec := testChurnSpec()
+	spec.MinValidatorWithdrawabilityDelay = 256
+	spec.MaxDeposits = 16
+	spec.SafetyDecay = 10
+
+	// Test vectors from the weak subjectivity guide of the consensus specs.
+	tests := []struct {
+		avgBalance Gwei
+		validators uint64
+		want       Epoch
+	}{
+		{avgBalance: 28 * GweiPerEth, validators: 32768, want: 504},
+		{avgBalance: 28 * GweiPerEth, validators: 65536, want: 752},
+		{avgBalance: 28 * GweiPerEth, validators: 131072, want: 1248},
+		{avgBalance: 28 * GweiPerEth, validators: 262144, want: 2241},
+		{avgBalance: 28 * GweiPerEth, validators: 524288, want: 2241},
+		{avgBalance: 28 * GweiPerEth, validators: 1048576, want: 2241},
+		{avgBalance: 32 * GweiPerEth, validators: 32768, want: 665},
+		{avgBalance: 32 * GweiPerEth, validators: 65536, want: 1075},
+		{avgBalance: 32 * GweiPerEth, validators: 131072, want: 1894},
+		{avgBalance: 32 * GweiPerEth, validators: 262144, want: 3532},
+		{avgBalance: 32 * GweiPerEth, validators: 524288, want: 3532},
+		{avgBalance: 32 * GweiPerEth, validators: 1048576, want: 3532},
+		{avgBalance: 32 * GweiPerEth, validators: 0, want: 256},
+	}
+	for _, tt := range tests {
+		if got := ComputeWeakSubjectivityPeriod(tt.validators, tt.avgBalance, spec); got != tt.want {
+			t.Errorf("ComputeWeakSubjectivityPeriod(%d, %v) = %d, want %d", tt.validators, tt.avgBalance, got, tt.want)
+		}
+	}
+}
+
+func TestComputeWeakSubjectivityPeriod_ZeroDivisors(t *testing.T) {
+	var reported []error
+	hook := func(err error) {
+		reported = append(reported, err)
+	}
+	spec := testChurnSpec()
+	spec.MinValidatorWithdrawabilityDelay = 256
+	spec.SafetyDecay = 10
+	spec.MaxDeposits = 0
+	spec.errorHook = &hook
+
+	// Balance top-ups saturate to zero, so validator set churn determines the period.
+	if got := ComputeWeakSubjectivityPeriod(32768, 28*GweiPerEth, spec); got != 504 {
+		t.Errorf("Unexpected period: %d", got)
+	}
+	if got := ComputeWeakSubjectivityPeriod(32768, 16*GweiPerEth, spec); got != 256 {
+		t.Errorf("Unexpected period: %d", got)
+	}
+	if len(reported) != 2 {
+		t.Fatalf("Unexpected number of reported errors: %d", len(reported))
+	}
+	for _, err := range reported {
+		if !errors.Is(err, mathutil.ErrDivByZero) {
+			t.Errorf("Unexpected reported error: %v", err)
+		}
+	}
+
+	spec.errorHook = nil
+	defer func() {
+		if r := recover(); r != mathutil.ErrDivByZero.Error() {
+			t.Errorf("Unexpected panic: %v", r)
+		}
+	}()
+	ComputeWeakSubjectivityPeriod(32768, 28*GweiPerEth, spec)
+}